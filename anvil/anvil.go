@@ -10,39 +10,192 @@ package anvil
 */
 
 import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
 	"github.com/beito123/level"
+	"github.com/beito123/level/asset"
+	"github.com/beito123/level/util"
+	"github.com/beito123/nbt"
+)
+
+const (
+	// LevelDataFile is a location of level.dat
+	LevelDataFile = "level.dat"
+
+	// DefaultDataVersion is a data version for new levels (v1.13.2)
+	DefaultDataVersion = 1631
+
+	// DataVersionV113 is the first data version of v1.13 chunk format
+	DataVersionV113 = 1519
+
+	// DataVersionV115 is the first data version which has 3d biomes (19w36a, v1.15)
+	DataVersionV115 = 2203
+
+	// Biomes3DSize is the number of biomes in a chunk with 3d biomes (4x4x4 blocks per biome)
+	Biomes3DSize = 1024
+
+	// DataVersionV116 is the first data version which packs block states and heightmaps
+	// without spanning values over longs (20w17a, v1.16)
+	DataVersionV116 = 2529
 )
 
-func NewAnvil(path string) (*Anvil, error) {
-	loader, err := NewRegionLoader(path, RegionFileAnvil)
+// RegionPaths is locations of region files for dimensions
+var RegionPaths = map[level.Dimension]string{
+	level.OverWorld: "region",
+	level.Nether:    "DIM-1/region",
+	level.TheEnd:    "DIM1/region",
+}
+
+func init() {
+	level.RegisterFormat(&level.Opener{
+		Name:   "anvil",
+		Detect: Detect,
+		Open: func(path string) (level.Format, error) {
+			return Load(path)
+		},
+	})
+
+	level.RegisterFormat(&level.Opener{
+		Name:   "mcregion",
+		Detect: DetectMCRegion,
+		Open: func(path string) (level.Format, error) {
+			return LoadMCRegion(path)
+		},
+	})
+}
+
+// New returns new Anvil
+// The path is a directory for save
+func New(path string) (*Anvil, error) {
+	path = filepath.Clean(path)
+
+	err := os.MkdirAll(path, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := DefaultProperties()
+
+	err = SaveLevelData(path, properties)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAnvil(path, properties, &ChunkFormatV113{}, RegionFileAnvil)
+}
+
+// Load loads a anvil level
+// The chunk format is selected by DataVersion of level.dat
+func Load(path string) (*Anvil, error) {
+	path = filepath.Clean(path)
+
+	properties, err := LoadLevelData(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var format ChunkFormat = &ChunkFormatV112{}
+	if properties.Data.Has(TagDataVersion) {
+		ver, err := properties.Data.GetInt(TagDataVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		if ver >= DataVersionV113 {
+			format = &ChunkFormatV113{}
+		}
+	}
+
+	return newAnvil(path, properties, format, RegionFileAnvil)
+}
+
+// LoadMCRegion loads a mcregion level (mcje beta 1.3 to v1.1)
+// Chunks are read from and written to .mcr region files
+func LoadMCRegion(path string) (*Anvil, error) {
+	path = filepath.Clean(path)
+
+	properties, err := LoadLevelData(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAnvil(path, properties, &ChunkFormatMCRegion{}, RegionFileMCRegion)
+}
+
+func newAnvil(path string, properties *Properties, format ChunkFormat, regionFile func(x, y int) string) (*Anvil, error) {
+	lvl := &Anvil{
+		Format:     format,
+		path:       path,
+		properties: properties,
+		regionFile: regionFile,
+		mutex:      new(sync.RWMutex),
+	}
+
+	err := lvl.setLoader(level.OverWorld)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Anvil{
-		loader:  loader,
-		regions: make(map[uint64]*Region),
-	}, nil
+	return lvl, nil
 }
 
 // Anvil is a level format
 // It often is used for minecraft java edition and server world
 type Anvil struct {
-	loader  *RegionLoader
-	regions map[uint64]*Region
+	Format ChunkFormat
+
+	path       string
+	properties *Properties
+	regionFile func(x, y int) string
+
+	dimension level.Dimension
+	loader    *RegionLoader
+	regions   map[uint64]*Region
+	chunks    map[uint64]*Chunk
+
+	mutex *sync.RWMutex
 }
 
-// getRegion returns a region with xy
-// If the Region doesn't exist, ok is false
-func (lvl *Anvil) getRegion(x, y int) (r *Region, ok bool) {
-	r, ok = lvl.regions[lvl.toIndex(x, y)]
+// setLoader sets a region loader for the dimension
+func (lvl *Anvil) setLoader(dimension level.Dimension) error {
+	dir, ok := RegionPaths[dimension]
+	if !ok {
+		return fmt.Errorf("level.anvil: unknown dimension %d", dimension)
+	}
+
+	path := filepath.Join(lvl.path, dir)
+
+	err := os.MkdirAll(path, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	loader, err := NewRegionLoader(path, lvl.regionFile)
+	if err != nil {
+		return err
+	}
+
+	lvl.mutex.Lock()
+	lvl.dimension = dimension
+	lvl.loader = loader
+	lvl.regions = make(map[uint64]*Region)
+	lvl.chunks = make(map[uint64]*Chunk)
+	lvl.mutex.Unlock()
 
-	return r, ok
+	return nil
 }
 
-// toCC returns id for container from region coordinate
+// toIndex returns id for container from coordinate
 func (Anvil) toIndex(x, y int) uint64 {
-	return uint64(int64(x)<<32 | int64(y))
+	return (uint64(uint32(y)) << 32) | uint64(uint32(x))
 }
 
 // chunkToRegion returns region coordinate from chunk coordinate
@@ -50,41 +203,429 @@ func (Anvil) chunkToRegion(x, y int) (cx, cy int) {
 	return x >> 5, y >> 5
 }
 
+// region returns a region at region coordinate
+// If create is true, returns new region when the region file doesn't exist
+// If the region doesn't exist, returns nil
+func (lvl *Anvil) region(x, y int, create bool) (*Region, error) {
+	lvl.mutex.Lock()
+	defer lvl.mutex.Unlock()
+
+	reg, ok := lvl.regions[lvl.toIndex(x, y)]
+	if ok {
+		return reg, nil
+	}
+
+	if !create && !util.ExistFile(util.To(lvl.loader.path, lvl.loader.ToRegionFile(x, y))) {
+		return nil, nil
+	}
+
+	reg, err := lvl.loader.LoadRegion(x, y, create)
+	if err != nil {
+		return nil, err
+	}
+
+	lvl.regions[lvl.toIndex(x, y)] = reg
+
+	return reg, nil
+}
+
+// Name returns name of level
+func (lvl *Anvil) Name() string {
+	tag, ok := lvl.Property(TagLevelName)
+	if !ok {
+		return ""
+	}
+
+	name, _ := tag.ToString()
+
+	return name
+}
+
+// SetName sets the name of level
+func (lvl *Anvil) SetName(name string) {
+	lvl.SetProperty(nbt.NewStringTag(TagLevelName, name))
+}
+
+// GameType returns the default game mode of level
+func (lvl *Anvil) GameType() level.GameType {
+	tag, ok := lvl.Property(TagGameType)
+	if !ok {
+		return level.Survival
+	}
+
+	typ, _ := tag.ToInt()
+
+	return level.GameType(typ)
+}
+
+// SetGameType sets the game mode of level
+func (lvl *Anvil) SetGameType(typ level.GameType) {
+	lvl.SetProperty(nbt.NewIntTag(TagGameType, int32(typ)))
+}
+
+// Spawn returns the default spawn of level
+func (lvl *Anvil) Spawn() (x, y, z int) {
+	if tag, ok := lvl.Property(TagSpawnX); ok {
+		x, _ = tag.ToInt()
+	}
+
+	if tag, ok := lvl.Property(TagSpawnY); ok {
+		y, _ = tag.ToInt()
+	}
+
+	if tag, ok := lvl.Property(TagSpawnZ); ok {
+		z, _ = tag.ToInt()
+	}
+
+	return x, y, z
+}
+
+// SetSpawn sets the default spawn of level
+func (lvl *Anvil) SetSpawn(x, y, z int) {
+	lvl.SetProperty(nbt.NewIntTag(TagSpawnX, int32(x)))
+	lvl.SetProperty(nbt.NewIntTag(TagSpawnY, int32(y)))
+	lvl.SetProperty(nbt.NewIntTag(TagSpawnZ, int32(z)))
+}
+
+// Property returns a property of level.dat
+func (lvl *Anvil) Property(name string) (tag nbt.Tag, ok bool) {
+	return lvl.properties.Data.Get(name)
+}
+
+// SetProperty sets a property
+func (lvl *Anvil) SetProperty(tag nbt.Tag) {
+	lvl.properties.Data.Set(tag)
+}
+
+// AllProperties returns all properties
+func (lvl *Anvil) AllProperties() *nbt.Compound {
+	return lvl.properties.Data
+}
+
+// SetAllProperties sets all properties
+func (lvl *Anvil) SetAllProperties(com *nbt.Compound) {
+	lvl.properties.Data = com
+}
+
+// Close saves level.dat
+// You must close after you use the format
+// It's should not run other functions after format is closed
+func (lvl *Anvil) Close() error {
+	return SaveLevelData(lvl.path, lvl.properties)
+}
+
+// Edition returns the edition of minecraft using the level format
+func (Anvil) Edition() asset.Edition {
+	return asset.JavaEdition
+}
+
+// Dimension return dimension of the level
+func (lvl *Anvil) Dimension() level.Dimension {
+	return lvl.dimension
+}
+
+// SetDimension set dimension of the level
+// Loaded chunks are unloaded without saving
+func (lvl *Anvil) SetDimension(dimension level.Dimension) {
+	lvl.setLoader(dimension)
+}
+
 // LoadChunk loads a chunk.
-// If create is true, generates a chunk.
-func (lvl *Anvil) LoadChunk(x, y int, create bool) bool {
-	return false
+// If create is enabled, generates a chunk if it doesn't exist
+func (lvl *Anvil) LoadChunk(x, y int, create bool) error {
+	if lvl.IsLoadedChunk(x, y) {
+		return fmt.Errorf("level.anvil: already loaded the chunk")
+	}
+
+	exist, err := lvl.HasGeneratedChunk(x, y)
+	if err != nil {
+		return err
+	}
+
+	if !exist {
+		if create {
+			return lvl.GenerateChunk(x, y)
+		}
+
+		return fmt.Errorf("level.anvil: the chunk isn't generated")
+	}
+
+	rx, ry := lvl.chunkToRegion(x, y)
+
+	reg, err := lvl.region(rx, ry, false)
+	if err != nil {
+		return err
+	}
+
+	b, err := reg.ReadChunk(x&31, y&31)
+	if err != nil {
+		return err
+	}
+
+	var chunk *Chunk
+	if _, ok := lvl.Format.(*ChunkFormatMCRegion); ok { // mcregion doesn't have DataVersion
+		var com *nbt.Compound
+		com, err = readChunkTag(b)
+		if err == nil {
+			chunk, err = lvl.Format.Read(com)
+		}
+	} else {
+		chunk, err = ReadChunk(x, y, b)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	lvl.mutex.Lock()
+	lvl.chunks[lvl.toIndex(x, y)] = chunk
+	lvl.mutex.Unlock()
+
+	return nil
 }
 
 // UnloadChunk unloads a chunk.
-// If safe is false, unloads even when players are there.
-func (lvl *Anvil) UnloadChunk(x, y int, safe bool) bool {
-	return false
+func (lvl *Anvil) UnloadChunk(x, y int) error {
+	if !lvl.IsLoadedChunk(x, y) {
+		return fmt.Errorf("level.anvil: not loaded the chunk")
+	}
+
+	lvl.mutex.Lock()
+	delete(lvl.chunks, lvl.toIndex(x, y))
+	lvl.mutex.Unlock()
+
+	return nil
+}
+
+// GenerateChunk generates a chunk and loads
+func (lvl *Anvil) GenerateChunk(x, y int) error {
+	if lvl.IsLoadedChunk(x, y) {
+		return fmt.Errorf("level.anvil: already loaded the chunk")
+	}
+
+	chunk := NewChunk(x, y, lvl.Format)
+
+	lvl.mutex.Lock()
+	lvl.chunks[lvl.toIndex(x, y)] = chunk
+	lvl.mutex.Unlock()
+
+	return nil
+}
+
+// HasGeneratedChunk returns whether the chunk is generaged
+func (lvl *Anvil) HasGeneratedChunk(x, y int) (bool, error) {
+	rx, ry := lvl.chunkToRegion(x, y)
+
+	reg, err := lvl.region(rx, ry, false)
+	if err != nil {
+		return false, err
+	}
+
+	if reg == nil {
+		return false, nil
+	}
+
+	return reg.HasChunk(x&31, y&31), nil
+}
+
+// ChunkStamp returns a hash of raw data of the chunk
+// It's changed when the chunk is saved with changes
+// If the chunk isn't generated, returns false for ok
+func (lvl *Anvil) ChunkStamp(x, y int) (stamp uint64, ok bool, err error) {
+	rx, ry := lvl.chunkToRegion(x, y)
+
+	reg, err := lvl.region(rx, ry, false)
+	if err != nil || reg == nil {
+		return 0, false, err
+	}
+
+	record, ok := reg.Record(x&31, y&31)
+	if !ok {
+		return 0, false, nil
+	}
+
+	hash := fnv.New64a()
+	hash.Write(record)
+
+	return hash.Sum64(), true, nil
+}
+
+// GeneratedChunks returns coordinates of all generated chunks in the dimension
+func (lvl *Anvil) GeneratedChunks() ([]level.ChunkCoord, error) {
+	lvl.mutex.RLock()
+	path := lvl.loader.path
+	ext := filepath.Ext(lvl.regionFile(0, 0))
+	lvl.mutex.RUnlock()
+
+	files, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []level.ChunkCoord
+	for _, file := range files {
+		parts := strings.Split(file.Name(), ".")
+		if file.IsDir() || len(parts) != 4 || parts[0] != "r" || "."+parts[3] != ext {
+			continue
+		}
+
+		rx, err1 := strconv.Atoi(parts[1])
+		ry, err2 := strconv.Atoi(parts[2])
+		if err1 != nil || err2 != nil {
+			continue
+		}
+
+		reg, err := lvl.region(rx, ry, false)
+		if err != nil {
+			return nil, err
+		}
+
+		if reg == nil {
+			continue
+		}
+
+		for y := 0; y < 32; y++ {
+			for x := 0; x < 32; x++ {
+				if reg.HasChunk(x, y) {
+					result = append(result, level.ChunkCoord{X: rx<<5 | x, Y: ry<<5 | y})
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// DeleteChunk deletes the chunk from the region file, and unloads it
+// If the chunk isn't generated, it does nothing
+func (lvl *Anvil) DeleteChunk(x, y int) error {
+	lvl.mutex.Lock()
+	delete(lvl.chunks, lvl.toIndex(x, y))
+	lvl.mutex.Unlock()
+
+	rx, ry := lvl.chunkToRegion(x, y)
+
+	reg, err := lvl.region(rx, ry, false)
+	if err != nil || reg == nil {
+		return err
+	}
+
+	if !reg.HasChunk(x&31, y&31) {
+		return nil
+	}
+
+	err = reg.DeleteChunk(x&31, y&31)
+	if err != nil {
+		return err
+	}
+
+	return lvl.loader.SaveRegion(reg)
 }
 
 // IsLoadedChunk returns weather a chunk is loaded.
 func (lvl *Anvil) IsLoadedChunk(x, y int) bool {
-	return false
+	lvl.mutex.RLock()
+	_, ok := lvl.chunks[lvl.toIndex(x, y)]
+	lvl.mutex.RUnlock()
+
+	return ok
 }
 
 // SaveChunk saves a chunk.
-func (lvl *Anvil) SaveChunk(x, y int) bool {
-	return false
+func (lvl *Anvil) SaveChunk(x, y int) error {
+	chunk, ok := lvl.chunk(x, y)
+	if !ok {
+		return fmt.Errorf("level.anvil: not loaded the chunk")
+	}
+
+	reg, err := lvl.writeChunk(chunk)
+	if err != nil {
+		return err
+	}
+
+	return lvl.loader.SaveRegion(reg)
 }
 
 // SaveChunks saves all chunks.
-func (lvl *Anvil) SaveChunks() {
+func (lvl *Anvil) SaveChunks() error {
+	regions := make(map[*Region]bool)
+	for _, chunk := range lvl.LoadedChunks() {
+		reg, err := lvl.writeChunk(chunk.(*Chunk))
+		if err != nil {
+			return err
+		}
+
+		regions[reg] = true
+	}
 
+	for reg := range regions {
+		err := lvl.loader.SaveRegion(reg)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeChunk writes a chunk to the region, returns the region
+func (lvl *Anvil) writeChunk(chunk *Chunk) (*Region, error) {
+	b, err := chunk.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	rx, ry := lvl.chunkToRegion(chunk.x, chunk.y)
+
+	reg, err := lvl.region(rx, ry, true)
+	if err != nil {
+		return nil, err
+	}
+
+	err = reg.WriteChunk(chunk.x&31, chunk.y&31, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return reg, nil
+}
+
+func (lvl *Anvil) chunk(x, y int) (*Chunk, bool) {
+	lvl.mutex.RLock()
+	chunk, ok := lvl.chunks[lvl.toIndex(x, y)]
+	lvl.mutex.RUnlock()
+
+	return chunk, ok
 }
 
 // Chunk returns a chunk.
-// If it's not loaded, loads the chunks.
-// If create is true, generates a chunk.
-func (lvl *Anvil) Chunk(x, y int, create bool) level.Chunk {
-	return nil
+// If a chunk is not loaded, it will be loaded
+func (lvl *Anvil) Chunk(x, y int) (level.Chunk, error) {
+	if !lvl.IsLoadedChunk(x, y) {
+		err := lvl.LoadChunk(x, y, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	chunk, ok := lvl.chunk(x, y)
+	if !ok {
+		return nil, errors.New("couldn't find the chunk")
+	}
+
+	return chunk, nil
 }
 
-// Chunks retuns loaded chunks.
-func (lvl *Anvil) Chunks() []level.Chunk {
-	return nil
+// LoadedChunks returns loaded chunks.
+func (lvl *Anvil) LoadedChunks() []level.Chunk {
+	lvl.mutex.RLock()
+
+	result := make([]level.Chunk, 0, len(lvl.chunks))
+	for _, chunk := range lvl.chunks {
+		result = append(result, chunk)
+	}
+
+	lvl.mutex.RUnlock()
+
+	return result
 }
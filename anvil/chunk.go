@@ -12,7 +12,12 @@ package anvil
 import (
 	"fmt"
 
+	"github.com/beito123/level"
+	"github.com/beito123/level/asset"
 	"github.com/beito123/level/block"
+	"github.com/beito123/level/blockentity"
+	"github.com/beito123/level/heightmap"
+	"github.com/beito123/level/util"
 
 	"github.com/beito123/nbt"
 )
@@ -20,27 +25,23 @@ import (
 // NewChunk returns new Chunk
 func NewChunk(x, y int, format ChunkFormat) *Chunk {
 	return &Chunk{
-		x:           x,
-		y:           y,
-		subChunks:   make([]*SubChunk, 16),
-		ChunkFormat: format,
+		x:             x,
+		y:             y,
+		heightMaps:    make(map[level.HeightMapType][]uint16),
+		biomes:        make([]int, 256),
+		subChunks:     make([]*SubChunk, 16),
+		ChunkFormat:   format,
+		blockEntities: blockentity.NewIndex(nil),
 	}
 }
 
 // ReadChunk returns new Chunk with CompoundTag
 func ReadChunk(x, y int, b []byte) (*Chunk, error) {
-	stream := nbt.NewStreamBytes(nbt.BigEndian, b)
-
-	tag, err := stream.ReadTag()
+	com, err := readChunkTag(b)
 	if err != nil {
 		return nil, err
 	}
 
-	com, ok := tag.(*nbt.Compound)
-	if !ok {
-		return nil, fmt.Errorf("level.anvil.region: expected to be CompoundTag, but it passed different tag(%sTag)", nbt.GetTagName(tag.ID()))
-	}
-
 	var format ChunkFormat = &ChunkFormatV112{}
 	if com.Has("DataVersion") {
 		ver, err := com.GetInt("DataVersion")
@@ -56,15 +57,38 @@ func ReadChunk(x, y int, b []byte) (*Chunk, error) {
 	return format.Read(com)
 }
 
-// Chunk is
+// readChunkTag reads CompoundTag of a chunk from bytes in region files
+func readChunkTag(b []byte) (*nbt.Compound, error) {
+	stream := nbt.NewStreamBytes(nbt.BigEndian, b)
+
+	tag, err := stream.ReadTag()
+	if err != nil {
+		return nil, err
+	}
+
+	com, ok := tag.(*nbt.Compound)
+	if !ok {
+		return nil, fmt.Errorf("level.anvil.region: expected to be CompoundTag, but it passed different tag(%sTag)", nbt.GetTagName(tag.ID()))
+	}
+
+	return com, nil
+}
+
+// Chunk is a block area which splits a world by 16x16
 type Chunk struct {
 	x int
 	y int
 
 	lastUpdate    int64
 	inhabitedTime int64
+	heightMaps    map[level.HeightMapType][]uint16
 	biomes        []int
 	subChunks     []*SubChunk
+	entities      []*nbt.Compound
+	blockEntities *blockentity.Index
+	ticks         []level.ScheduledTick
+
+	raw *nbt.Compound // the read root compound, unknown tags are kept when it's saved
 
 	ChunkFormat ChunkFormat
 }
@@ -79,6 +103,228 @@ func (chunk *Chunk) Y() int {
 	return chunk.y
 }
 
+// Edition returns the edition of minecraft using the chunk
+func (Chunk) Edition() asset.Edition {
+	return asset.JavaEdition
+}
+
+// SetX set x coordinate
+func (chunk *Chunk) SetX(x int) {
+	chunk.x = x
+}
+
+// SetY set y coordinate
+func (chunk *Chunk) SetY(y int) {
+	chunk.y = y
+}
+
+func (chunk *Chunk) atData2D(x, y int) int {
+	return y*16 + x
+}
+
+// Height returns the height of the highest block at chunk coordinate
+// If the heightmap of kind isn't computed yet, it's computed from blocks
+func (chunk *Chunk) Height(x, y int, kind level.HeightMapType) (height uint16, ok bool) {
+	heights, ok := chunk.heightMaps[kind]
+	if !ok {
+		var err error
+		heights, err = heightmap.Compute(chunk, kind)
+		if err != nil {
+			return 0, false
+		}
+
+		chunk.heightMaps[kind] = heights
+	}
+
+	return heights[chunk.atData2D(x, y)], true
+}
+
+// SetHeight sets the height of the highest block at chunk coordinate
+func (chunk *Chunk) SetHeight(x, y int, kind level.HeightMapType, height uint16) {
+	heights, ok := chunk.heightMaps[kind]
+	if !ok {
+		heights = make([]uint16, heightmap.Size)
+		chunk.heightMaps[kind] = heights
+	}
+
+	heights[chunk.atData2D(x, y)] = height
+}
+
+// RecalculateHeightMaps recomputes all heightmaps from blocks
+func (chunk *Chunk) RecalculateHeightMaps() error {
+	for kind := range chunk.heightMaps {
+		heights, err := heightmap.Compute(chunk, kind)
+		if err != nil {
+			return err
+		}
+
+		chunk.heightMaps[kind] = heights
+	}
+
+	return nil
+}
+
+// Biome returns biome
+// For chunks with 3d biomes, returns the biome at the surface
+func (chunk *Chunk) Biome(x, y int) byte {
+	if !chunk.has3DBiomes() {
+		index := chunk.atData2D(x, y)
+		if index >= len(chunk.biomes) {
+			return 0
+		}
+
+		return byte(chunk.biomes[index])
+	}
+
+	by := 0
+	if h, ok := chunk.Height(x, y, level.MotionBlocking); ok && h > 0 {
+		by = int(h) - 1
+	}
+
+	return chunk.BiomeAt(x, by, y)
+}
+
+// SetBiome set biome
+// For chunks with 3d biomes, sets the biome of the whole column
+func (chunk *Chunk) SetBiome(x, y int, biome byte) {
+	if !chunk.has3DBiomes() {
+		index := chunk.atData2D(x, y)
+		if index >= len(chunk.biomes) {
+			return
+		}
+
+		chunk.biomes[index] = int(biome)
+
+		return
+	}
+
+	for by := 0; by < len(chunk.subChunks)*16; by += 4 {
+		chunk.SetBiomeAt(x, by, y, biome)
+	}
+}
+
+// BiomeAt returns biome at chunk coordinate
+// For chunks with 2d biomes, y is ignored
+func (chunk *Chunk) BiomeAt(x, y, z int) byte {
+	if !chunk.has3DBiomes() {
+		return chunk.Biome(x, z)
+	}
+
+	index := atBiome3D(x, y, z)
+	if index < 0 || index >= len(chunk.biomes) {
+		return 0
+	}
+
+	return byte(chunk.biomes[index])
+}
+
+// SetBiomeAt sets biome at chunk coordinate
+// Biomes are stored by 4x4x4 blocks in chunks with 3d biomes, for chunks with 2d biomes, y is ignored
+func (chunk *Chunk) SetBiomeAt(x, y, z int, biome byte) {
+	if !chunk.has3DBiomes() {
+		chunk.SetBiome(x, z, biome)
+
+		return
+	}
+
+	index := atBiome3D(x, y, z)
+	if index < 0 || index >= len(chunk.biomes) {
+		return
+	}
+
+	chunk.biomes[index] = int(biome)
+}
+
+// has3DBiomes returns whether biomes of the chunk are stored by 4x4x4 blocks (v1.15 and after)
+func (chunk *Chunk) has3DBiomes() bool {
+	if len(chunk.biomes) != Biomes3DSize || chunk.raw == nil {
+		return false
+	}
+
+	ver, err := dataVersion(chunk.raw)
+
+	return err == nil && ver >= DataVersionV115
+}
+
+// atBiome3D returns a index for 3d biomes at chunk coordinate
+func atBiome3D(x, y, z int) int {
+	return ((y >> 2) << 4) | ((z >> 2) << 2) | (x >> 2)
+}
+
+// Entities returns entities of nbt data
+func (chunk *Chunk) Entities() []*nbt.Compound {
+	return chunk.entities
+}
+
+// SetEntities set entities of nbt data
+func (chunk *Chunk) SetEntities(entities []*nbt.Compound) {
+	chunk.entities = entities
+}
+
+// BlockEntities returns block entities of nbt data
+func (chunk *Chunk) BlockEntities() []*nbt.Compound {
+	return chunk.blockEntities.All()
+}
+
+// SetBlockEntities set block entities of nbt data
+func (chunk *Chunk) SetBlockEntities(entities []*nbt.Compound) {
+	chunk.blockEntities = blockentity.NewIndex(entities)
+}
+
+// BlockEntity returns a block entity at chunk coordinate
+func (chunk *Chunk) BlockEntity(x, y, z int) (*nbt.Compound, bool) {
+	return chunk.blockEntities.Get(chunk.x*16+x, y, chunk.y*16+z)
+}
+
+// SetBlockEntity sets a block entity at the coordinate (x, y, z) of nbt data
+// A block entity at the same coordinate is replaced
+func (chunk *Chunk) SetBlockEntity(com *nbt.Compound) error {
+	pos, ok := blockentity.PosOf(com)
+	if !ok {
+		return fmt.Errorf("level.anvil: the block entity doesn't have a coordinate")
+	}
+
+	if pos.X>>4 != chunk.x || pos.Z>>4 != chunk.y {
+		return fmt.Errorf("level.anvil: the block entity is out of the chunk")
+	}
+
+	return chunk.blockEntities.Set(com)
+}
+
+// RemoveBlockEntity removes a block entity at chunk coordinate
+// If the block entity isn't found, returns false
+func (chunk *Chunk) RemoveBlockEntity(x, y, z int) bool {
+	return chunk.blockEntities.Remove(chunk.x*16+x, y, chunk.y*16+z)
+}
+
+// removeOrphan removes a block entity at chunk coordinate if the block is changed to state
+func (chunk *Chunk) removeOrphan(x, y, z int, state level.BlockState) error {
+	if !chunk.blockEntities.Has(chunk.x*16+x, y, chunk.y*16+z) {
+		return nil
+	}
+
+	old, err := chunk.GetBlock(x, y, z)
+	if err != nil {
+		return err
+	}
+
+	if state == nil || old.Name() != state.Name() {
+		chunk.RemoveBlockEntity(x, y, z)
+	}
+
+	return nil
+}
+
+// ScheduledTicks returns scheduled block updates in the chunk
+func (chunk *Chunk) ScheduledTicks() []level.ScheduledTick {
+	return chunk.ticks
+}
+
+// SetScheduledTicks sets scheduled block updates in the chunk
+func (chunk *Chunk) SetScheduledTicks(ticks []level.ScheduledTick) {
+	chunk.ticks = ticks
+}
+
 // SubChunks returns sub chunks
 func (chunk *Chunk) SubChunks() []*SubChunk {
 	return chunk.subChunks
@@ -87,46 +333,258 @@ func (chunk *Chunk) SubChunks() []*SubChunk {
 // GetSubChunk returns a sub chunk at the y index
 // you can set 0-15 at y
 func (chunk *Chunk) GetSubChunk(y int) (*SubChunk, bool) {
-	if len(chunk.subChunks) >= y {
+	if y < 0 || y >= len(chunk.subChunks) {
 		return nil, false
 	}
 
-	return chunk.subChunks[y], true
+	return chunk.subChunks[y], chunk.subChunks[y] != nil
 }
 
 // AtSubChunk returns a sub chunk at the y (chunk coordinate)
 func (chunk *Chunk) AtSubChunk(y int) (*SubChunk, bool) {
-	index := y / 16
-	if len(chunk.subChunks) >= index {
-		return nil, false
+	return chunk.GetSubChunk(y / 16)
+}
+
+// BuildHeight returns the height of blocks which the chunk can store
+func (chunk *Chunk) BuildHeight() int {
+	return len(chunk.subChunks) * 16
+}
+
+// Vaild vailds a chunk coordinates
+func (chunk *Chunk) Vaild(x, y, z int) error {
+	if x < 0 || x > 15 || y < 0 || y >= len(chunk.subChunks)*16 || z < 0 || z > 15 {
+		return fmt.Errorf("level.anvil: invaild chunk coordinate")
 	}
 
-	return chunk.subChunks[index], true
+	return nil
 }
 
 // GetBlock gets a block at the xyz (chunk coordinate)
-func (chunk *Chunk) GetBlock(x, y, z int) (*block.Block, error) {
+func (chunk *Chunk) GetBlock(x, y, z int) (level.BlockState, error) {
+	err := chunk.Vaild(x, y, z)
+	if err != nil {
+		return nil, err
+	}
+
 	sub, ok := chunk.AtSubChunk(y)
 	if !ok {
-		return nil, nil
+		return NewBlockState("minecraft:air", nil), nil
+	}
+
+	return sub.AtBlock(x, y&15, z)
+}
+
+// SetBlock sets a block at the xyz (chunk coordinate)
+func (chunk *Chunk) SetBlock(x, y, z int, state level.BlockState) error {
+	err := chunk.Vaild(x, y, z)
+	if err != nil {
+		return err
 	}
 
-	bl, err := sub.AtBlock(x, y, z)
+	bs, err := FromBlockState(state)
 	if err != nil {
-		return nil, nil
+		return err
+	}
+
+	err = chunk.removeOrphan(x, y, z, bs)
+	if err != nil {
+		return err
+	}
+
+	err = chunk.atOrNewSubChunk(y).SetBlock(x, y&15, z, bs)
+	if err != nil {
+		return err
+	}
+
+	for kind, heights := range chunk.heightMaps {
+		err := heightmap.Update(chunk, heights, x, y, z, bs, kind)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetBlockAtLayer gets a block at the xyz (chunk coordinate) from the layer
+// LayerLiquid returns water if the block is waterlogged, otherwise air
+func (chunk *Chunk) GetBlockAtLayer(x, y, z, layer int) (level.BlockState, error) {
+	switch layer {
+	case level.LayerBlock:
+		return chunk.GetBlock(x, y, z)
+	case level.LayerLiquid:
+		bs, err := chunk.GetBlock(x, y, z)
+		if err != nil {
+			return nil, err
+		}
+
+		_, properties, ok := bs.ToBlockNameProperties()
+		if ok && properties[block.Waterlogged] == "true" {
+			return NewWaterBlockState(), nil
+		}
+
+		return NewBlockState("minecraft:air", nil), nil
 	}
 
-	return bl.ToBlockData(), nil
+	return nil, fmt.Errorf("level.anvil: unsupported layer %d", layer)
+}
+
+// SetBlockAtLayer sets a block at the xyz (chunk coordinate) to the layer
+// LayerLiquid only supports water and air, it changes waterlogged property of the block
+// If the block can't be waterlogged, water is set only in air
+func (chunk *Chunk) SetBlockAtLayer(x, y, z, layer int, state level.BlockState) error {
+	switch layer {
+	case level.LayerBlock:
+		return chunk.SetBlock(x, y, z, state)
+	case level.LayerLiquid:
+	default:
+		return fmt.Errorf("level.anvil: unsupported layer %d", layer)
+	}
+
+	water := state != nil && block.IsWater(state.Name())
+	if !water && state != nil && !block.IsAir(state.Name()) {
+		return fmt.Errorf("level.anvil: %s isn't supported in the liquid layer", state.Name())
+	}
+
+	bs, err := chunk.GetBlock(x, y, z)
+	if err != nil {
+		return err
+	}
+
+	name, properties, ok := bs.ToBlockNameProperties()
+	if !ok {
+		return nil
+	}
+
+	_, has := properties[block.Waterlogged]
+	if !has && !block.CanWaterlog(name) {
+		if water && block.IsAir(name) {
+			return chunk.SetBlock(x, y, z, NewWaterBlockState())
+		}
+
+		return nil
+	}
+
+	result := make(map[string]string, len(properties)+1)
+	for key, val := range properties {
+		result[key] = val
+	}
+
+	result[block.Waterlogged] = "false"
+	if water {
+		result[block.Waterlogged] = "true"
+	}
+
+	return chunk.SetBlock(x, y, z, NewBlockState(name, result))
+}
+
+// atOrNewSubChunk returns a sub chunk at the y, it's created if it doesn't exist
+// A missing sub chunk is open sky, so skylight of new sub chunks is 15
+func (chunk *Chunk) atOrNewSubChunk(y int) *SubChunk {
+	sub, ok := chunk.AtSubChunk(y)
+	if !ok {
+		sub = NewSubChunk(byte(y / 16))
+		for i := range sub.SkyLight {
+			sub.SkyLight[i] = 0xFF
+		}
+
+		chunk.subChunks[y/16] = sub
+	}
+
+	return sub
+}
+
+// BlockLight returns a blocklight at the xyz (chunk coordinate)
+func (chunk *Chunk) BlockLight(x, y, z int) (byte, error) {
+	err := chunk.Vaild(x, y, z)
+	if err != nil {
+		return 0, err
+	}
+
+	sub, ok := chunk.AtSubChunk(y)
+	if !ok || len(sub.BlockLight) == 0 {
+		return 0, nil
+	}
+
+	return sub.AtBlockLight(x, y&15, z)
+}
+
+// SetBlockLight sets a blocklight at the xyz (chunk coordinate)
+// If the subchunk doesn't exist and light is 0, it does nothing
+func (chunk *Chunk) SetBlockLight(x, y, z int, light byte) error {
+	err := chunk.Vaild(x, y, z)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := chunk.AtSubChunk(y); !ok && light == 0 {
+		return nil
+	}
+
+	return chunk.atOrNewSubChunk(y).SetBlockLight(x, y&15, z, light)
+}
+
+// SkyLight returns a skylight at the xyz (chunk coordinate)
+// If the subchunk doesn't exist, returns 15 (open sky)
+func (chunk *Chunk) SkyLight(x, y, z int) (byte, error) {
+	err := chunk.Vaild(x, y, z)
+	if err != nil {
+		return 0, err
+	}
+
+	sub, ok := chunk.AtSubChunk(y)
+	if !ok || len(sub.SkyLight) == 0 {
+		return 15, nil
+	}
+
+	return sub.AtSkyLight(x, y&15, z)
+}
+
+// SetSkyLight sets a skylight at the xyz (chunk coordinate)
+// If the subchunk doesn't exist and light is 15, it does nothing
+func (chunk *Chunk) SetSkyLight(x, y, z int, light byte) error {
+	err := chunk.Vaild(x, y, z)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := chunk.AtSubChunk(y); !ok && light == 15 {
+		return nil
+	}
+
+	return chunk.atOrNewSubChunk(y).SetSkyLight(x, y&15, z, light)
 }
 
 // Save saves the chunk, returns CompoundTag
 func (chunk *Chunk) Save() (*nbt.Compound, error) {
-	return nil, nil // TODO
+	if chunk.ChunkFormat == nil {
+		return nil, fmt.Errorf("level.anvil: the chunk format isn't set")
+	}
+
+	return chunk.ChunkFormat.Write(chunk)
+}
+
+// Bytes returns the chunk as nbt bytes
+func (chunk *Chunk) Bytes() ([]byte, error) {
+	com, err := chunk.Save()
+	if err != nil {
+		return nil, err
+	}
+
+	stream := nbt.NewStream(nbt.BigEndian)
+
+	err = stream.WriteTag(util.FixArrays(com))
+	if err != nil {
+		return nil, err
+	}
+
+	return stream.Bytes(), nil
 }
 
 // ChunkFormat is a chunk format for a version
 type ChunkFormat interface {
 	Read(tag *nbt.Compound) (*Chunk, error)
+	Write(chunk *Chunk) (*nbt.Compound, error)
 }
 
 // ChunkFormatV112 is a chunk format for v1.12
@@ -136,24 +594,11 @@ type ChunkFormatV112 struct {
 func (format *ChunkFormatV112) Read(tag *nbt.Compound) (*Chunk, error) {
 	chunk := NewChunk(0, 0, format)
 
-	com, err := tag.GetCompound("Level")
-	if err != nil {
-		return nil, err
-	}
-
-	xPos, err := com.GetInt("xPos")
-	if err != nil {
-		return nil, err
-	}
-
-	zPos, err := com.GetInt("zPos")
+	com, err := readLevel(chunk, tag)
 	if err != nil {
 		return nil, err
 	}
 
-	chunk.x = int(xPos)
-	chunk.y = int(zPos)
-
 	// Biomes
 	if com.Has("Biomes") {
 		biomes, err := com.GetByteArray("Biomes")
@@ -167,6 +612,26 @@ func (format *ChunkFormatV112) Read(tag *nbt.Compound) (*Chunk, error) {
 		}
 	}
 
+	// HeightMap
+	if com.Has("HeightMap") {
+		heightMap, err := com.GetIntArray("HeightMap")
+		if err != nil {
+			return nil, err
+		}
+
+		heights := make([]uint16, heightmap.Size)
+		for i := 0; i < len(heightMap) && i < len(heights); i++ {
+			heights[i] = uint16(heightMap[i])
+		}
+
+		chunk.heightMaps[level.MotionBlocking] = heights
+	}
+
+	err = readEntities(chunk, com)
+	if err != nil {
+		return nil, err
+	}
+
 	// Subchunks
 	sections, err := com.GetList("Sections")
 	if err != nil {
@@ -193,6 +658,45 @@ func (format *ChunkFormatV112) Read(tag *nbt.Compound) (*Chunk, error) {
 	return chunk, nil
 }
 
+// Write writes the chunk as CompoundTag
+func (format *ChunkFormatV112) Write(chunk *Chunk) (*nbt.Compound, error) {
+	root, com := writeLevel(chunk)
+
+	// Biomes
+	biomes := make([]byte, len(chunk.biomes))
+	for i, biome := range chunk.biomes {
+		biomes[i] = byte(biome)
+	}
+
+	com.Set(nbt.NewByteArrayTag("Biomes", biomes))
+
+	// HeightMap
+	heightMap := make([]int32, heightmap.Size)
+	for y := 0; y < 16; y++ {
+		for x := 0; x < 16; x++ {
+			h, _ := chunk.Height(x, y, level.MotionBlocking)
+			heightMap[chunk.atData2D(x, y)] = int32(h)
+		}
+	}
+
+	com.Set(nbt.NewIntArrayTag("HeightMap", heightMap))
+
+	if !com.Has("TerrainPopulated") {
+		com.Set(nbt.NewByteTag("TerrainPopulated", 1))
+	}
+
+	if !com.Has("LightPopulated") {
+		com.Set(nbt.NewByteTag("LightPopulated", 1))
+	}
+
+	err := writeSections(chunk, com, &SubChunkFormatV112{})
+	if err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
 // ChunkFormatV113 is a chunk format for v1.13
 type ChunkFormatV113 struct {
 }
@@ -200,24 +704,16 @@ type ChunkFormatV113 struct {
 func (format *ChunkFormatV113) Read(tag *nbt.Compound) (*Chunk, error) {
 	chunk := NewChunk(0, 0, format)
 
-	com, err := tag.GetCompound("Level")
-	if err != nil {
-		return nil, err
-	}
-
-	xPos, err := com.GetInt("xPos")
+	com, err := readLevel(chunk, tag)
 	if err != nil {
 		return nil, err
 	}
 
-	zPos, err := com.GetInt("zPos")
+	ver, err := dataVersion(tag)
 	if err != nil {
 		return nil, err
 	}
 
-	chunk.x = int(xPos)
-	chunk.y = int(zPos)
-
 	// Biomes
 	if com.Has("Biomes") {
 		biomes, err := com.GetIntArray("Biomes")
@@ -231,13 +727,39 @@ func (format *ChunkFormatV113) Read(tag *nbt.Compound) (*Chunk, error) {
 		}
 	}
 
+	// Heightmaps
+	if com.Has("Heightmaps") {
+		heightMaps, err := com.GetCompound("Heightmaps")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, kind := range level.HeightMapTypes {
+			if !heightMaps.Has(kind.Name()) {
+				continue
+			}
+
+			data, err := heightMaps.GetLongArray(kind.Name())
+			if err != nil {
+				return nil, err
+			}
+
+			chunk.heightMaps[kind] = UnpackBits(data, HeightMapBits, heightmap.Size, isSpanned(ver))
+		}
+	}
+
+	err = readEntities(chunk, com)
+	if err != nil {
+		return nil, err
+	}
+
 	// Subchunks
 	sections, err := com.GetList("Sections")
 	if err != nil {
 		return nil, err
 	}
 
-	subchunkFormat := &SubChunkFormatV113{}
+	subchunkFormat := &SubChunkFormatV113{DataVersion: ver}
 
 	chunk.subChunks = make([]*SubChunk, 16)
 	for _, entry := range sections {
@@ -254,5 +776,353 @@ func (format *ChunkFormatV113) Read(tag *nbt.Compound) (*Chunk, error) {
 		chunk.subChunks[sub.Y] = sub
 	}
 
-	return nil, nil
+	return chunk, nil
+}
+
+// Write writes the chunk as CompoundTag
+// Heightmaps and block states are packed with the format for DataVersion of the chunk
+func (format *ChunkFormatV113) Write(chunk *Chunk) (*nbt.Compound, error) {
+	root, com := writeLevel(chunk)
+
+	if !root.Has(TagDataVersion) {
+		root.Set(nbt.NewIntTag(TagDataVersion, DefaultDataVersion))
+	}
+
+	ver, err := dataVersion(root)
+	if err != nil {
+		return nil, err
+	}
+
+	// Biomes
+	biomes := make([]int32, len(chunk.biomes))
+	for i, biome := range chunk.biomes {
+		biomes[i] = int32(biome)
+	}
+
+	com.Set(nbt.NewIntArrayTag("Biomes", biomes))
+
+	// Heightmaps
+	heightMaps := nbt.NewCompoundTag("Heightmaps", make(map[string]nbt.Tag))
+	for kind, heights := range chunk.heightMaps {
+		heightMaps.Set(nbt.NewLongArrayTag(kind.Name(), PackBits(heights, HeightMapBits, isSpanned(ver))))
+	}
+
+	com.Set(heightMaps)
+
+	if !com.Has("Status") {
+		com.Set(nbt.NewStringTag("Status", "postprocessed"))
+	}
+
+	err = writeSections(chunk, com, &SubChunkFormatV113{DataVersion: ver})
+	if err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+// dataVersion returns DataVersion of a root compound of chunks
+// If it doesn't have DataVersion, returns DataVersionV113
+func dataVersion(root *nbt.Compound) (int, error) {
+	if !root.Has(TagDataVersion) {
+		return DataVersionV113, nil
+	}
+
+	ver, err := root.GetInt(TagDataVersion)
+
+	return int(ver), err
+}
+
+// isSpanned returns whether values are spanned over longs in packed arrays of the DataVersion
+func isSpanned(ver int) bool {
+	return ver < DataVersionV116
+}
+
+// PackedLength returns the number of longs to pack count values
+// If spanned is true, values are spanned over longs (v1.13-v1.15)
+func PackedLength(count int, bits int, spanned bool) int {
+	if spanned {
+		return (count*bits + 63) / 64
+	}
+
+	perLong := 64 / bits
+
+	return (count + perLong - 1) / perLong
+}
+
+// HeightMapBits is bits per a entry of heightmaps in mcje v1.13 or after
+const HeightMapBits = 9
+
+// UnpackBits unpacks values packed to longs
+// If spanned is true, values are spanned over longs (v1.13-v1.15)
+func UnpackBits(data []int64, bits int, count int, spanned bool) []uint16 {
+	result := make([]uint16, count)
+	mask := uint64((1 << uint(bits)) - 1)
+
+	longBits := 64
+	perLong := longBits / bits
+
+	for i := 0; i < count; i++ {
+		var index, off int
+		if spanned {
+			index = (i * bits) / longBits
+			off = (i * bits) % longBits
+		} else {
+			index = i / perLong
+			off = (i % perLong) * bits
+		}
+
+		if index >= len(data) {
+			break
+		}
+
+		value := uint64(data[index]) >> uint(off)
+		if spanned && off+bits > longBits && index+1 < len(data) {
+			value |= uint64(data[index+1]) << uint(longBits-off)
+		}
+
+		result[i] = uint16(value & mask)
+	}
+
+	return result
+}
+
+// PackBits packs values to longs
+// If spanned is true, values are spanned over longs (v1.13-v1.15)
+func PackBits(values []uint16, bits int, spanned bool) []int64 {
+	longBits := 64
+	perLong := longBits / bits
+	length := PackedLength(len(values), bits, spanned)
+
+	data := make([]uint64, length)
+	mask := uint64((1 << uint(bits)) - 1)
+
+	for i, v := range values {
+		value := uint64(v) & mask
+
+		var index, off int
+		if spanned {
+			index = (i * bits) / longBits
+			off = (i * bits) % longBits
+		} else {
+			index = i / perLong
+			off = (i % perLong) * bits
+		}
+
+		data[index] |= value << uint(off)
+		if spanned && off+bits > longBits {
+			data[index+1] |= value >> uint(longBits-off)
+		}
+	}
+
+	result := make([]int64, length)
+	for i, v := range data {
+		result[i] = int64(v)
+	}
+
+	return result
+}
+
+// readLevel reads common values from a root compound, returns Level compound
+func readLevel(chunk *Chunk, tag *nbt.Compound) (*nbt.Compound, error) {
+	com, err := tag.GetCompound("Level")
+	if err != nil {
+		return nil, err
+	}
+
+	xPos, err := com.GetInt("xPos")
+	if err != nil {
+		return nil, err
+	}
+
+	zPos, err := com.GetInt("zPos")
+	if err != nil {
+		return nil, err
+	}
+
+	chunk.x = int(xPos)
+	chunk.y = int(zPos)
+
+	if com.Has("LastUpdate") {
+		chunk.lastUpdate, err = com.GetLong("LastUpdate")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if com.Has("InhabitedTime") {
+		chunk.inhabitedTime, err = com.GetLong("InhabitedTime")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = readTicks(chunk, com)
+	if err != nil {
+		return nil, err
+	}
+
+	chunk.raw = tag
+
+	return com, nil
+}
+
+// readTicks reads TileTicks and LiquidTicks
+func readTicks(chunk *Chunk, com *nbt.Compound) error {
+	chunk.ticks = nil
+
+	for _, name := range []string{"TileTicks", "LiquidTicks"} {
+		if !com.Has(name) {
+			continue
+		}
+
+		list, err := util.ReadCompounds(com, name)
+		if err != nil {
+			return err
+		}
+
+		for _, tick := range list {
+			id, err := tick.GetString("i")
+			if err != nil {
+				return err
+			}
+
+			var values [5]int32
+			for i, key := range []string{"x", "y", "z", "t", "p"} {
+				if key == "p" && !tick.Has(key) { // before v1.8
+					continue
+				}
+
+				values[i], err = tick.GetInt(key)
+				if err != nil {
+					return err
+				}
+			}
+
+			chunk.ticks = append(chunk.ticks, level.ScheduledTick{
+				X:        int(values[0]),
+				Y:        int(values[1]),
+				Z:        int(values[2]),
+				Block:    NewBlockState(id, nil),
+				Delay:    int(values[3]),
+				Priority: int(values[4]),
+				Liquid:   name == "LiquidTicks",
+			})
+		}
+	}
+
+	return nil
+}
+
+// writeTicks writes TileTicks and LiquidTicks
+func writeTicks(chunk *Chunk, com *nbt.Compound) {
+	var blocks, liquids []*nbt.Compound
+	for _, tick := range chunk.ticks {
+		var id string
+		if tick.Block != nil {
+			id = tick.Block.Name()
+		}
+
+		tag := nbt.NewCompoundTag("", map[string]nbt.Tag{
+			"i": nbt.NewStringTag("i", id),
+			"x": nbt.NewIntTag("x", int32(tick.X)),
+			"y": nbt.NewIntTag("y", int32(tick.Y)),
+			"z": nbt.NewIntTag("z", int32(tick.Z)),
+			"t": nbt.NewIntTag("t", int32(tick.Delay)),
+			"p": nbt.NewIntTag("p", int32(tick.Priority)),
+		})
+
+		if tick.Liquid {
+			liquids = append(liquids, tag)
+		} else {
+			blocks = append(blocks, tag)
+		}
+	}
+
+	delete(com.Value, "TileTicks")
+	delete(com.Value, "LiquidTicks")
+
+	if len(blocks) > 0 {
+		com.Set(util.WriteCompounds("TileTicks", blocks))
+	}
+
+	if len(liquids) > 0 {
+		com.Set(util.WriteCompounds("LiquidTicks", liquids))
+	}
+}
+
+// writeLevel returns a root compound and Level compound with common values
+// Tags of the read compound are copied
+func writeLevel(chunk *Chunk) (root *nbt.Compound, com *nbt.Compound) {
+	root = nbt.NewCompoundTag("", make(map[string]nbt.Tag))
+	com = nbt.NewCompoundTag("Level", make(map[string]nbt.Tag))
+
+	if chunk.raw != nil {
+		for name, tag := range chunk.raw.Value {
+			root.Value[name] = tag
+		}
+
+		raw, err := chunk.raw.GetCompound("Level")
+		if err == nil {
+			for name, tag := range raw.Value {
+				com.Value[name] = tag
+			}
+		}
+	}
+
+	com.Set(nbt.NewIntTag("xPos", int32(chunk.x)))
+	com.Set(nbt.NewIntTag("zPos", int32(chunk.y)))
+	com.Set(nbt.NewLongTag("LastUpdate", chunk.lastUpdate))
+	com.Set(nbt.NewLongTag("InhabitedTime", chunk.inhabitedTime))
+
+	com.Set(util.WriteCompounds("Entities", chunk.entities))
+	com.Set(util.WriteCompounds("TileEntities", chunk.blockEntities.All()))
+
+	writeTicks(chunk, com)
+
+	root.Set(com)
+
+	return root, com
+}
+
+// writeSections writes sub chunks as Sections
+func writeSections(chunk *Chunk, com *nbt.Compound, format SubChunkFormat) error {
+	var sections []nbt.Tag
+	for _, sub := range chunk.subChunks {
+		if sub == nil {
+			continue
+		}
+
+		sec, err := format.Write(sub)
+		if err != nil {
+			return err
+		}
+
+		sections = append(sections, sec)
+	}
+
+	com.Set(nbt.NewListTag("Sections", sections, nbt.IDTagCompound))
+
+	return nil
+}
+
+// readEntities reads entities and block entities from Level compound
+func readEntities(chunk *Chunk, com *nbt.Compound) (err error) {
+	if com.Has("Entities") {
+		chunk.entities, err = util.ReadCompounds(com, "Entities")
+		if err != nil {
+			return err
+		}
+	}
+
+	if com.Has("TileEntities") {
+		entities, err := util.ReadCompounds(com, "TileEntities")
+		if err != nil {
+			return err
+		}
+
+		chunk.blockEntities = blockentity.NewIndex(entities)
+	}
+
+	return nil
 }
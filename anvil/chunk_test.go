@@ -0,0 +1,126 @@
+package anvil
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/heightmap"
+	"github.com/beito123/nbt"
+)
+
+func TestPackBits(t *testing.T) {
+	tests := []struct {
+		bits    int
+		count   int
+		spanned bool
+		length  int
+	}{
+		{bits: 4, count: 4096, spanned: true, length: 256},
+		{bits: 4, count: 4096, spanned: false, length: 256},
+		{bits: 5, count: 4096, spanned: true, length: 320},
+		{bits: 5, count: 4096, spanned: false, length: 342},
+		{bits: 14, count: 4096, spanned: true, length: 896},
+		{bits: 14, count: 4096, spanned: false, length: 1024},
+		{bits: HeightMapBits, count: heightmap.Size, spanned: true, length: 36},
+		{bits: HeightMapBits, count: heightmap.Size, spanned: false, length: 37},
+	}
+
+	for _, test := range tests {
+		values := make([]uint16, test.count)
+		for i := range values {
+			values[i] = uint16((i * 7) % (1 << uint(test.bits)))
+		}
+
+		data := PackBits(values, test.bits, test.spanned)
+		if len(data) != test.length {
+			t.Errorf("bits: %d, spanned: %t: expected %d longs, but got %d", test.bits, test.spanned, test.length, len(data))
+		}
+
+		got := UnpackBits(data, test.bits, test.count, test.spanned)
+		if !reflect.DeepEqual(got, values) {
+			t.Errorf("bits: %d, spanned: %t: unpacked values are different from packed values", test.bits, test.spanned)
+		}
+	}
+}
+
+func TestPackBitsLayout(t *testing.T) {
+	values := make([]uint16, 13)
+	values[12] = 31 // the 13th value of 5 bits crosses the first long
+
+	spanned := PackBits(values, 5, true)
+	if spanned[0] != -1<<60 || spanned[1] != 1 {
+		t.Errorf("spanned: expected the value over two longs, but got %x", spanned)
+	}
+
+	padded := PackBits(values, 5, false)
+	if padded[0] != 0 || padded[1] != 31 {
+		t.Errorf("not spanned: expected the value at the next long, but got %x", padded)
+	}
+}
+
+func TestIsSpanned(t *testing.T) {
+	if !isSpanned(DataVersionV116 - 1) {
+		t.Errorf("DataVersion %d should be spanned", DataVersionV116-1)
+	}
+
+	if isSpanned(DataVersionV116) {
+		t.Errorf("DataVersion %d shouldn't be spanned", DataVersionV116)
+	}
+}
+
+func TestChunkReadWrite(t *testing.T) {
+	for _, ver := range []int{DataVersionV116 - 1, DataVersionV116} {
+		chunk := NewChunk(1, 2, &ChunkFormatV113{})
+		chunk.raw = nbt.NewCompoundTag("", map[string]nbt.Tag{
+			TagDataVersion: nbt.NewIntTag(TagDataVersion, int32(ver)),
+		})
+
+		// 20 states need 5 bits per block
+		for i := 0; i < 20; i++ {
+			err := chunk.SetBlock(i%16, 3+i/16, 5, NewBlockState("minecraft:stone", map[string]string{
+				"test": strconv.Itoa(i),
+			}))
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		com, err := (&ChunkFormatV113{}).Write(chunk)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		read, err := (&ChunkFormatV113{}).Read(com)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < 20; i++ {
+			bs, err := read.GetBlock(i%16, 3+i/16, 5)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, properties, _ := bs.ToBlockNameProperties()
+			if bs.Name() != "minecraft:stone" || properties["test"] != strconv.Itoa(i) {
+				t.Errorf("DataVersion %d: expected minecraft:stone[test=%d], but got %s%v", ver, i, bs.Name(), properties)
+			}
+		}
+
+		h, _ := read.Height(0, 5, level.MotionBlocking) // the highest block is at y = 4
+		if h != 5 {
+			t.Errorf("DataVersion %d: expected height 5, but got %d", ver, h)
+		}
+	}
+}
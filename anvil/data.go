@@ -0,0 +1,145 @@
+package anvil
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/util"
+	"github.com/beito123/nbt"
+)
+
+// DefaultProperties returns the default properties for level.dat
+func DefaultProperties() *Properties {
+	return &Properties{
+		Data: &nbt.Compound{
+			Value: map[string]nbt.Tag{
+				TagLevelName:   nbt.NewStringTag(TagLevelName, ""),
+				TagGameType:    nbt.NewIntTag(TagGameType, int32(level.Survival)),
+				TagSpawnX:      nbt.NewIntTag(TagSpawnX, 0),
+				TagSpawnY:      nbt.NewIntTag(TagSpawnY, 0),
+				TagSpawnZ:      nbt.NewIntTag(TagSpawnZ, 0),
+				TagVersion:     nbt.NewIntTag(TagVersion, 19133), // anvil
+				TagDataVersion: nbt.NewIntTag(TagDataVersion, DefaultDataVersion),
+			},
+		},
+	}
+}
+
+var (
+	TagData        = "Data"
+	TagLevelName   = "LevelName"
+	TagGameType    = "GameType"
+	TagSpawnX      = "SpawnX"
+	TagSpawnY      = "SpawnY"
+	TagSpawnZ      = "SpawnZ"
+	TagVersion     = "version"
+	TagDataVersion = "DataVersion"
+)
+
+// LoadLevelData loads properties from level.dat
+func LoadLevelData(path string) (*Properties, error) {
+	stream, err := nbt.FromFile(filepath.Join(path, LevelDataFile), nbt.BigEndian)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := stream.ReadTag()
+	if err != nil {
+		return nil, err
+	}
+
+	root, ok := tag.(*nbt.Compound)
+	if !ok {
+		return nil, errors.New("unexpected " + nbt.GetTagName(tag.ID()) + "Tag, expected CompoundTag")
+	}
+
+	data, err := root.GetCompound(TagData)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Properties{
+		Data: data,
+	}, nil
+}
+
+// SaveLevelData saves properties to level.dat
+func SaveLevelData(path string, pro *Properties) error {
+	stream := nbt.NewStream(nbt.BigEndian)
+
+	err := stream.WriteTag(util.FixArrays(nbt.NewCompoundTag("", map[string]nbt.Tag{
+		TagData: pro.Data,
+	})))
+	if err != nil {
+		return err
+	}
+
+	b, err := nbt.Compress(stream, nbt.CompressGZip, nbt.DefaultCompressionLevel)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(path, LevelDataFile), b, os.ModePerm)
+}
+
+// Properties is data of level from level.dat
+type Properties struct {
+	Data *nbt.Compound
+}
+
+// Detect returns whether the directory is a anvil level
+// It needs .mca region files, or a gzipped level.dat without .mcr region files for new levels
+func Detect(path string) bool {
+	if !isGZip(filepath.Join(path, LevelDataFile)) {
+		return false
+	}
+
+	if hasRegionFiles(path, ".mca") {
+		return true
+	}
+
+	return !hasRegionFiles(path, ".mcr")
+}
+
+// DetectMCRegion returns whether the directory is a mcregion level
+// Levels which have both .mcr and .mca region files are converted to anvil, so they aren't mcregion
+func DetectMCRegion(path string) bool {
+	return isGZip(filepath.Join(path, LevelDataFile)) &&
+		hasRegionFiles(path, ".mcr") && !hasRegionFiles(path, ".mca")
+}
+
+// isGZip returns whether the file is compressed by gzip
+func isGZip(file string) bool {
+	f, err := os.Open(file)
+	if err != nil {
+		return false
+	}
+
+	defer f.Close()
+
+	header := make([]byte, 2)
+
+	_, err = io.ReadFull(f, header)
+
+	return err == nil && header[0] == 0x1f && header[1] == 0x8b
+}
+
+// hasRegionFiles returns whether the overworld has region files with the extension
+func hasRegionFiles(path string, ext string) bool {
+	files, err := filepath.Glob(filepath.Join(path, RegionPaths[level.OverWorld], "r.*.*"+ext))
+
+	return err == nil && len(files) > 0
+}
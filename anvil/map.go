@@ -0,0 +1,228 @@
+package anvil
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/beito123/level/asset"
+	"github.com/beito123/level/mapitem"
+	"github.com/beito123/level/util"
+	"github.com/beito123/nbt"
+)
+
+const (
+	// DataDir is a directory of saved data such as maps
+	DataDir = "data"
+
+	// IDCountsFile is a file of the last used ids
+	IDCountsFile = "idcounts.dat"
+)
+
+// mapPath returns the location of the map file
+func (lvl *Anvil) mapPath(id int64) string {
+	return filepath.Join(lvl.path, DataDir, "map_"+strconv.FormatInt(id, 10)+".dat")
+}
+
+// readData reads a gzipped nbt file and returns the data compound
+func readData(path string) (*nbt.Compound, error) {
+	stream, err := nbt.FromFile(path, nbt.BigEndian)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := stream.ReadTag()
+	if err != nil {
+		return nil, err
+	}
+
+	com, ok := tag.(*nbt.Compound)
+	if !ok {
+		return nil, errors.New("level.anvil: invaild data file")
+	}
+
+	return com.GetCompound(TagData)
+}
+
+// writeData writes the data compound to a gzipped nbt file
+func writeData(path string, data *nbt.Compound, version int) error {
+	data.SetName(TagData)
+
+	stream := nbt.NewStream(nbt.BigEndian)
+
+	err := stream.WriteTag(util.FixArrays(nbt.NewCompoundTag("", map[string]nbt.Tag{
+		TagData:        data,
+		TagDataVersion: nbt.NewIntTag(TagDataVersion, int32(version)),
+	})))
+	if err != nil {
+		return err
+	}
+
+	b, err := nbt.Compress(stream, nbt.CompressGZip, nbt.DefaultCompressionLevel)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, b, os.ModePerm)
+}
+
+// dataVersion returns DataVersion of level.dat
+func (lvl *Anvil) dataVersion() int {
+	tag, ok := lvl.Property(TagDataVersion)
+	if !ok {
+		return DefaultDataVersion
+	}
+
+	ver, err := tag.ToInt()
+	if err != nil {
+		return DefaultDataVersion
+	}
+
+	return ver
+}
+
+// MapIDs returns ids of all maps sorted
+func (lvl *Anvil) MapIDs() ([]int64, error) {
+	files, err := ioutil.ReadDir(filepath.Join(lvl.path, DataDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasPrefix(name, "map_") || !strings.HasSuffix(name, ".dat") {
+			continue
+		}
+
+		id, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(name, "map_"), ".dat"), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	return ids, nil
+}
+
+// Map returns a map by id
+// If the map isn't found, returns false for ok
+func (lvl *Anvil) Map(id int64) (*mapitem.Map, bool, error) {
+	path := lvl.mapPath(id)
+	if !util.ExistFile(path) {
+		return nil, false, nil
+	}
+
+	data, err := readData(path)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return mapitem.Wrap(data, asset.JavaEdition, id), true, nil
+}
+
+// Maps returns all maps
+func (lvl *Anvil) Maps() ([]*mapitem.Map, error) {
+	ids, err := lvl.MapIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	maps := make([]*mapitem.Map, 0, len(ids))
+	for _, id := range ids {
+		m, ok, err := lvl.Map(id)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			maps = append(maps, m)
+		}
+	}
+
+	return maps, nil
+}
+
+// SaveMap saves the map
+func (lvl *Anvil) SaveMap(m *mapitem.Map) error {
+	return writeData(lvl.mapPath(m.ID), m.Compound, lvl.dataVersion())
+}
+
+// DeleteMap deletes the map
+func (lvl *Anvil) DeleteMap(id int64) error {
+	err := os.Remove(lvl.mapPath(id))
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	return err
+}
+
+// NewMapID returns a unused map id and records it to idcounts.dat
+func (lvl *Anvil) NewMapID() (int64, error) {
+	path := filepath.Join(lvl.path, DataDir, IDCountsFile)
+
+	data := nbt.NewCompoundTag(TagData, make(map[string]nbt.Tag))
+	if util.ExistFile(path) {
+		var err error
+		data, err = readData(path)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	var id int64
+	if tag, ok := data.Get("map"); ok {
+		last, err := tag.ToInt()
+		if err != nil {
+			return 0, err
+		}
+
+		id = int64(last) + 1
+	}
+
+	ids, err := lvl.MapIDs()
+	if err != nil {
+		return 0, err
+	}
+
+	if len(ids) > 0 && ids[len(ids)-1] >= id {
+		id = ids[len(ids)-1] + 1
+	}
+
+	data.Set(nbt.NewIntTag("map", int32(id)))
+
+	err = writeData(path, data, lvl.dataVersion())
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
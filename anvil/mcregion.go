@@ -0,0 +1,266 @@
+package anvil
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/block"
+	"github.com/beito123/level/heightmap"
+	"github.com/beito123/nbt"
+)
+
+const (
+	// MCRegionHeight is the height of chunks in mcregion
+	MCRegionHeight = 128
+
+	// mcregionBlocks is the number of blocks in a chunk of mcregion
+	mcregionBlocks = 16 * 16 * MCRegionHeight
+)
+
+// ChunkFormatMCRegion is a chunk format for mcregion (mcje beta 1.3 to v1.1)
+// Blocks are stored in a chunk without sections, and the height is 128
+type ChunkFormatMCRegion struct {
+}
+
+// mcregionIndex returns the index of blocks in mcregion from xyz (chunk coordinate)
+func mcregionIndex(x, y, z int) int {
+	return x<<11 | z<<7 | y
+}
+
+func (format *ChunkFormatMCRegion) Read(tag *nbt.Compound) (*Chunk, error) {
+	chunk := NewChunk(0, 0, format)
+
+	com, err := readLevel(chunk, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	err = readEntities(chunk, com)
+	if err != nil {
+		return nil, err
+	}
+
+	// HeightMap
+	if com.Has("HeightMap") {
+		heightMap, err := com.GetByteArray("HeightMap")
+		if err != nil {
+			return nil, err
+		}
+
+		heights := make([]uint16, heightmap.Size)
+		for i := 0; i < len(heightMap) && i < len(heights); i++ {
+			heights[i] = uint16(heightMap[i])
+		}
+
+		chunk.heightMaps[level.MotionBlocking] = heights
+	}
+
+	blocks, err := com.GetByteArray("Blocks")
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := com.GetByteArray("Data")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(blocks) < mcregionBlocks || len(data) < mcregionBlocks/2 {
+		return nil, fmt.Errorf("level.anvil: invaild length of blocks of mcregion")
+	}
+
+	var skyLight, blockLight []byte
+	if com.Has("SkyLight") {
+		skyLight, err = com.GetByteArray("SkyLight")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if com.Has("BlockLight") {
+		blockLight, err = com.GetByteArray("BlockLight")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for sy := 0; sy < MCRegionHeight/16; sy++ {
+		sub := readMCRegionSection(sy, blocks, data, skyLight, blockLight)
+		if sub != nil {
+			chunk.subChunks[sy] = sub
+		}
+	}
+
+	return chunk, nil
+}
+
+// readMCRegionSection returns a subchunk at y from blocks of mcregion
+// If the subchunk has only air, returns nil
+func readMCRegionSection(sy int, blocks, data, skyLight, blockLight []byte) *SubChunk {
+	sub := &SubChunk{
+		Y: byte(sy),
+		Palette: []*BlockState{
+			0: NewLegacyBlockState(0, 0), // air
+		},
+		Blocks: make([]uint16, 4096),
+	}
+
+	hasLight := len(skyLight) >= mcregionBlocks/2 && len(blockLight) >= mcregionBlocks/2
+	if hasLight {
+		sub.SkyLight = make([]byte, 2048)
+		sub.BlockLight = make([]byte, 2048)
+	}
+
+	empty := true
+	for y := 0; y < 16; y++ {
+		for z := 0; z < 16; z++ {
+			for x := 0; x < 16; x++ {
+				i := y<<8 | z<<4 | x
+				mi := mcregionIndex(x, sy<<4|y, z)
+
+				if hasLight {
+					SetNibble(sub.SkyLight, i, ToNibble(skyLight, mi))
+					SetNibble(sub.BlockLight, i, ToNibble(blockLight, mi))
+				}
+
+				if blocks[mi] == 0 {
+					continue
+				}
+
+				empty = false
+
+				state := NewLegacyBlockState(blocks[mi], ToNibble(data, mi))
+
+				index := -1
+				for ind, val := range sub.Palette { // find palette
+					if val.Equal(state) {
+						index = ind
+						break
+					}
+				}
+
+				if index == -1 {
+					index = len(sub.Palette) // next index
+					sub.Palette = append(sub.Palette, state)
+				}
+
+				sub.Blocks[i] = uint16(index)
+			}
+		}
+	}
+
+	if empty {
+		return nil
+	}
+
+	return sub
+}
+
+// Write writes the chunk as CompoundTag
+// If a block above MCRegionHeight isn't air or a block can't be converted to a old block id, returns an error
+func (format *ChunkFormatMCRegion) Write(chunk *Chunk) (*nbt.Compound, error) {
+	for sy := MCRegionHeight / 16; sy < len(chunk.subChunks); sy++ {
+		sub := chunk.subChunks[sy]
+		if sub == nil {
+			continue
+		}
+
+		palette, _, err := sub.compact()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, bs := range palette {
+			if !block.IsAir(bs.Name()) {
+				return nil, fmt.Errorf("level.anvil: %s is above the height of mcregion (%d)", bs.Name(), MCRegionHeight)
+			}
+		}
+	}
+
+	root, com := writeLevel(chunk)
+
+	blocks := make([]byte, mcregionBlocks)
+	data := make([]byte, mcregionBlocks/2)
+	skyLight := make([]byte, mcregionBlocks/2)
+	blockLight := make([]byte, mcregionBlocks/2)
+
+	for sy := 0; sy < MCRegionHeight/16; sy++ {
+		sub := chunk.subChunks[sy]
+		if sub == nil {
+			for z := 0; z < 16; z++ {
+				for x := 0; x < 16; x++ {
+					for y := 0; y < 16; y++ {
+						SetNibble(skyLight, mcregionIndex(x, sy<<4|y, z), 15)
+					}
+				}
+			}
+
+			continue
+		}
+
+		palette, indexes, err := sub.compact()
+		if err != nil {
+			return nil, err
+		}
+
+		ids := make([]byte, len(palette))
+		metas := make([]byte, len(palette))
+		for i, bs := range palette {
+			id, meta, ok := bs.ToLegacy()
+			if !ok {
+				return nil, fmt.Errorf("level.anvil: couldn't convert %s to a old block id", block.FormatState(bs.Name(), bs.Properties()))
+			}
+
+			ids[i] = id
+			metas[i] = meta
+		}
+
+		sky := sub.lightOrEmpty(sub.SkyLight, 15)
+		light := sub.lightOrEmpty(sub.BlockLight, 0)
+
+		for i, index := range indexes {
+			x, y, z := i&15, sub.Y<<4|byte(i>>8), (i>>4)&15
+			mi := mcregionIndex(x, int(y), z)
+
+			blocks[mi] = ids[index]
+			SetNibble(data, mi, metas[index])
+			SetNibble(skyLight, mi, ToNibble(sky, i))
+			SetNibble(blockLight, mi, ToNibble(light, i))
+		}
+	}
+
+	heightMap := make([]byte, heightmap.Size)
+	for y := 0; y < 16; y++ {
+		for x := 0; x < 16; x++ {
+			h, _ := chunk.Height(x, y, level.MotionBlocking)
+			if h > MCRegionHeight {
+				h = MCRegionHeight
+			}
+
+			heightMap[chunk.atData2D(x, y)] = byte(h)
+		}
+	}
+
+	delete(com.Value, "Sections")
+
+	com.Set(nbt.NewByteArrayTag("Blocks", blocks))
+	com.Set(nbt.NewByteArrayTag("Data", data))
+	com.Set(nbt.NewByteArrayTag("SkyLight", skyLight))
+	com.Set(nbt.NewByteArrayTag("BlockLight", blockLight))
+	com.Set(nbt.NewByteArrayTag("HeightMap", heightMap))
+
+	if !com.Has("TerrainPopulated") {
+		com.Set(nbt.NewByteTag("TerrainPopulated", 1))
+	}
+
+	return root, nil
+}
@@ -0,0 +1,112 @@
+package anvil
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/beito123/level/util"
+	"github.com/beito123/nbt"
+)
+
+// PlayerDataDir is a directory of player data
+const PlayerDataDir = "playerdata"
+
+// playerPath returns the location of the player file
+func (lvl *Anvil) playerPath(uuid string) string {
+	return filepath.Join(lvl.path, PlayerDataDir, uuid+".dat")
+}
+
+// Player returns nbt data of the player by the uuid such as "069a79f4-44e9-4726-a5be-fca90e38aaf5"
+// If the player isn't found, returns false for ok
+func (lvl *Anvil) Player(uuid string) (com *nbt.Compound, ok bool, err error) {
+	path := lvl.playerPath(uuid)
+	if !util.ExistFile(path) {
+		return nil, false, nil
+	}
+
+	stream, err := nbt.FromFile(path, nbt.BigEndian)
+	if err != nil {
+		return nil, false, err
+	}
+
+	tag, err := stream.ReadTag()
+	if err != nil {
+		return nil, false, err
+	}
+
+	com, ok = tag.(*nbt.Compound)
+	if !ok {
+		return nil, false, errors.New("level.anvil: invaild player file")
+	}
+
+	return com, true, nil
+}
+
+// Players returns nbt data of all players by uuid
+func (lvl *Anvil) Players() (map[string]*nbt.Compound, error) {
+	files, err := ioutil.ReadDir(filepath.Join(lvl.path, PlayerDataDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	players := make(map[string]*nbt.Compound)
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasSuffix(name, ".dat") {
+			continue
+		}
+
+		uuid := strings.TrimSuffix(name, ".dat")
+
+		com, ok, err := lvl.Player(uuid)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			players[uuid] = com
+		}
+	}
+
+	return players, nil
+}
+
+// SavePlayer saves nbt data of the player by the uuid
+func (lvl *Anvil) SavePlayer(uuid string, com *nbt.Compound) error {
+	stream := nbt.NewStream(nbt.BigEndian)
+
+	err := stream.WriteTag(util.FixArrays(com))
+	if err != nil {
+		return err
+	}
+
+	b, err := nbt.Compress(stream, nbt.CompressGZip, nbt.DefaultCompressionLevel)
+	if err != nil {
+		return err
+	}
+
+	path := lvl.playerPath(uuid)
+
+	err = os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, b, os.ModePerm)
+}
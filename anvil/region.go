@@ -19,6 +19,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"time"
 
 	"github.com/beito123/level/binary"
 	"github.com/beito123/level/util"
@@ -141,12 +142,18 @@ const (
 
 // NewRegion returns new Region with xy
 func NewRegion(x, y int) *Region {
+	locations := make([]*Location, ChunkCount)
+	for i := range locations {
+		locations[i] = &Location{}
+	}
+
 	return &Region{
 		X:          x,
 		Y:          y,
 		Data:       make([]byte, InformationSector),
-		Locations:  make([]*Location, ChunkCount),
+		Locations:  locations,
 		Timestamps: make([]int32, ChunkCount),
+		pending:    make(map[int][]byte),
 	}
 }
 
@@ -159,6 +166,8 @@ type Region struct {
 
 	Locations  []*Location
 	Timestamps []int32
+
+	pending map[int][]byte // written chunk records which aren't saved yet, nil for deleted chunks
 }
 
 func (Region) vaild(x, y int) error {
@@ -220,10 +229,192 @@ func (reg *Region) Load(b []byte) error {
 }
 
 // Save saves region data, returns bytes for a region file
+// Chunks written by WriteChunk are stored
 func (reg *Region) Save() ([]byte, error) {
-	// TODO: write
+	header := binary.NewStream()
+	body := binary.NewStream()
+
+	timestamps := make([]int32, ChunkCount)
+	copy(timestamps, reg.Timestamps)
+
+	now := int32(time.Now().Unix())
+
+	sector := InformationSector / Sector
+	for i := 0; i < ChunkCount; i++ {
+		record, ok := reg.pending[i]
+		if ok {
+			timestamps[i] = now
+			if record == nil { // deleted
+				timestamps[i] = 0
+			}
+		} else {
+			record = reg.record(i)
+		}
+
+		if record == nil { // not generated
+			err := header.PutTriad(0)
+			if err != nil {
+				return nil, err
+			}
+
+			err = header.PutByte(0)
+			if err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		count := (len(record) + Sector - 1) / Sector
+		if count > 255 {
+			return nil, fmt.Errorf("level.anvil.region: too large chunk data (%d bytes)", len(record))
+		}
+
+		err := header.PutTriad(binary.Triad(sector))
+		if err != nil {
+			return nil, err
+		}
+
+		err = header.PutByte(byte(count))
+		if err != nil {
+			return nil, err
+		}
+
+		err = body.Put(record)
+		if err != nil {
+			return nil, err
+		}
+
+		err = body.Put(make([]byte, count*Sector-len(record))) // pads
+		if err != nil {
+			return nil, err
+		}
+
+		sector += count
+	}
+
+	for _, stamp := range timestamps {
+		err := header.PutInt(stamp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	b := append(header.AllBytes(), body.AllBytes()...)
+
+	err := reg.Load(b)
+	if err != nil {
+		return nil, err
+	}
+
+	reg.pending = make(map[int][]byte)
+
+	return b, nil
+}
+
+// record returns a chunk record (length, compression type and data) at index
+func (reg *Region) record(index int) []byte {
+	locat := reg.Locations[index]
+
+	off := int(locat.Off) * Sector
+	if off == 0 || off+5 > len(reg.Data) {
+		return nil
+	}
+
+	ln := int(binary.ReadTriad(reg.Data[off+1:off+4])) | int(reg.Data[off])<<24
+	if off+4+ln > len(reg.Data) {
+		return nil
+	}
+
+	return reg.Data[off : off+4+ln]
+}
+
+// Record returns a raw chunk record (length, compression type and compressed data)
+// If the chunk doesn't exist, returns false for ok
+func (reg *Region) Record(x, y int) ([]byte, bool) {
+	if reg.vaild(x, y) != nil {
+		return nil, false
+	}
+
+	record, ok := reg.pending[reg.getIndex(x, y)]
+	if !ok {
+		record = reg.record(reg.getIndex(x, y))
+	}
+
+	return record, record != nil
+}
+
+// HasChunk returns whether the chunk exists in the region
+func (reg *Region) HasChunk(x, y int) bool {
+	if reg.vaild(x, y) != nil {
+		return false
+	}
+
+	index := reg.getIndex(x, y)
+
+	record, ok := reg.pending[index]
+	if ok {
+		return record != nil
+	}
+
+	return reg.Locations[index].Off != 0
+}
+
+// DeleteChunk deletes a chunk
+// The chunk is removed from Data when the region is saved
+func (reg *Region) DeleteChunk(x, y int) error {
+	err := reg.vaild(x, y)
+	if err != nil {
+		return err
+	}
+
+	reg.pending[reg.getIndex(x, y)] = nil
+
+	return nil
+}
+
+// WriteChunk writes a chunk data, it's compressed with zlib
+// The chunk is stored to Data when the region is saved
+func (reg *Region) WriteChunk(x, y int, b []byte) error {
+	err := reg.vaild(x, y)
+	if err != nil {
+		return err
+	}
+
+	buf := new(bytes.Buffer)
+
+	writer := zlib.NewWriter(buf)
 
-	return nil, nil
+	_, err = writer.Write(b)
+	if err != nil {
+		return err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return err
+	}
+
+	stream := binary.NewStream()
+
+	err = stream.PutInt(int32(buf.Len() + 1)) // + compression type
+	if err != nil {
+		return err
+	}
+
+	err = stream.PutByte(CompressionZlib)
+	if err != nil {
+		return err
+	}
+
+	err = stream.Put(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	reg.pending[reg.getIndex(x, y)] = stream.AllBytes()
+
+	return nil
 }
 
 // ReadChunk reads a chunk, returns chunk data as []byte
@@ -234,16 +425,16 @@ func (reg *Region) ReadChunk(x, y int) ([]byte, error) {
 		return nil, err
 	}
 
-	locat := reg.Locations[reg.getIndex(x, y)]
-
-	off := int(locat.Off) * Sector
-	ln := int(locat.Count) * Sector
+	record, ok := reg.pending[reg.getIndex(x, y)]
+	if !ok {
+		record = reg.record(reg.getIndex(x, y))
+	}
 
-	if off == 0 { // It haven't generated yet
+	if record == nil { // It haven't generated yet
 		return nil, nil
 	}
 
-	stream := binary.NewStreamBytes(reg.Data[off : off+ln]) // chunk data + pads
+	stream := binary.NewStreamBytes(record)
 
 	realLen, err := stream.Int() // ln = readLen + pad(4096 - (readlen % 4096)
 	if err != nil {
@@ -255,7 +446,7 @@ func (reg *Region) ReadChunk(x, y int) ([]byte, error) {
 		return nil, err
 	}
 
-	data := stream.Get(int(realLen)) // chunk data
+	data := stream.Get(int(realLen) - 1) // chunk data
 
 	switch ctype {
 	case CompressionGZip:
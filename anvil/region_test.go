@@ -0,0 +1,100 @@
+package anvil
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+func TestRegionSaveRead(t *testing.T) {
+	small := []byte("small chunk")
+
+	large := make([]byte, Sector*3) // compressed data is over a sector
+	rand.New(rand.NewSource(1)).Read(large)
+
+	reg := NewRegion(0, 0)
+
+	err := reg.WriteChunk(0, 0, small)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = reg.WriteChunk(31, 31, large)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = reg.WriteChunk(5, 6, small)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = reg.DeleteChunk(5, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := reg.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(b)%Sector != 0 {
+		t.Errorf("the region isn't padded to sectors (%d bytes)", len(b))
+	}
+
+	read := NewRegion(0, 0)
+
+	err = read.Load(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		x, y int
+		data []byte
+	}{
+		{x: 0, y: 0, data: small},
+		{x: 31, y: 31, data: large},
+		{x: 5, y: 6, data: nil},
+		{x: 1, y: 0, data: nil},
+	} {
+		if read.HasChunk(test.x, test.y) != (test.data != nil) {
+			t.Errorf("(%d, %d): HasChunk returns %t", test.x, test.y, read.HasChunk(test.x, test.y))
+		}
+
+		data, err := read.ReadChunk(test.x, test.y)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(data, test.data) {
+			t.Errorf("(%d, %d): read data is different from written data", test.x, test.y)
+		}
+	}
+
+	if read.Timestamps[read.getIndex(0, 0)] == 0 || read.Timestamps[read.getIndex(5, 6)] != 0 {
+		t.Errorf("timestamps aren't updated")
+	}
+}
+
+func TestRegionVaild(t *testing.T) {
+	reg := NewRegion(0, 0)
+
+	if reg.WriteChunk(32, 0, nil) == nil {
+		t.Errorf("expected an error for x = 32")
+	}
+
+	if reg.HasChunk(-1, 0) {
+		t.Errorf("expected false for x = -1")
+	}
+}
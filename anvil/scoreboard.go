@@ -0,0 +1,41 @@
+package anvil
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"path/filepath"
+
+	"github.com/beito123/level/util"
+	"github.com/beito123/nbt"
+)
+
+// ScoreboardFile is a file of the scoreboard
+const ScoreboardFile = "scoreboard.dat"
+
+// Scoreboard returns the raw data compound of the scoreboard
+// If the world doesn't have it, returns false for ok
+func (lvl *Anvil) Scoreboard() (*nbt.Compound, bool, error) {
+	path := filepath.Join(lvl.path, DataDir, ScoreboardFile)
+	if !util.ExistFile(path) {
+		return nil, false, nil
+	}
+
+	data, err := readData(path)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return data, true, nil
+}
+
+// SetScoreboard sets the raw data compound of the scoreboard
+func (lvl *Anvil) SetScoreboard(com *nbt.Compound) error {
+	return writeData(filepath.Join(lvl.path, DataDir, ScoreboardFile), com, lvl.dataVersion())
+}
@@ -12,15 +12,16 @@ package anvil
 import (
 	"fmt"
 
+	"github.com/beito123/level"
 	"github.com/beito123/level/block"
 
-	"github.com/beito123/binary"
 	"github.com/beito123/nbt"
 )
 
 // SubChunkFormat is a subchunk format for a version
 type SubChunkFormat interface {
 	Read(tag *nbt.Compound) (*SubChunk, error)
+	Write(sub *SubChunk) (*nbt.Compound, error)
 }
 
 // SubChunkFormatV112 is a subchunk format for v1.12 and before
@@ -40,9 +41,7 @@ func (SubChunkFormatV112) Read(tag *nbt.Compound) (*SubChunk, error) {
 	// Blocks
 
 	sub.Palette = []*BlockState{
-		0: &BlockState{
-			Name: "minecraft:air",
-		},
+		0: NewLegacyBlockState(0, 0), // air
 	}
 
 	blocks, err := tag.GetByteArray("Blocks")
@@ -60,11 +59,7 @@ func (SubChunkFormatV112) Read(tag *nbt.Compound) (*SubChunk, error) {
 	sub.Blocks = make([]uint16, blockCount)
 
 	for i := range sub.Blocks {
-		state := &BlockState{
-			IsOld:   true,
-			OldID:   blocks[i],
-			OldMeta: ToNibble(data, i),
-		}
+		state := NewLegacyBlockState(blocks[i], ToNibble(data, i))
 
 		index := -1
 		for ind, val := range sub.Palette { // find palette
@@ -99,54 +94,59 @@ func (SubChunkFormatV112) Read(tag *nbt.Compound) (*SubChunk, error) {
 	return sub, nil
 }
 
-// SubChunkFormatV113 is a subchunk format for v1.13 and after
-type SubChunkFormatV113 struct { // after v1.13
-}
-
-func (SubChunkFormatV113) Read(tag *nbt.Compound) (*SubChunk, error) {
-	y, err := tag.GetByte("Y")
+// Write writes a subchunk as a section
+// If a block can't be converted to a old block id, returns an error
+func (SubChunkFormatV112) Write(sub *SubChunk) (*nbt.Compound, error) {
+	palette, blocks, err := sub.compact()
 	if err != nil {
 		return nil, err
 	}
 
-	sub := &SubChunk{
-		Y: y,
-	}
+	ids := make([]byte, len(palette))
+	metas := make([]byte, len(palette))
+	for i, bs := range palette {
+		id, meta, ok := bs.ToLegacy()
+		if !ok {
+			return nil, fmt.Errorf("level.anvil: couldn't convert %s to a old block id", block.FormatState(bs.Name(), bs.Properties()))
+		}
 
-	blockData, err := tag.GetLongArray("BlockStates")
-	if err != nil {
-		return nil, err
+		ids[i] = id
+		metas[i] = meta
 	}
 
 	blockCount := 16 * 16 * 16 // 4096
 
-	longLen := binary.LongSize * 8 // 1bytes = 8bits // 64
-
-	bit := (len(blockData) * longLen) / blockCount      // bits per block
-	perBlock := (len(blockData) * longLen) / blockCount // bits per block
-	mask := uint16((1 << uint(bit)) - 1)                // returns 4bits -> 0b1111
-
-	//fmt.Printf("bits: %02b\n", mask)
-
-	sub.Blocks = make([]uint16, blockCount)
-
-	var count int
-
-	for i := 0; i < blockCount; i++ {
-		index := (perBlock * i) / longLen
-		off := (perBlock * i) % longLen
+	result := make([]byte, blockCount)
+	data := make([]byte, blockCount/2)
+	for i, index := range blocks {
+		result[i] = ids[index]
+		SetNibble(data, i, metas[index])
+	}
 
-		data := uint16(uint64(blockData[index])>>uint(off)) & mask
+	return nbt.NewCompoundTag("", map[string]nbt.Tag{
+		"Y":          nbt.NewByteTag("Y", int8(sub.Y)),
+		"Blocks":     nbt.NewByteArrayTag("Blocks", result),
+		"Data":       nbt.NewByteArrayTag("Data", data),
+		"BlockLight": nbt.NewByteArrayTag("BlockLight", sub.lightOrEmpty(sub.BlockLight, 0)),
+		"SkyLight":   nbt.NewByteArrayTag("SkyLight", sub.lightOrEmpty(sub.SkyLight, 15)),
+	}), nil
+}
 
-		left := ((off + perBlock) - longLen)
-		if left > 0 {
-			m := uint64((1 << uint(left)) - 1)
-			data = ((uint16(uint64(blockData[index+1])&m) << uint(perBlock-left)) | data) & mask
-		}
+// SubChunkFormatV113 is a subchunk format for v1.13 and after
+type SubChunkFormatV113 struct { // after v1.13
+	// DataVersion is DataVersion of the chunk, it decides how block states are packed
+	// If it's 0, block states are packed as v1.13
+	DataVersion int
+}
 
-		sub.Blocks[count] = data
+func (format SubChunkFormatV113) Read(tag *nbt.Compound) (*SubChunk, error) {
+	y, err := tag.GetByte("Y")
+	if err != nil {
+		return nil, err
+	}
 
-		count++
+	sub := &SubChunk{
+		Y: y,
 	}
 
 	palettes, err := tag.GetList("Palette")
@@ -167,11 +167,42 @@ func (SubChunkFormatV113) Read(tag *nbt.Compound) (*SubChunk, error) {
 			return nil, err
 		}
 
-		sub.Palette[i] = &BlockState{
-			Name: bName,
+		properties := make(map[string]string)
+		if pac.Has("Properties") {
+			pro, err := pac.GetCompound("Properties")
+			if err != nil {
+				return nil, err
+			}
+
+			for key, tag := range pro.Value {
+				properties[key], err = tag.ToString()
+				if err != nil {
+					return nil, err
+				}
+			}
 		}
+
+		sub.Palette[i] = NewBlockState(bName, properties)
 	}
 
+	// Blocks
+
+	blockData, err := tag.GetLongArray("BlockStates")
+	if err != nil {
+		return nil, err
+	}
+
+	blockCount := 16 * 16 * 16 // 4096
+
+	bits := paletteBits(len(sub.Palette))
+	spanned := format.spanned()
+
+	if len(blockData) != PackedLength(blockCount, bits, spanned) {
+		return nil, fmt.Errorf("level.anvil: invaild length of BlockStates for %d palettes", len(sub.Palette))
+	}
+
+	sub.Blocks = UnpackBits(blockData, bits, blockCount, spanned)
+
 	// BlockLight
 
 	sub.BlockLight, err = tag.GetByteArray("BlockLight")
@@ -189,16 +220,69 @@ func (SubChunkFormatV113) Read(tag *nbt.Compound) (*SubChunk, error) {
 	return sub, nil
 }
 
-func (SubChunkFormatV113) Write(sub *SubChunk) (*nbt.Compound, error) {
-	return nil, nil
+// Write writes a subchunk as a section
+// Unused palettes are removed
+func (format SubChunkFormatV113) Write(sub *SubChunk) (*nbt.Compound, error) {
+	palette, blocks, err := sub.compact()
+	if err != nil {
+		return nil, err
+	}
+
+	bits := paletteBits(len(palette))
+
+	palettes := make([]nbt.Tag, len(palette))
+	for i, bs := range palette {
+		name, properties, ok := bs.ToBlockNameProperties()
+		if !ok {
+			name = bs.Name()
+		}
+
+		com := nbt.NewCompoundTag("", map[string]nbt.Tag{
+			"Name": nbt.NewStringTag("Name", name),
+		})
+
+		if len(properties) > 0 {
+			pro := nbt.NewCompoundTag("Properties", make(map[string]nbt.Tag))
+			for key, val := range properties {
+				pro.Set(nbt.NewStringTag(key, val))
+			}
+
+			com.Set(pro)
+		}
+
+		palettes[i] = com
+	}
+
+	return nbt.NewCompoundTag("", map[string]nbt.Tag{
+		"Y":           nbt.NewByteTag("Y", int8(sub.Y)),
+		"Palette":     nbt.NewListTag("Palette", palettes, nbt.IDTagCompound),
+		"BlockStates": nbt.NewLongArrayTag("BlockStates", PackBits(blocks, bits, format.spanned())),
+		"BlockLight":  nbt.NewByteArrayTag("BlockLight", sub.lightOrEmpty(sub.BlockLight, 0)),
+		"SkyLight":    nbt.NewByteArrayTag("SkyLight", sub.lightOrEmpty(sub.SkyLight, 15)),
+	}), nil
+}
+
+// spanned returns whether block states are spanned over longs
+func (format SubChunkFormatV113) spanned() bool {
+	return format.DataVersion == 0 || isSpanned(format.DataVersion)
+}
+
+// paletteBits returns bits per block for the number of palettes
+func paletteBits(n int) int {
+	bits := 4 // minimum bits per block
+	for (1 << uint(bits)) < n {
+		bits++
+	}
+
+	return bits
 }
 
-// NewSubChunk returns new subchunk
+// NewSubChunk returns new subchunk filled with air
 func NewSubChunk(y byte) *SubChunk {
 	return &SubChunk{
 		Y:          y,
-		Palette:    make([]*BlockState, 0),
-		Blocks:     make([]uint16, 2048),
+		Palette:    []*BlockState{NewBlockState("minecraft:air", nil)},
+		Blocks:     make([]uint16, 16*16*16),
 		BlockLight: make([]byte, 2048),
 		SkyLight:   make([]byte, 2048),
 	}
@@ -214,6 +298,49 @@ type SubChunk struct {
 	SkyLight   []byte
 }
 
+// lightOrEmpty returns light data, or light data filled with value if it's empty
+func (SubChunk) lightOrEmpty(light []byte, value byte) []byte {
+	if len(light) >= 2048 {
+		return light
+	}
+
+	result := make([]byte, 2048)
+	for i := range result {
+		result[i] = value<<4 | value
+	}
+
+	return result
+}
+
+// compact returns a palette without unused entries and remapped blocks
+func (sub *SubChunk) compact() ([]*BlockState, []uint16, error) {
+	remap := make([]int, len(sub.Palette))
+	for i := range remap {
+		remap[i] = -1
+	}
+
+	var palette []*BlockState
+	blocks := make([]uint16, len(sub.Blocks))
+	for i, index := range sub.Blocks {
+		if int(index) >= len(sub.Palette) {
+			return nil, nil, fmt.Errorf("couldn't find a palette for the block")
+		}
+
+		if remap[index] == -1 {
+			remap[index] = len(palette)
+			palette = append(palette, sub.Palette[index])
+		}
+
+		blocks[i] = uint16(remap[index])
+	}
+
+	if len(palette) == 0 {
+		palette = append(palette, NewBlockState("minecraft:air", nil))
+	}
+
+	return palette, blocks, nil
+}
+
 // At returns index from subchunk coordinates
 // xyz need to be more 0 and less 15
 func (SubChunk) At(x, y, z int) int {
@@ -273,47 +400,263 @@ func (sub *SubChunk) AtSkyLight(x, y, z int) (byte, error) {
 	return ToNibble(sub.SkyLight, sub.At(x, y, z)), nil
 }
 
-/*
-type BlockRawData struct {
-	Name       string
-	Properties map[string]string
-}*/
+// SetBlock sets a block at the subchunk coordinates
+func (sub *SubChunk) SetBlock(x, y, z int, bs *BlockState) error {
+	err := sub.Vaild(x, y, z)
+	if err != nil {
+		return err
+	}
+
+	index := -1
+	for ind, val := range sub.Palette {
+		if val.Equal(bs) {
+			index = ind
+			break
+		}
+	}
+
+	if index == -1 {
+		index = len(sub.Palette)
+		sub.Palette = append(sub.Palette, bs)
+	}
+
+	sub.Blocks[sub.At(x, y, z)] = uint16(index)
+
+	return nil
+}
+
+// SetBlockLight sets a blocklight at the subchunk coordinates
+func (sub *SubChunk) SetBlockLight(x, y, z int, light byte) error {
+	err := sub.Vaild(x, y, z)
+	if err != nil {
+		return err
+	}
+
+	if len(sub.BlockLight) < 2048 {
+		sub.BlockLight = make([]byte, 2048)
+	}
+
+	SetNibble(sub.BlockLight, sub.At(x, y, z), light)
+
+	return nil
+}
+
+// SetSkyLight sets a skylight at the subchunk coordinates
+func (sub *SubChunk) SetSkyLight(x, y, z int, light byte) error {
+	err := sub.Vaild(x, y, z)
+	if err != nil {
+		return err
+	}
+
+	if len(sub.SkyLight) < 2048 {
+		sub.SkyLight = make([]byte, 2048)
+	}
+
+	SetNibble(sub.SkyLight, sub.At(x, y, z), light)
+
+	return nil
+}
+
+// NewBlockState returns new BlockState with name and properties
+func NewBlockState(name string, properties map[string]string) *BlockState {
+	if properties == nil {
+		properties = make(map[string]string)
+	}
+
+	return &BlockState{
+		name:       name,
+		properties: properties,
+	}
+}
+
+// NewWaterBlockState returns new BlockState of water source
+func NewWaterBlockState() *BlockState {
+	return NewBlockState("minecraft:water", map[string]string{
+		"level": "0",
+	})
+}
+
+// NewLegacyBlockState returns new BlockState with old block id and meta (v1.12 and before)
+func NewLegacyBlockState(id byte, meta byte) *BlockState {
+	return &BlockState{
+		IsOld:   true,
+		OldID:   id,
+		OldMeta: meta,
+	}
+}
+
+// FromBlockState returns new BlockState from level.BlockState
+func FromBlockState(bs level.BlockState) (*BlockState, error) {
+	if state, ok := bs.(*BlockState); ok {
+		return state, nil
+	}
+
+	name, properties, ok := bs.ToBlockNameProperties()
+	if ok {
+		return NewBlockState(name, properties), nil
+	}
+
+	id, meta, ok := bs.ToBlockIDMeta()
+	if ok {
+		return NewLegacyBlockState(byte(id), byte(meta)), nil
+	}
 
+	return nil, fmt.Errorf("level.anvil: unable to convert from %s to BlockState", bs.Name())
+}
+
+// BlockState is a block information in a palette
 type BlockState struct {
-	Name       string
-	Properties map[string]string
+	name       string
+	properties map[string]string
 
 	IsOld   bool
 	OldID   byte
 	OldMeta byte
 }
 
-func (bs *BlockState) ToBlockData() *block.Block {
+// Name returns block name
+// If it's a old block, returns a name converted to v1.13
+func (bs *BlockState) Name() string {
+	if bs.IsOld {
+		bl, err := bs.ToBlockData()
+		if err != nil {
+			return "minecraft:unknown"
+		}
+
+		return bl.Name
+	}
+
+	return bs.name
+}
+
+// SetName sets block name
+// If it's a old block, it becomes a block with the name
+func (bs *BlockState) SetName(name string) {
+	bs.name = name
+	bs.IsOld = false
+}
+
+// Properties returns block properties
+func (bs *BlockState) Properties() map[string]string {
+	return bs.properties
+}
+
+// SetProperties sets block properties
+func (bs *BlockState) SetProperties(properties map[string]string) {
+	if properties == nil {
+		properties = make(map[string]string)
+	}
+
+	bs.properties = properties
+}
+
+// ToBlockNameProperties returns block name and properties
+// If it's not supported, returns false for ok
+func (bs *BlockState) ToBlockNameProperties() (name string, properties map[string]string, ok bool) {
+	if bs.IsOld {
+		bl, err := bs.ToBlockData()
+		if err != nil {
+			return "", nil, false
+		}
+
+		return bl.Name, bl.Properties, true
+	}
+
+	return bs.name, bs.properties, true
+}
+
+// ToBlockNameMeta returns block name and meta
+// If it's not supported, returns false for ok
+func (bs *BlockState) ToBlockNameMeta() (name string, meta int, ok bool) {
+	if !bs.IsOld {
+		return "", 0, false
+	}
+
+	list, err := block.ListV112()
+	if err != nil {
+		return "", 0, false
+	}
+
+	bl, ok := list[block.ToNumberID(int(bs.OldID))]
+	if !ok {
+		return "", 0, false
+	}
+
+	return bl.Name, int(bs.OldMeta), true
+}
+
+// ToBlockIDMeta returns block id and meta
+// If it's not supported, returns false for ok
+func (bs *BlockState) ToBlockIDMeta() (id int, meta int, ok bool) {
+	if !bs.IsOld {
+		return 0, 0, false
+	}
+
+	return int(bs.OldID), int(bs.OldMeta), true
+}
+
+// ToLegacy returns old block id and meta (v1.12 and before)
+// Blocks after v1.13 are converted by the compatibility data
+func (bs *BlockState) ToLegacy() (id byte, meta byte, ok bool) {
+	if bs.IsOld {
+		return bs.OldID, bs.OldMeta, true
+	}
+
+	if block.IsAir(bs.name) {
+		return 0, 0, true
+	}
+
+	name, m, ok := block.GetV113ToV112(bs.name)
+	if !ok {
+		name = bs.name
+	}
+
+	list, err := block.ListV112()
+	if err != nil {
+		return 0, 0, false
+	}
+
+	bl, ok := list.Get(name)
+	if !ok {
+		return 0, 0, false
+	}
+
+	return byte(bl.ID), byte(m), true
+}
+
+// ToBlockData returns block data
+// If it's a old block which isn't found, returns an error
+func (bs *BlockState) ToBlockData() (*block.Block, error) {
 	if bs.IsOld {
 		return block.FromBlockID(int(bs.OldID), int(bs.OldMeta))
 	}
 
 	return &block.Block{
-		Name:       bs.Name,
-		Properties: bs.Properties,
-	}
+		Name:       bs.name,
+		Properties: bs.properties,
+	}, nil
 }
 
+// Equal returns whether bs is equal sub
 func (bs *BlockState) Equal(sub *BlockState) bool {
+	if bs.IsOld != sub.IsOld {
+		return false
+	}
+
 	if bs.IsOld {
 		return bs.OldID == sub.OldID && bs.OldMeta == sub.OldMeta
 	}
 
-	if bs.Name != sub.Name {
+	if bs.name != sub.name {
 		return false
 	}
 
-	if len(bs.Properties) != len(sub.Properties) {
+	if len(bs.properties) != len(sub.properties) {
 		return false
 	}
 
-	for k, v := range bs.Properties {
-		val, ok := sub.Properties[k]
+	for k, v := range bs.properties {
+		val, ok := sub.properties[k]
 		if !ok {
 			return false
 		} else if v != val {
@@ -334,3 +677,13 @@ func ToNibble(b []byte, index int) byte {
 
 	return data & 0x0F // 0b00001111
 }
+
+// SetNibble sets a nibble data to []byte by index
+func SetNibble(b []byte, index int, value byte) {
+	if (index % 2) != 0 {
+		b[index/2] = (b[index/2] & 0x0F) | ((value & 0x0F) << 4)
+		return
+	}
+
+	b[index/2] = (b[index/2] & 0xF0) | (value & 0x0F)
+}
@@ -9,9 +9,11 @@ import (
 var _Assetsfb77da93f791278b498d50cfaabe2ce0b999ee51 = "[\n  {\n    \"id\": 0,\n    \"displayName\": \"Air\",\n    \"name\": \"air\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      0\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 1,\n    \"displayName\": \"Stone\",\n    \"name\": \"stone\",\n    \"hardness\": 1.5,\n    \"minStateId\": 1,\n    \"maxStateId\": 1,\n    \"states\": [],\n    \"drops\": [\n      1\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 2,\n    \"displayName\": \"Granite\",\n    \"name\": \"granite\",\n    \"hardness\": 1.5,\n    \"minStateId\": 2,\n    \"maxStateId\": 2,\n    \"states\": [],\n    \"drops\": [\n      2\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 3,\n    \"displayName\": \"Polished Granite\",\n    \"name\": \"polished_granite\",\n    \"hardness\": 1.5,\n    \"minStateId\": 3,\n    \"maxStateId\": 3,\n    \"states\": [],\n    \"drops\": [\n      3\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 4,\n    \"displayName\": \"Diorite\",\n    \"name\": \"diorite\",\n    \"hardness\": 1.5,\n    \"minStateId\": 4,\n    \"maxStateId\": 4,\n    \"states\": [],\n    \"drops\": [\n      4\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 5,\n    \"displayName\": \"Polished Diorite\",\n    \"name\": \"polished_diorite\",\n    \"hardness\": 1.5,\n    \"minStateId\": 5,\n    \"maxStateId\": 5,\n    \"states\": [],\n    \"drops\": [\n      5\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 6,\n    \"displayName\": \"Andesite\",\n    \"name\": \"andesite\",\n    \"hardness\": 1.5,\n    \"minStateId\": 6,\n    \"maxStateId\": 6,\n    \"states\": [],\n    \"drops\": [\n      6\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 7,\n    \"displayName\": \"Polished Andesite\",\n    \"name\": \"polished_andesite\",\n    \"hardness\": 1.5,\n    \"minStateId\": 7,\n    \"maxStateId\": 7,\n    \"states\": [],\n    \"drops\": [\n      7\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 8,\n    \"displayName\": \"Grass Block\",\n    \"name\": \"grass_block\",\n    \"hardness\": 0.6,\n    \"minStateId\": 8,\n    \"maxStateId\": 9,\n    \"states\": [\n      {\n        \"name\": \"snowy\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      8\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 9,\n    \"displayName\": \"Dirt\",\n    \"name\": \"dirt\",\n    \"hardness\": 0.5,\n    \"minStateId\": 10,\n    \"maxStateId\": 10,\n    \"states\": [],\n    \"drops\": [\n      9\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 10,\n    \"displayName\": \"Coarse Dirt\",\n    \"name\": \"coarse_dirt\",\n    \"hardness\": 0.5,\n    \"minStateId\": 11,\n    \"maxStateId\": 11,\n    \"states\": [],\n    \"drops\": [\n      10\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 11,\n    \"displayName\": \"Podzol\",\n    \"name\": \"podzol\",\n    \"hardness\": 0.5,\n    \"minStateId\": 12,\n    \"maxStateId\": 13,\n    \"states\": [\n      {\n        \"name\": \"snowy\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      11\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 12,\n    \"displayName\": \"Cobblestone\",\n    \"name\": \"cobblestone\",\n    \"hardness\": 2,\n    \"minStateId\": 14,\n    \"maxStateId\": 14,\n    \"states\": [],\n    \"drops\": [\n      12\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 13,\n    \"displayName\": \"Oak Planks\",\n    \"name\": \"oak_planks\",\n    \"hardness\": 2,\n    \"minStateId\": 15,\n    \"maxStateId\": 15,\n    \"states\": [],\n    \"drops\": [\n      13\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 14,\n    \"displayName\": \"Spruce Planks\",\n    \"name\": \"spruce_planks\",\n    \"hardness\": 2,\n    \"minStateId\": 16,\n    \"maxStateId\": 16,\n    \"states\": [],\n    \"drops\": [\n      14\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 15,\n    \"displayName\": \"Birch Planks\",\n    \"name\": \"birch_planks\",\n    \"hardness\": 2,\n    \"minStateId\": 17,\n    \"maxStateId\": 17,\n    \"states\": [],\n    \"drops\": [\n      15\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 16,\n    \"displayName\": \"Jungle Planks\",\n    \"name\": \"jungle_planks\",\n    \"hardness\": 2,\n    \"minStateId\": 18,\n    \"maxStateId\": 18,\n    \"states\": [],\n    \"drops\": [\n      16\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 17,\n    \"displayName\": \"Acacia Planks\",\n    \"name\": \"acacia_planks\",\n    \"hardness\": 2,\n    \"minStateId\": 19,\n    \"maxStateId\": 19,\n    \"states\": [],\n    \"drops\": [\n      17\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 18,\n    \"displayName\": \"Dark Oak Planks\",\n    \"name\": \"dark_oak_planks\",\n    \"hardness\": 2,\n    \"minStateId\": 20,\n    \"maxStateId\": 20,\n    \"states\": [],\n    \"drops\": [\n      18\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 19,\n    \"displayName\": \"Oak Sapling\",\n    \"name\": \"oak_sapling\",\n    \"hardness\": 0,\n    \"minStateId\": 21,\n    \"maxStateId\": 22,\n    \"states\": [\n      {\n        \"name\": \"stage\",\n        \"type\": \"int\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      19\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 20,\n    \"displayName\": \"Spruce Sapling\",\n    \"name\": \"spruce_sapling\",\n    \"hardness\": 0,\n    \"minStateId\": 23,\n    \"maxStateId\": 24,\n    \"states\": [\n      {\n        \"name\": \"stage\",\n        \"type\": \"int\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      20\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 21,\n    \"displayName\": \"Birch Sapling\",\n    \"name\": \"birch_sapling\",\n    \"hardness\": 0,\n    \"minStateId\": 25,\n    \"maxStateId\": 26,\n    \"states\": [\n      {\n        \"name\": \"stage\",\n        \"type\": \"int\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      21\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 22,\n    \"displayName\": \"Jungle Sapling\",\n    \"name\": \"jungle_sapling\",\n    \"hardness\": 0,\n    \"minStateId\": 27,\n    \"maxStateId\": 28,\n    \"states\": [\n      {\n        \"name\": \"stage\",\n        \"type\": \"int\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      22\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 23,\n    \"displayName\": \"Acacia Sapling\",\n    \"name\": \"acacia_sapling\",\n    \"hardness\": 0,\n    \"minStateId\": 29,\n    \"maxStateId\": 30,\n    \"states\": [\n      {\n        \"name\": \"stage\",\n        \"type\": \"int\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      23\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 24,\n    \"displayName\": \"Dark Oak Sapling\",\n    \"name\": \"dark_oak_sapling\",\n    \"hardness\": 0,\n    \"minStateId\": 31,\n    \"maxStateId\": 32,\n    \"states\": [\n      {\n        \"name\": \"stage\",\n        \"type\": \"int\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      24\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 25,\n    \"displayName\": \"Bedrock\",\n    \"name\": \"bedrock\",\n    \"hardness\": null,\n    \"minStateId\": 33,\n    \"maxStateId\": 33,\n    \"states\": [],\n    \"drops\": [\n      25\n    ],\n    \"diggable\": false,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 26,\n    \"displayName\": \"Water\",\n    \"name\": \"water\",\n    \"hardness\": 100,\n    \"minStateId\": 34,\n    \"maxStateId\": 49,\n    \"states\": [\n      {\n        \"name\": \"level\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": true,\n    \"filterLight\": 2,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 27,\n    \"displayName\": \"Lava\",\n    \"name\": \"lava\",\n    \"hardness\": 100,\n    \"minStateId\": 50,\n    \"maxStateId\": 65,\n    \"states\": [\n      {\n        \"name\": \"level\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 15,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 28,\n    \"displayName\": \"Sand\",\n    \"name\": \"sand\",\n    \"hardness\": 0.5,\n    \"minStateId\": 66,\n    \"maxStateId\": 66,\n    \"states\": [],\n    \"drops\": [\n      26\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 0,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 29,\n    \"displayName\": \"Red Sand\",\n    \"name\": \"red_sand\",\n    \"hardness\": 0.5,\n    \"minStateId\": 67,\n    \"maxStateId\": 67,\n    \"states\": [],\n    \"drops\": [\n      27\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 0,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 30,\n    \"displayName\": \"Gravel\",\n    \"name\": \"gravel\",\n    \"hardness\": 0.6,\n    \"minStateId\": 68,\n    \"maxStateId\": 68,\n    \"states\": [],\n    \"drops\": [\n      28\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 31,\n    \"displayName\": \"Gold Ore\",\n    \"name\": \"gold_ore\",\n    \"hardness\": 3,\n    \"minStateId\": 69,\n    \"maxStateId\": 69,\n    \"states\": [],\n    \"drops\": [\n      29\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"490\": true\n    }\n  },\n  {\n    \"id\": 32,\n    \"displayName\": \"Iron Ore\",\n    \"name\": \"iron_ore\",\n    \"hardness\": 3,\n    \"minStateId\": 70,\n    \"maxStateId\": 70,\n    \"states\": [],\n    \"drops\": [\n      30\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"486\": true,\n      \"490\": true\n    }\n  },\n  {\n    \"id\": 33,\n    \"displayName\": \"Coal Ore\",\n    \"name\": \"coal_ore\",\n    \"hardness\": 3,\n    \"minStateId\": 71,\n    \"maxStateId\": 71,\n    \"states\": [],\n    \"drops\": [\n      31\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 34,\n    \"displayName\": \"Oak Log\",\n    \"name\": \"oak_log\",\n    \"hardness\": 2,\n    \"minStateId\": 72,\n    \"maxStateId\": 74,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      32\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 35,\n    \"displayName\": \"Spruce Log\",\n    \"name\": \"spruce_log\",\n    \"hardness\": 2,\n    \"minStateId\": 75,\n    \"maxStateId\": 77,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      33\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 36,\n    \"displayName\": \"Birch Log\",\n    \"name\": \"birch_log\",\n    \"hardness\": 2,\n    \"minStateId\": 78,\n    \"maxStateId\": 80,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      34\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 37,\n    \"displayName\": \"Jungle Log\",\n    \"name\": \"jungle_log\",\n    \"hardness\": 2,\n    \"minStateId\": 81,\n    \"maxStateId\": 83,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      35\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 38,\n    \"displayName\": \"Acacia Log\",\n    \"name\": \"acacia_log\",\n    \"hardness\": 2,\n    \"minStateId\": 84,\n    \"maxStateId\": 86,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      36\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 39,\n    \"displayName\": \"Dark Oak Log\",\n    \"name\": \"dark_oak_log\",\n    \"hardness\": 2,\n    \"minStateId\": 87,\n    \"maxStateId\": 89,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      37\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 40,\n    \"displayName\": \"Stripped Spruce Log\",\n    \"name\": \"stripped_spruce_log\",\n    \"hardness\": 2,\n    \"minStateId\": 90,\n    \"maxStateId\": 92,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      39\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 41,\n    \"displayName\": \"Stripped Birch Log\",\n    \"name\": \"stripped_birch_log\",\n    \"hardness\": 2,\n    \"minStateId\": 93,\n    \"maxStateId\": 95,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      40\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 42,\n    \"displayName\": \"Stripped Jungle Log\",\n    \"name\": \"stripped_jungle_log\",\n    \"hardness\": 2,\n    \"minStateId\": 96,\n    \"maxStateId\": 98,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      41\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 43,\n    \"displayName\": \"Stripped Acacia Log\",\n    \"name\": \"stripped_acacia_log\",\n    \"hardness\": 2,\n    \"minStateId\": 99,\n    \"maxStateId\": 101,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      42\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 44,\n    \"displayName\": \"Stripped Dark Oak Log\",\n    \"name\": \"stripped_dark_oak_log\",\n    \"hardness\": 2,\n    \"minStateId\": 102,\n    \"maxStateId\": 104,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      43\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 45,\n    \"displayName\": \"Stripped Oak Log\",\n    \"name\": \"stripped_oak_log\",\n    \"hardness\": 2,\n    \"minStateId\": 105,\n    \"maxStateId\": 107,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      38\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 46,\n    \"displayName\": \"Oak Wood\",\n    \"name\": \"oak_wood\",\n    \"hardness\": 2,\n    \"minStateId\": 108,\n    \"maxStateId\": 110,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      50\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 47,\n    \"displayName\": \"Spruce Wood\",\n    \"name\": \"spruce_wood\",\n    \"hardness\": 2,\n    \"minStateId\": 111,\n    \"maxStateId\": 113,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      51\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 48,\n    \"displayName\": \"Birch Wood\",\n    \"name\": \"birch_wood\",\n    \"hardness\": 2,\n    \"minStateId\": 114,\n    \"maxStateId\": 116,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      52\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 49,\n    \"displayName\": \"Jungle Wood\",\n    \"name\": \"jungle_wood\",\n    \"hardness\": 2,\n    \"minStateId\": 117,\n    \"maxStateId\": 119,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      53\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 50,\n    \"displayName\": \"Acacia Wood\",\n    \"name\": \"acacia_wood\",\n    \"hardness\": 2,\n    \"minStateId\": 120,\n    \"maxStateId\": 122,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      54\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 51,\n    \"displayName\": \"Dark Oak Wood\",\n    \"name\": \"dark_oak_wood\",\n    \"hardness\": 2,\n    \"minStateId\": 123,\n    \"maxStateId\": 125,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      55\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 52,\n    \"displayName\": \"Stripped Oak Wood\",\n    \"name\": \"stripped_oak_wood\",\n    \"hardness\": 2,\n    \"minStateId\": 126,\n    \"maxStateId\": 128,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      44\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 53,\n    \"displayName\": \"Stripped Spruce Wood\",\n    \"name\": \"stripped_spruce_wood\",\n    \"hardness\": 2,\n    \"minStateId\": 129,\n    \"maxStateId\": 131,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      45\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 54,\n    \"displayName\": \"Stripped Birch Wood\",\n    \"name\": \"stripped_birch_wood\",\n    \"hardness\": 2,\n    \"minStateId\": 132,\n    \"maxStateId\": 134,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      46\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 55,\n    \"displayName\": \"Stripped Jungle Wood\",\n    \"name\": \"stripped_jungle_wood\",\n    \"hardness\": 2,\n    \"minStateId\": 135,\n    \"maxStateId\": 137,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      47\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 56,\n    \"displayName\": \"Stripped Acacia Wood\",\n    \"name\": \"stripped_acacia_wood\",\n    \"hardness\": 2,\n    \"minStateId\": 138,\n    \"maxStateId\": 140,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      48\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 57,\n    \"displayName\": \"Stripped Dark Oak Wood\",\n    \"name\": \"stripped_dark_oak_wood\",\n    \"hardness\": 2,\n    \"minStateId\": 141,\n    \"maxStateId\": 143,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      49\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 58,\n    \"displayName\": \"Oak Leaves\",\n    \"name\": \"oak_leaves\",\n    \"hardness\": 0.2,\n    \"minStateId\": 144,\n    \"maxStateId\": 157,\n    \"states\": [\n      {\n        \"name\": \"distance\",\n        \"type\": \"int\",\n        \"num_values\": 7\n      },\n      {\n        \"name\": \"persistent\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      56\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 59,\n    \"displayName\": \"Spruce Leaves\",\n    \"name\": \"spruce_leaves\",\n    \"hardness\": 0.2,\n    \"minStateId\": 158,\n    \"maxStateId\": 171,\n    \"states\": [\n      {\n        \"name\": \"distance\",\n        \"type\": \"int\",\n        \"num_values\": 7\n      },\n      {\n        \"name\": \"persistent\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      57\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 60,\n    \"displayName\": \"Birch Leaves\",\n    \"name\": \"birch_leaves\",\n    \"hardness\": 0.2,\n    \"minStateId\": 172,\n    \"maxStateId\": 185,\n    \"states\": [\n      {\n        \"name\": \"distance\",\n        \"type\": \"int\",\n        \"num_values\": 7\n      },\n      {\n        \"name\": \"persistent\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      58\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 61,\n    \"displayName\": \"Jungle Leaves\",\n    \"name\": \"jungle_leaves\",\n    \"hardness\": 0.2,\n    \"minStateId\": 186,\n    \"maxStateId\": 199,\n    \"states\": [\n      {\n        \"name\": \"distance\",\n        \"type\": \"int\",\n        \"num_values\": 7\n      },\n      {\n        \"name\": \"persistent\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      59\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 62,\n    \"displayName\": \"Acacia Leaves\",\n    \"name\": \"acacia_leaves\",\n    \"hardness\": 0.2,\n    \"minStateId\": 200,\n    \"maxStateId\": 213,\n    \"states\": [\n      {\n        \"name\": \"distance\",\n        \"type\": \"int\",\n        \"num_values\": 7\n      },\n      {\n        \"name\": \"persistent\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      60\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 63,\n    \"displayName\": \"Dark Oak Leaves\",\n    \"name\": \"dark_oak_leaves\",\n    \"hardness\": 0.2,\n    \"minStateId\": 214,\n    \"maxStateId\": 227,\n    \"states\": [\n      {\n        \"name\": \"distance\",\n        \"type\": \"int\",\n        \"num_values\": 7\n      },\n      {\n        \"name\": \"persistent\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      61\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 64,\n    \"displayName\": \"Sponge\",\n    \"name\": \"sponge\",\n    \"hardness\": 0.6,\n    \"minStateId\": 228,\n    \"maxStateId\": 228,\n    \"states\": [],\n    \"drops\": [\n      62\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 65,\n    \"displayName\": \"Wet Sponge\",\n    \"name\": \"wet_sponge\",\n    \"hardness\": 0.6,\n    \"minStateId\": 229,\n    \"maxStateId\": 229,\n    \"states\": [],\n    \"drops\": [\n      63\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 66,\n    \"displayName\": \"Glass\",\n    \"name\": \"glass\",\n    \"hardness\": 0.3,\n    \"minStateId\": 230,\n    \"maxStateId\": 230,\n    \"states\": [],\n    \"drops\": [\n      64\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 67,\n    \"displayName\": \"Lapis Lazuli Ore\",\n    \"name\": \"lapis_ore\",\n    \"hardness\": 3,\n    \"minStateId\": 231,\n    \"maxStateId\": 231,\n    \"states\": [],\n    \"drops\": [\n      65\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"486\": true,\n      \"490\": true\n    }\n  },\n  {\n    \"id\": 68,\n    \"displayName\": \"Lapis Lazuli Block\",\n    \"name\": \"lapis_block\",\n    \"hardness\": 3,\n    \"minStateId\": 232,\n    \"maxStateId\": 232,\n    \"states\": [],\n    \"drops\": [\n      66\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"486\": true,\n      \"490\": true\n    }\n  },\n  {\n    \"id\": 69,\n    \"displayName\": \"Dispenser\",\n    \"name\": \"dispenser\",\n    \"hardness\": 3.5,\n    \"minStateId\": 233,\n    \"maxStateId\": 244,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      },\n      {\n        \"name\": \"triggered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      67\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 70,\n    \"displayName\": \"Sandstone\",\n    \"name\": \"sandstone\",\n    \"hardness\": 0.8,\n    \"minStateId\": 245,\n    \"maxStateId\": 245,\n    \"states\": [],\n    \"drops\": [\n      68\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 71,\n    \"displayName\": \"Chiseled Sandstone\",\n    \"name\": \"chiseled_sandstone\",\n    \"hardness\": 0.8,\n    \"minStateId\": 246,\n    \"maxStateId\": 246,\n    \"states\": [],\n    \"drops\": [\n      69\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 72,\n    \"displayName\": \"Cut Sandstone\",\n    \"name\": \"cut_sandstone\",\n    \"hardness\": 0.8,\n    \"minStateId\": 247,\n    \"maxStateId\": 247,\n    \"states\": [],\n    \"drops\": [\n      70\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 73,\n    \"displayName\": \"Note Block\",\n    \"name\": \"note_block\",\n    \"hardness\": 0.8,\n    \"minStateId\": 248,\n    \"maxStateId\": 747,\n    \"states\": [\n      {\n        \"name\": \"instrument\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"HARP\",\n          \"BASEDRUM\",\n          \"SNARE\",\n          \"HAT\",\n          \"BASS\",\n          \"FLUTE\",\n          \"BELL\",\n          \"GUITAR\",\n          \"CHIME\",\n          \"XYLOPHONE\"\n        ],\n        \"num_values\": 10\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"note\",\n        \"type\": \"int\",\n        \"num_values\": 25\n      }\n    ],\n    \"drops\": [\n      71\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 74,\n    \"displayName\": \"White Bed\",\n    \"name\": \"white_bed\",\n    \"hardness\": 0.2,\n    \"minStateId\": 748,\n    \"maxStateId\": 763,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"part\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"HEAD\",\n          \"FOOT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"occupied\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      591\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 75,\n    \"displayName\": \"Orange Bed\",\n    \"name\": \"orange_bed\",\n    \"hardness\": 0.2,\n    \"minStateId\": 764,\n    \"maxStateId\": 779,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"part\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"HEAD\",\n          \"FOOT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"occupied\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      592\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 76,\n    \"displayName\": \"Magenta Bed\",\n    \"name\": \"magenta_bed\",\n    \"hardness\": 0.2,\n    \"minStateId\": 780,\n    \"maxStateId\": 795,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"part\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"HEAD\",\n          \"FOOT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"occupied\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      593\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 77,\n    \"displayName\": \"Light Blue Bed\",\n    \"name\": \"light_blue_bed\",\n    \"hardness\": 0.2,\n    \"minStateId\": 796,\n    \"maxStateId\": 811,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"part\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"HEAD\",\n          \"FOOT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"occupied\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      594\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 78,\n    \"displayName\": \"Yellow Bed\",\n    \"name\": \"yellow_bed\",\n    \"hardness\": 0.2,\n    \"minStateId\": 812,\n    \"maxStateId\": 827,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"part\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"HEAD\",\n          \"FOOT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"occupied\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      595\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 79,\n    \"displayName\": \"Lime Bed\",\n    \"name\": \"lime_bed\",\n    \"hardness\": 0.2,\n    \"minStateId\": 828,\n    \"maxStateId\": 843,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"part\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"HEAD\",\n          \"FOOT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"occupied\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      596\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 80,\n    \"displayName\": \"Pink Bed\",\n    \"name\": \"pink_bed\",\n    \"hardness\": 0.2,\n    \"minStateId\": 844,\n    \"maxStateId\": 859,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"part\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"HEAD\",\n          \"FOOT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"occupied\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      597\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 81,\n    \"displayName\": \"Gray Bed\",\n    \"name\": \"gray_bed\",\n    \"hardness\": 0.2,\n    \"minStateId\": 860,\n    \"maxStateId\": 875,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"part\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"HEAD\",\n          \"FOOT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"occupied\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      598\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 82,\n    \"displayName\": \"Light Gray Bed\",\n    \"name\": \"light_gray_bed\",\n    \"hardness\": 0.2,\n    \"minStateId\": 876,\n    \"maxStateId\": 891,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"part\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"HEAD\",\n          \"FOOT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"occupied\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      599\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 83,\n    \"displayName\": \"Cyan Bed\",\n    \"name\": \"cyan_bed\",\n    \"hardness\": 0.2,\n    \"minStateId\": 892,\n    \"maxStateId\": 907,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"part\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"HEAD\",\n          \"FOOT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"occupied\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      600\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 84,\n    \"displayName\": \"Purple Bed\",\n    \"name\": \"purple_bed\",\n    \"hardness\": 0.2,\n    \"minStateId\": 908,\n    \"maxStateId\": 923,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"part\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"HEAD\",\n          \"FOOT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"occupied\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      601\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 85,\n    \"displayName\": \"Blue Bed\",\n    \"name\": \"blue_bed\",\n    \"hardness\": 0.2,\n    \"minStateId\": 924,\n    \"maxStateId\": 939,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"part\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"HEAD\",\n          \"FOOT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"occupied\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      602\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 86,\n    \"displayName\": \"Brown Bed\",\n    \"name\": \"brown_bed\",\n    \"hardness\": 0.2,\n    \"minStateId\": 940,\n    \"maxStateId\": 955,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"part\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"HEAD\",\n          \"FOOT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"occupied\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      603\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 87,\n    \"displayName\": \"Green Bed\",\n    \"name\": \"green_bed\",\n    \"hardness\": 0.2,\n    \"minStateId\": 956,\n    \"maxStateId\": 971,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"part\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"HEAD\",\n          \"FOOT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"occupied\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      604\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 88,\n    \"displayName\": \"Red Bed\",\n    \"name\": \"red_bed\",\n    \"hardness\": 0.2,\n    \"minStateId\": 972,\n    \"maxStateId\": 987,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"part\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"HEAD\",\n          \"FOOT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"occupied\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      605\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 89,\n    \"displayName\": \"Black Bed\",\n    \"name\": \"black_bed\",\n    \"hardness\": 0.2,\n    \"minStateId\": 988,\n    \"maxStateId\": 1003,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"part\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"HEAD\",\n          \"FOOT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"occupied\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      606\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 90,\n    \"displayName\": \"Powered Rail\",\n    \"name\": \"powered_rail\",\n    \"hardness\": 0.7,\n    \"minStateId\": 1004,\n    \"maxStateId\": 1015,\n    \"states\": [\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"NORTH_SOUTH\",\n          \"EAST_WEST\",\n          \"ASCENDING_EAST\",\n          \"ASCENDING_WEST\",\n          \"ASCENDING_NORTH\",\n          \"ASCENDING_SOUTH\"\n        ],\n        \"num_values\": 6\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      72\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 91,\n    \"displayName\": \"Detector Rail\",\n    \"name\": \"detector_rail\",\n    \"hardness\": 0.7,\n    \"minStateId\": 1016,\n    \"maxStateId\": 1027,\n    \"states\": [\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"NORTH_SOUTH\",\n          \"EAST_WEST\",\n          \"ASCENDING_EAST\",\n          \"ASCENDING_WEST\",\n          \"ASCENDING_NORTH\",\n          \"ASCENDING_SOUTH\"\n        ],\n        \"num_values\": 6\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      73\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 92,\n    \"displayName\": \"Sticky Piston\",\n    \"name\": \"sticky_piston\",\n    \"hardness\": 0.5,\n    \"minStateId\": 1028,\n    \"maxStateId\": 1039,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      },\n      {\n        \"name\": \"extended\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      74\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 93,\n    \"displayName\": \"Cobweb\",\n    \"name\": \"cobweb\",\n    \"hardness\": 4,\n    \"minStateId\": 1040,\n    \"maxStateId\": 1040,\n    \"states\": [],\n    \"drops\": [\n      75\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"web\",\n    \"harvestTools\": {\n      \"479\": true,\n      \"480\": true,\n      \"484\": true,\n      \"488\": true,\n      \"495\": true,\n      \"609\": true\n    }\n  },\n  {\n    \"id\": 94,\n    \"displayName\": \"Grass\",\n    \"name\": \"grass\",\n    \"hardness\": 0,\n    \"minStateId\": 1041,\n    \"maxStateId\": 1041,\n    \"states\": [],\n    \"drops\": [\n      76\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 95,\n    \"displayName\": \"Fern\",\n    \"name\": \"fern\",\n    \"hardness\": 0,\n    \"minStateId\": 1042,\n    \"maxStateId\": 1042,\n    \"states\": [],\n    \"drops\": [\n      77\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 96,\n    \"displayName\": \"Dead Bush\",\n    \"name\": \"dead_bush\",\n    \"hardness\": 0,\n    \"minStateId\": 1043,\n    \"maxStateId\": 1043,\n    \"states\": [],\n    \"drops\": [\n      78\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 97,\n    \"displayName\": \"Seagrass\",\n    \"name\": \"seagrass\",\n    \"hardness\": 0,\n    \"minStateId\": 1044,\n    \"maxStateId\": 1044,\n    \"states\": [],\n    \"drops\": [\n      79\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 98,\n    \"displayName\": \"Tall Seagrass\",\n    \"name\": \"tall_seagrass\",\n    \"hardness\": 0,\n    \"minStateId\": 1045,\n    \"maxStateId\": 1046,\n    \"states\": [\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"UPPER\",\n          \"LOWER\"\n        ],\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      79\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 99,\n    \"displayName\": \"Piston\",\n    \"name\": \"piston\",\n    \"hardness\": 0.5,\n    \"minStateId\": 1047,\n    \"maxStateId\": 1058,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      },\n      {\n        \"name\": \"extended\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      81\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 100,\n    \"displayName\": \"Piston Head\",\n    \"name\": \"piston_head\",\n    \"hardness\": 0.5,\n    \"minStateId\": 1059,\n    \"maxStateId\": 1082,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      },\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"DEFAULT\",\n          \"STICKY\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"short\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 101,\n    \"displayName\": \"White Wool\",\n    \"name\": \"white_wool\",\n    \"hardness\": 0.8,\n    \"minStateId\": 1083,\n    \"maxStateId\": 1083,\n    \"states\": [],\n    \"drops\": [\n      82\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wool\"\n  },\n  {\n    \"id\": 102,\n    \"displayName\": \"Orange Wool\",\n    \"name\": \"orange_wool\",\n    \"hardness\": 0.8,\n    \"minStateId\": 1084,\n    \"maxStateId\": 1084,\n    \"states\": [],\n    \"drops\": [\n      83\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wool\"\n  },\n  {\n    \"id\": 103,\n    \"displayName\": \"Magenta Wool\",\n    \"name\": \"magenta_wool\",\n    \"hardness\": 0.8,\n    \"minStateId\": 1085,\n    \"maxStateId\": 1085,\n    \"states\": [],\n    \"drops\": [\n      84\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wool\"\n  },\n  {\n    \"id\": 104,\n    \"displayName\": \"Light Blue Wool\",\n    \"name\": \"light_blue_wool\",\n    \"hardness\": 0.8,\n    \"minStateId\": 1086,\n    \"maxStateId\": 1086,\n    \"states\": [],\n    \"drops\": [\n      85\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wool\"\n  },\n  {\n    \"id\": 105,\n    \"displayName\": \"Yellow Wool\",\n    \"name\": \"yellow_wool\",\n    \"hardness\": 0.8,\n    \"minStateId\": 1087,\n    \"maxStateId\": 1087,\n    \"states\": [],\n    \"drops\": [\n      86\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wool\"\n  },\n  {\n    \"id\": 106,\n    \"displayName\": \"Lime Wool\",\n    \"name\": \"lime_wool\",\n    \"hardness\": 0.8,\n    \"minStateId\": 1088,\n    \"maxStateId\": 1088,\n    \"states\": [],\n    \"drops\": [\n      87\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wool\"\n  },\n  {\n    \"id\": 107,\n    \"displayName\": \"Pink Wool\",\n    \"name\": \"pink_wool\",\n    \"hardness\": 0.8,\n    \"minStateId\": 1089,\n    \"maxStateId\": 1089,\n    \"states\": [],\n    \"drops\": [\n      88\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wool\"\n  },\n  {\n    \"id\": 108,\n    \"displayName\": \"Gray Wool\",\n    \"name\": \"gray_wool\",\n    \"hardness\": 0.8,\n    \"minStateId\": 1090,\n    \"maxStateId\": 1090,\n    \"states\": [],\n    \"drops\": [\n      89\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wool\"\n  },\n  {\n    \"id\": 109,\n    \"displayName\": \"Light Gray Wool\",\n    \"name\": \"light_gray_wool\",\n    \"hardness\": 0.8,\n    \"minStateId\": 1091,\n    \"maxStateId\": 1091,\n    \"states\": [],\n    \"drops\": [\n      90\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wool\"\n  },\n  {\n    \"id\": 110,\n    \"displayName\": \"Cyan Wool\",\n    \"name\": \"cyan_wool\",\n    \"hardness\": 0.8,\n    \"minStateId\": 1092,\n    \"maxStateId\": 1092,\n    \"states\": [],\n    \"drops\": [\n      91\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wool\"\n  },\n  {\n    \"id\": 111,\n    \"displayName\": \"Purple Wool\",\n    \"name\": \"purple_wool\",\n    \"hardness\": 0.8,\n    \"minStateId\": 1093,\n    \"maxStateId\": 1093,\n    \"states\": [],\n    \"drops\": [\n      92\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wool\"\n  },\n  {\n    \"id\": 112,\n    \"displayName\": \"Blue Wool\",\n    \"name\": \"blue_wool\",\n    \"hardness\": 0.8,\n    \"minStateId\": 1094,\n    \"maxStateId\": 1094,\n    \"states\": [],\n    \"drops\": [\n      93\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wool\"\n  },\n  {\n    \"id\": 113,\n    \"displayName\": \"Brown Wool\",\n    \"name\": \"brown_wool\",\n    \"hardness\": 0.8,\n    \"minStateId\": 1095,\n    \"maxStateId\": 1095,\n    \"states\": [],\n    \"drops\": [\n      94\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wool\"\n  },\n  {\n    \"id\": 114,\n    \"displayName\": \"Green Wool\",\n    \"name\": \"green_wool\",\n    \"hardness\": 0.8,\n    \"minStateId\": 1096,\n    \"maxStateId\": 1096,\n    \"states\": [],\n    \"drops\": [\n      95\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wool\"\n  },\n  {\n    \"id\": 115,\n    \"displayName\": \"Red Wool\",\n    \"name\": \"red_wool\",\n    \"hardness\": 0.8,\n    \"minStateId\": 1097,\n    \"maxStateId\": 1097,\n    \"states\": [],\n    \"drops\": [\n      96\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wool\"\n  },\n  {\n    \"id\": 116,\n    \"displayName\": \"Black Wool\",\n    \"name\": \"black_wool\",\n    \"hardness\": 0.8,\n    \"minStateId\": 1098,\n    \"maxStateId\": 1098,\n    \"states\": [],\n    \"drops\": [\n      97\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wool\"\n  },\n  {\n    \"id\": 117,\n    \"displayName\": \"Moving Piston\",\n    \"name\": \"moving_piston\",\n    \"hardness\": null,\n    \"minStateId\": 1099,\n    \"maxStateId\": 1110,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      },\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"DEFAULT\",\n          \"STICKY\"\n        ],\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 118,\n    \"displayName\": \"Dandelion\",\n    \"name\": \"dandelion\",\n    \"hardness\": 0,\n    \"minStateId\": 1111,\n    \"maxStateId\": 1111,\n    \"states\": [],\n    \"drops\": [\n      98\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 119,\n    \"displayName\": \"Poppy\",\n    \"name\": \"poppy\",\n    \"hardness\": 0,\n    \"minStateId\": 1112,\n    \"maxStateId\": 1112,\n    \"states\": [],\n    \"drops\": [\n      99\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 120,\n    \"displayName\": \"Blue Orchid\",\n    \"name\": \"blue_orchid\",\n    \"hardness\": 0,\n    \"minStateId\": 1113,\n    \"maxStateId\": 1113,\n    \"states\": [],\n    \"drops\": [\n      100\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 121,\n    \"displayName\": \"Allium\",\n    \"name\": \"allium\",\n    \"hardness\": 0,\n    \"minStateId\": 1114,\n    \"maxStateId\": 1114,\n    \"states\": [],\n    \"drops\": [\n      101\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 122,\n    \"displayName\": \"Azure Bluet\",\n    \"name\": \"azure_bluet\",\n    \"hardness\": 0,\n    \"minStateId\": 1115,\n    \"maxStateId\": 1115,\n    \"states\": [],\n    \"drops\": [\n      102\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 123,\n    \"displayName\": \"Red Tulip\",\n    \"name\": \"red_tulip\",\n    \"hardness\": 0,\n    \"minStateId\": 1116,\n    \"maxStateId\": 1116,\n    \"states\": [],\n    \"drops\": [\n      103\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 124,\n    \"displayName\": \"Orange Tulip\",\n    \"name\": \"orange_tulip\",\n    \"hardness\": 0,\n    \"minStateId\": 1117,\n    \"maxStateId\": 1117,\n    \"states\": [],\n    \"drops\": [\n      104\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 125,\n    \"displayName\": \"White Tulip\",\n    \"name\": \"white_tulip\",\n    \"hardness\": 0,\n    \"minStateId\": 1118,\n    \"maxStateId\": 1118,\n    \"states\": [],\n    \"drops\": [\n      105\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 126,\n    \"displayName\": \"Pink Tulip\",\n    \"name\": \"pink_tulip\",\n    \"hardness\": 0,\n    \"minStateId\": 1119,\n    \"maxStateId\": 1119,\n    \"states\": [],\n    \"drops\": [\n      106\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 127,\n    \"displayName\": \"Oxeye Daisy\",\n    \"name\": \"oxeye_daisy\",\n    \"hardness\": 0,\n    \"minStateId\": 1120,\n    \"maxStateId\": 1120,\n    \"states\": [],\n    \"drops\": [\n      107\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 128,\n    \"displayName\": \"Brown Mushroom\",\n    \"name\": \"brown_mushroom\",\n    \"hardness\": 0,\n    \"minStateId\": 1121,\n    \"maxStateId\": 1121,\n    \"states\": [],\n    \"drops\": [\n      108\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 1,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 129,\n    \"displayName\": \"Red Mushroom\",\n    \"name\": \"red_mushroom\",\n    \"hardness\": 0,\n    \"minStateId\": 1122,\n    \"maxStateId\": 1122,\n    \"states\": [],\n    \"drops\": [\n      109\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 1,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 130,\n    \"displayName\": \"Block of Gold\",\n    \"name\": \"gold_block\",\n    \"hardness\": 3,\n    \"minStateId\": 1123,\n    \"maxStateId\": 1123,\n    \"states\": [],\n    \"drops\": [\n      110\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"490\": true\n    }\n  },\n  {\n    \"id\": 131,\n    \"displayName\": \"Block of Iron\",\n    \"name\": \"iron_block\",\n    \"hardness\": 5,\n    \"minStateId\": 1124,\n    \"maxStateId\": 1124,\n    \"states\": [],\n    \"drops\": [\n      111\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"486\": true,\n      \"490\": true\n    }\n  },\n  {\n    \"id\": 132,\n    \"displayName\": \"Bricks\",\n    \"name\": \"bricks\",\n    \"hardness\": 2,\n    \"minStateId\": 1125,\n    \"maxStateId\": 1125,\n    \"states\": [],\n    \"drops\": [\n      135\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 133,\n    \"displayName\": \"TNT\",\n    \"name\": \"tnt\",\n    \"hardness\": 0,\n    \"minStateId\": 1126,\n    \"maxStateId\": 1126,\n    \"states\": [],\n    \"drops\": [\n      136\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 134,\n    \"displayName\": \"Bookshelf\",\n    \"name\": \"bookshelf\",\n    \"hardness\": 1.5,\n    \"minStateId\": 1127,\n    \"maxStateId\": 1127,\n    \"states\": [],\n    \"drops\": [\n      137\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 135,\n    \"displayName\": \"Mossy Cobblestone\",\n    \"name\": \"mossy_cobblestone\",\n    \"hardness\": 2,\n    \"minStateId\": 1128,\n    \"maxStateId\": 1128,\n    \"states\": [],\n    \"drops\": [\n      138\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 136,\n    \"displayName\": \"Obsidian\",\n    \"name\": \"obsidian\",\n    \"hardness\": 50,\n    \"minStateId\": 1129,\n    \"maxStateId\": 1129,\n    \"states\": [],\n    \"drops\": [\n      139\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"490\": true\n    }\n  },\n  {\n    \"id\": 137,\n    \"displayName\": \"Torch\",\n    \"name\": \"torch\",\n    \"hardness\": 0,\n    \"minStateId\": 1130,\n    \"maxStateId\": 1130,\n    \"states\": [],\n    \"drops\": [\n      140\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 138,\n    \"displayName\": \"Wall Torch\",\n    \"name\": \"wall_torch\",\n    \"hardness\": 0,\n    \"minStateId\": 1131,\n    \"maxStateId\": 1134,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      140\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 139,\n    \"displayName\": \"Fire\",\n    \"name\": \"fire\",\n    \"hardness\": 0,\n    \"minStateId\": 1135,\n    \"maxStateId\": 1646,\n    \"states\": [\n      {\n        \"name\": \"age\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      },\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"up\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 15,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 140,\n    \"displayName\": \"Spawner\",\n    \"name\": \"spawner\",\n    \"hardness\": 5,\n    \"minStateId\": 1647,\n    \"maxStateId\": 1647,\n    \"states\": [],\n    \"drops\": [\n      147\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 141,\n    \"displayName\": \"Oak Stairs\",\n    \"name\": \"oak_stairs\",\n    \"hardness\": 2,\n    \"minStateId\": 1648,\n    \"maxStateId\": 1727,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"STRAIGHT\",\n          \"INNER_LEFT\",\n          \"INNER_RIGHT\",\n          \"OUTER_LEFT\",\n          \"OUTER_RIGHT\"\n        ],\n        \"num_values\": 5\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      148\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 142,\n    \"displayName\": \"Chest\",\n    \"name\": \"chest\",\n    \"hardness\": 2.5,\n    \"minStateId\": 1728,\n    \"maxStateId\": 1751,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"SINGLE\",\n          \"LEFT\",\n          \"RIGHT\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      149\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 143,\n    \"displayName\": \"Redstone Dust\",\n    \"name\": \"redstone_wire\",\n    \"hardness\": 0,\n    \"minStateId\": 1752,\n    \"maxStateId\": 3047,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"UP\",\n          \"SIDE\",\n          \"NONE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"UP\",\n          \"SIDE\",\n          \"NONE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"UP\",\n          \"SIDE\",\n          \"NONE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"UP\",\n          \"SIDE\",\n          \"NONE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"power\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      542\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 144,\n    \"displayName\": \"Diamond Ore\",\n    \"name\": \"diamond_ore\",\n    \"hardness\": 3,\n    \"minStateId\": 3048,\n    \"maxStateId\": 3048,\n    \"states\": [],\n    \"drops\": [\n      150\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"490\": true\n    }\n  },\n  {\n    \"id\": 145,\n    \"displayName\": \"Block of Diamond\",\n    \"name\": \"diamond_block\",\n    \"hardness\": 5,\n    \"minStateId\": 3049,\n    \"maxStateId\": 3049,\n    \"states\": [],\n    \"drops\": [\n      151\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"490\": true\n    }\n  },\n  {\n    \"id\": 146,\n    \"displayName\": \"Crafting Table\",\n    \"name\": \"crafting_table\",\n    \"hardness\": 2.5,\n    \"minStateId\": 3050,\n    \"maxStateId\": 3050,\n    \"states\": [],\n    \"drops\": [\n      152\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 147,\n    \"displayName\": \"Wheat Crops\",\n    \"name\": \"wheat\",\n    \"hardness\": 0,\n    \"minStateId\": 3051,\n    \"maxStateId\": 3058,\n    \"states\": [\n      {\n        \"name\": \"age\",\n        \"type\": \"int\",\n        \"num_values\": 8\n      }\n    ],\n    \"drops\": [\n      508\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 148,\n    \"displayName\": \"Farmland\",\n    \"name\": \"farmland\",\n    \"hardness\": 0.6,\n    \"minStateId\": 3059,\n    \"maxStateId\": 3066,\n    \"states\": [\n      {\n        \"name\": \"moisture\",\n        \"type\": \"int\",\n        \"num_values\": 8\n      }\n    ],\n    \"drops\": [\n      153\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 149,\n    \"displayName\": \"Furnace\",\n    \"name\": \"furnace\",\n    \"hardness\": 3.5,\n    \"minStateId\": 3067,\n    \"maxStateId\": 3074,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"lit\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      154\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 13,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 150,\n    \"displayName\": \"Sign\",\n    \"name\": \"sign\",\n    \"hardness\": 1,\n    \"minStateId\": 3075,\n    \"maxStateId\": 3106,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      536\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 151,\n    \"displayName\": \"Oak Door\",\n    \"name\": \"oak_door\",\n    \"hardness\": 3,\n    \"minStateId\": 3107,\n    \"maxStateId\": 3170,\n    \"states\": [\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"UPPER\",\n          \"LOWER\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"hinge\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"LEFT\",\n          \"RIGHT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      456\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 152,\n    \"displayName\": \"Ladder\",\n    \"name\": \"ladder\",\n    \"hardness\": 0.4,\n    \"minStateId\": 3171,\n    \"maxStateId\": 3178,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      155\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 153,\n    \"displayName\": \"Rail\",\n    \"name\": \"rail\",\n    \"hardness\": 0.7,\n    \"minStateId\": 3179,\n    \"maxStateId\": 3188,\n    \"states\": [\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"NORTH_SOUTH\",\n          \"EAST_WEST\",\n          \"ASCENDING_EAST\",\n          \"ASCENDING_WEST\",\n          \"ASCENDING_NORTH\",\n          \"ASCENDING_SOUTH\",\n          \"SOUTH_EAST\",\n          \"SOUTH_WEST\",\n          \"NORTH_WEST\",\n          \"NORTH_EAST\"\n        ],\n        \"num_values\": 10\n      }\n    ],\n    \"drops\": [\n      156\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 154,\n    \"displayName\": \"Cobblestone Stairs\",\n    \"name\": \"cobblestone_stairs\",\n    \"hardness\": 2,\n    \"minStateId\": 3189,\n    \"maxStateId\": 3268,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"STRAIGHT\",\n          \"INNER_LEFT\",\n          \"INNER_RIGHT\",\n          \"OUTER_LEFT\",\n          \"OUTER_RIGHT\"\n        ],\n        \"num_values\": 5\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      157\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 155,\n    \"displayName\": \"Wall Sign\",\n    \"name\": \"wall_sign\",\n    \"hardness\": 1,\n    \"minStateId\": 3269,\n    \"maxStateId\": 3276,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      536\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 156,\n    \"displayName\": \"Lever\",\n    \"name\": \"lever\",\n    \"hardness\": 0.5,\n    \"minStateId\": 3277,\n    \"maxStateId\": 3300,\n    \"states\": [\n      {\n        \"name\": \"face\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"FLOOR\",\n          \"WALL\",\n          \"CEILING\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      158\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 157,\n    \"displayName\": \"Stone Pressure Plate\",\n    \"name\": \"stone_pressure_plate\",\n    \"hardness\": 0.5,\n    \"minStateId\": 3301,\n    \"maxStateId\": 3302,\n    \"states\": [\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      159\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 158,\n    \"displayName\": \"Iron Door\",\n    \"name\": \"iron_door\",\n    \"hardness\": 5,\n    \"minStateId\": 3303,\n    \"maxStateId\": 3366,\n    \"states\": [\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"UPPER\",\n          \"LOWER\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"hinge\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"LEFT\",\n          \"RIGHT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      455\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 159,\n    \"displayName\": \"Oak Pressure Plate\",\n    \"name\": \"oak_pressure_plate\",\n    \"hardness\": 0.5,\n    \"minStateId\": 3367,\n    \"maxStateId\": 3368,\n    \"states\": [\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      160\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 160,\n    \"displayName\": \"Spruce Pressure Plate\",\n    \"name\": \"spruce_pressure_plate\",\n    \"hardness\": 0.5,\n    \"minStateId\": 3369,\n    \"maxStateId\": 3370,\n    \"states\": [\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      161\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 161,\n    \"displayName\": \"Birch Pressure Plate\",\n    \"name\": \"birch_pressure_plate\",\n    \"hardness\": 0.5,\n    \"minStateId\": 3371,\n    \"maxStateId\": 3372,\n    \"states\": [\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      162\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 162,\n    \"displayName\": \"Jungle Pressure Plate\",\n    \"name\": \"jungle_pressure_plate\",\n    \"hardness\": 0.5,\n    \"minStateId\": 3373,\n    \"maxStateId\": 3374,\n    \"states\": [\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      163\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 163,\n    \"displayName\": \"Acacia Pressure Plate\",\n    \"name\": \"acacia_pressure_plate\",\n    \"hardness\": 0.5,\n    \"minStateId\": 3375,\n    \"maxStateId\": 3376,\n    \"states\": [\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      164\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 164,\n    \"displayName\": \"Dark Oak Pressure Plate\",\n    \"name\": \"dark_oak_pressure_plate\",\n    \"hardness\": 0.5,\n    \"minStateId\": 3377,\n    \"maxStateId\": 3378,\n    \"states\": [\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      165\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 165,\n    \"displayName\": \"Redstone Ore\",\n    \"name\": \"redstone_ore\",\n    \"hardness\": 3,\n    \"minStateId\": 3379,\n    \"maxStateId\": 3380,\n    \"states\": [\n      {\n        \"name\": \"lit\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      166\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 9,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"490\": true\n    }\n  },\n  {\n    \"id\": 166,\n    \"displayName\": \"Redstone Torch\",\n    \"name\": \"redstone_torch\",\n    \"hardness\": 0,\n    \"minStateId\": 3381,\n    \"maxStateId\": 3382,\n    \"states\": [\n      {\n        \"name\": \"lit\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      167\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 7,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 167,\n    \"displayName\": \"Redstone Wall Torch\",\n    \"name\": \"redstone_wall_torch\",\n    \"hardness\": 0,\n    \"minStateId\": 3383,\n    \"maxStateId\": 3390,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"lit\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      167\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 7,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 168,\n    \"displayName\": \"Stone Button\",\n    \"name\": \"stone_button\",\n    \"hardness\": 0.5,\n    \"minStateId\": 3391,\n    \"maxStateId\": 3414,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"face\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"FLOOR\",\n          \"WALL\",\n          \"CEILING\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      168\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 169,\n    \"displayName\": \"Snow\",\n    \"name\": \"snow\",\n    \"hardness\": 0.1,\n    \"minStateId\": 3415,\n    \"maxStateId\": 3422,\n    \"states\": [\n      {\n        \"name\": \"layers\",\n        \"type\": \"int\",\n        \"num_values\": 8\n      }\n    ],\n    \"drops\": [\n      169\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\",\n    \"harvestTools\": {\n      \"467\": true,\n      \"481\": true,\n      \"485\": true,\n      \"489\": true,\n      \"496\": true\n    }\n  },\n  {\n    \"id\": 170,\n    \"displayName\": \"Ice\",\n    \"name\": \"ice\",\n    \"hardness\": 0.5,\n    \"minStateId\": 3423,\n    \"maxStateId\": 3423,\n    \"states\": [],\n    \"drops\": [\n      170\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 171,\n    \"displayName\": \"Snow Block\",\n    \"name\": \"snow_block\",\n    \"hardness\": 0.2,\n    \"minStateId\": 3424,\n    \"maxStateId\": 3424,\n    \"states\": [],\n    \"drops\": [\n      171\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\",\n    \"harvestTools\": {\n      \"467\": true,\n      \"481\": true,\n      \"485\": true,\n      \"489\": true,\n      \"496\": true\n    }\n  },\n  {\n    \"id\": 172,\n    \"displayName\": \"Cactus\",\n    \"name\": \"cactus\",\n    \"hardness\": 0.4,\n    \"minStateId\": 3425,\n    \"maxStateId\": 3440,\n    \"states\": [\n      {\n        \"name\": \"age\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      172\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 173,\n    \"displayName\": \"Clay\",\n    \"name\": \"clay\",\n    \"hardness\": 0.6,\n    \"minStateId\": 3441,\n    \"maxStateId\": 3441,\n    \"states\": [],\n    \"drops\": [\n      173\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 174,\n    \"displayName\": \"Sugar Cane\",\n    \"name\": \"sugar_cane\",\n    \"hardness\": 0,\n    \"minStateId\": 3442,\n    \"maxStateId\": 3457,\n    \"states\": [\n      {\n        \"name\": \"age\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      553\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 175,\n    \"displayName\": \"Jukebox\",\n    \"name\": \"jukebox\",\n    \"hardness\": 2,\n    \"minStateId\": 3458,\n    \"maxStateId\": 3459,\n    \"states\": [\n      {\n        \"name\": \"has_record\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      174\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 176,\n    \"displayName\": \"Oak Fence\",\n    \"name\": \"oak_fence\",\n    \"hardness\": 2,\n    \"minStateId\": 3460,\n    \"maxStateId\": 3491,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      175\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 177,\n    \"displayName\": \"Pumpkin\",\n    \"name\": \"pumpkin\",\n    \"hardness\": 1,\n    \"minStateId\": 3492,\n    \"maxStateId\": 3492,\n    \"states\": [],\n    \"drops\": [\n      181\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 178,\n    \"displayName\": \"Netherrack\",\n    \"name\": \"netherrack\",\n    \"hardness\": 0.4,\n    \"minStateId\": 3493,\n    \"maxStateId\": 3493,\n    \"states\": [],\n    \"drops\": [\n      183\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 179,\n    \"displayName\": \"Soul Sand\",\n    \"name\": \"soul_sand\",\n    \"hardness\": 0.5,\n    \"minStateId\": 3494,\n    \"maxStateId\": 3494,\n    \"states\": [],\n    \"drops\": [\n      184\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 180,\n    \"displayName\": \"Glowstone\",\n    \"name\": \"glowstone\",\n    \"hardness\": 0.3,\n    \"minStateId\": 3495,\n    \"maxStateId\": 3495,\n    \"states\": [],\n    \"drops\": [\n      185\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 181,\n    \"displayName\": \"Nether Portal\",\n    \"name\": \"nether_portal\",\n    \"hardness\": null,\n    \"minStateId\": 3496,\n    \"maxStateId\": 3497,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Z\"\n        ],\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 11,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 182,\n    \"displayName\": \"Carved Pumpkin\",\n    \"name\": \"carved_pumpkin\",\n    \"hardness\": 1,\n    \"minStateId\": 3498,\n    \"maxStateId\": 3501,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      182\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 183,\n    \"displayName\": \"Jack o'Lantern\",\n    \"name\": \"jack_o_lantern\",\n    \"hardness\": 1,\n    \"minStateId\": 3502,\n    \"maxStateId\": 3505,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      186\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 15,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 184,\n    \"displayName\": \"Cake\",\n    \"name\": \"cake\",\n    \"hardness\": 0.5,\n    \"minStateId\": 3506,\n    \"maxStateId\": 3512,\n    \"states\": [\n      {\n        \"name\": \"bites\",\n        \"type\": \"int\",\n        \"num_values\": 7\n      }\n    ],\n    \"drops\": [\n      590\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 185,\n    \"displayName\": \"Redstone Repeater\",\n    \"name\": \"repeater\",\n    \"hardness\": 0,\n    \"minStateId\": 3513,\n    \"maxStateId\": 3576,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"delay\",\n        \"type\": \"int\",\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"locked\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      462\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 186,\n    \"displayName\": \"White Stained Glass\",\n    \"name\": \"white_stained_glass\",\n    \"hardness\": 0.3,\n    \"minStateId\": 3577,\n    \"maxStateId\": 3577,\n    \"states\": [],\n    \"drops\": [\n      311\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 187,\n    \"displayName\": \"Orange Stained Glass\",\n    \"name\": \"orange_stained_glass\",\n    \"hardness\": 0.3,\n    \"minStateId\": 3578,\n    \"maxStateId\": 3578,\n    \"states\": [],\n    \"drops\": [\n      312\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 188,\n    \"displayName\": \"Magenta Stained Glass\",\n    \"name\": \"magenta_stained_glass\",\n    \"hardness\": 0.3,\n    \"minStateId\": 3579,\n    \"maxStateId\": 3579,\n    \"states\": [],\n    \"drops\": [\n      313\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 189,\n    \"displayName\": \"Light Blue Stained Glass\",\n    \"name\": \"light_blue_stained_glass\",\n    \"hardness\": 0.3,\n    \"minStateId\": 3580,\n    \"maxStateId\": 3580,\n    \"states\": [],\n    \"drops\": [\n      314\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 190,\n    \"displayName\": \"Yellow Stained Glass\",\n    \"name\": \"yellow_stained_glass\",\n    \"hardness\": 0.3,\n    \"minStateId\": 3581,\n    \"maxStateId\": 3581,\n    \"states\": [],\n    \"drops\": [\n      315\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 191,\n    \"displayName\": \"Lime Stained Glass\",\n    \"name\": \"lime_stained_glass\",\n    \"hardness\": 0.3,\n    \"minStateId\": 3582,\n    \"maxStateId\": 3582,\n    \"states\": [],\n    \"drops\": [\n      316\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 192,\n    \"displayName\": \"Pink Stained Glass\",\n    \"name\": \"pink_stained_glass\",\n    \"hardness\": 0.3,\n    \"minStateId\": 3583,\n    \"maxStateId\": 3583,\n    \"states\": [],\n    \"drops\": [\n      317\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 193,\n    \"displayName\": \"Gray Stained Glass\",\n    \"name\": \"gray_stained_glass\",\n    \"hardness\": 0.3,\n    \"minStateId\": 3584,\n    \"maxStateId\": 3584,\n    \"states\": [],\n    \"drops\": [\n      318\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 194,\n    \"displayName\": \"Light Gray Stained Glass\",\n    \"name\": \"light_gray_stained_glass\",\n    \"hardness\": 0.3,\n    \"minStateId\": 3585,\n    \"maxStateId\": 3585,\n    \"states\": [],\n    \"drops\": [\n      319\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 195,\n    \"displayName\": \"Cyan Stained Glass\",\n    \"name\": \"cyan_stained_glass\",\n    \"hardness\": 0.3,\n    \"minStateId\": 3586,\n    \"maxStateId\": 3586,\n    \"states\": [],\n    \"drops\": [\n      320\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 196,\n    \"displayName\": \"Purple Stained Glass\",\n    \"name\": \"purple_stained_glass\",\n    \"hardness\": 0.3,\n    \"minStateId\": 3587,\n    \"maxStateId\": 3587,\n    \"states\": [],\n    \"drops\": [\n      321\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 197,\n    \"displayName\": \"Blue Stained Glass\",\n    \"name\": \"blue_stained_glass\",\n    \"hardness\": 0.3,\n    \"minStateId\": 3588,\n    \"maxStateId\": 3588,\n    \"states\": [],\n    \"drops\": [\n      322\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 198,\n    \"displayName\": \"Brown Stained Glass\",\n    \"name\": \"brown_stained_glass\",\n    \"hardness\": 0.3,\n    \"minStateId\": 3589,\n    \"maxStateId\": 3589,\n    \"states\": [],\n    \"drops\": [\n      323\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 199,\n    \"displayName\": \"Green Stained Glass\",\n    \"name\": \"green_stained_glass\",\n    \"hardness\": 0.3,\n    \"minStateId\": 3590,\n    \"maxStateId\": 3590,\n    \"states\": [],\n    \"drops\": [\n      324\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 200,\n    \"displayName\": \"Red Stained Glass\",\n    \"name\": \"red_stained_glass\",\n    \"hardness\": 0.3,\n    \"minStateId\": 3591,\n    \"maxStateId\": 3591,\n    \"states\": [],\n    \"drops\": [\n      325\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 201,\n    \"displayName\": \"Black Stained Glass\",\n    \"name\": \"black_stained_glass\",\n    \"hardness\": 0.3,\n    \"minStateId\": 3592,\n    \"maxStateId\": 3592,\n    \"states\": [],\n    \"drops\": [\n      326\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 202,\n    \"displayName\": \"Oak Trapdoor\",\n    \"name\": \"oak_trapdoor\",\n    \"hardness\": 3,\n    \"minStateId\": 3593,\n    \"maxStateId\": 3656,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      187\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 203,\n    \"displayName\": \"Spruce Trapdoor\",\n    \"name\": \"spruce_trapdoor\",\n    \"hardness\": 3,\n    \"minStateId\": 3657,\n    \"maxStateId\": 3720,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      188\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 204,\n    \"displayName\": \"Birch Trapdoor\",\n    \"name\": \"birch_trapdoor\",\n    \"hardness\": 3,\n    \"minStateId\": 3721,\n    \"maxStateId\": 3784,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      189\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 205,\n    \"displayName\": \"Jungle Trapdoor\",\n    \"name\": \"jungle_trapdoor\",\n    \"hardness\": 3,\n    \"minStateId\": 3785,\n    \"maxStateId\": 3848,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      190\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 206,\n    \"displayName\": \"Acacia Trapdoor\",\n    \"name\": \"acacia_trapdoor\",\n    \"hardness\": 3,\n    \"minStateId\": 3849,\n    \"maxStateId\": 3912,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      191\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 207,\n    \"displayName\": \"Dark Oak Trapdoor\",\n    \"name\": \"dark_oak_trapdoor\",\n    \"hardness\": 3,\n    \"minStateId\": 3913,\n    \"maxStateId\": 3976,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      192\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 208,\n    \"displayName\": \"Infested Stone\",\n    \"name\": \"infested_stone\",\n    \"hardness\": 0,\n    \"minStateId\": 3977,\n    \"maxStateId\": 3977,\n    \"states\": [],\n    \"drops\": [\n      193\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 209,\n    \"displayName\": \"Infested Cobblestone\",\n    \"name\": \"infested_cobblestone\",\n    \"hardness\": 0,\n    \"minStateId\": 3978,\n    \"maxStateId\": 3978,\n    \"states\": [],\n    \"drops\": [\n      194\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 210,\n    \"displayName\": \"Infested Stone Bricks\",\n    \"name\": \"infested_stone_bricks\",\n    \"hardness\": 0,\n    \"minStateId\": 3979,\n    \"maxStateId\": 3979,\n    \"states\": [],\n    \"drops\": [\n      195\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 211,\n    \"displayName\": \"Infested Mossy Stone Bricks\",\n    \"name\": \"infested_mossy_stone_bricks\",\n    \"hardness\": 0,\n    \"minStateId\": 3980,\n    \"maxStateId\": 3980,\n    \"states\": [],\n    \"drops\": [\n      196\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 212,\n    \"displayName\": \"Infested Cracked Stone Bricks\",\n    \"name\": \"infested_cracked_stone_bricks\",\n    \"hardness\": 0,\n    \"minStateId\": 3981,\n    \"maxStateId\": 3981,\n    \"states\": [],\n    \"drops\": [\n      197\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 213,\n    \"displayName\": \"Infested Chiseled Stone Bricks\",\n    \"name\": \"infested_chiseled_stone_bricks\",\n    \"hardness\": 0,\n    \"minStateId\": 3982,\n    \"maxStateId\": 3982,\n    \"states\": [],\n    \"drops\": [\n      198\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 214,\n    \"displayName\": \"Stone Bricks\",\n    \"name\": \"stone_bricks\",\n    \"hardness\": 1.5,\n    \"minStateId\": 3983,\n    \"maxStateId\": 3983,\n    \"states\": [],\n    \"drops\": [\n      199\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 215,\n    \"displayName\": \"Mossy Stone Bricks\",\n    \"name\": \"mossy_stone_bricks\",\n    \"hardness\": 1.5,\n    \"minStateId\": 3984,\n    \"maxStateId\": 3984,\n    \"states\": [],\n    \"drops\": [\n      200\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 216,\n    \"displayName\": \"Cracked Stone Bricks\",\n    \"name\": \"cracked_stone_bricks\",\n    \"hardness\": 1.5,\n    \"minStateId\": 3985,\n    \"maxStateId\": 3985,\n    \"states\": [],\n    \"drops\": [\n      201\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 217,\n    \"displayName\": \"Chiseled Stone Bricks\",\n    \"name\": \"chiseled_stone_bricks\",\n    \"hardness\": 1.5,\n    \"minStateId\": 3986,\n    \"maxStateId\": 3986,\n    \"states\": [],\n    \"drops\": [\n      202\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 218,\n    \"displayName\": \"Brown Mushroom Block\",\n    \"name\": \"brown_mushroom_block\",\n    \"hardness\": 0.2,\n    \"minStateId\": 3987,\n    \"maxStateId\": 4050,\n    \"states\": [\n      {\n        \"name\": \"up\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"down\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      203\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 219,\n    \"displayName\": \"Red Mushroom Block\",\n    \"name\": \"red_mushroom_block\",\n    \"hardness\": 0.2,\n    \"minStateId\": 4051,\n    \"maxStateId\": 4114,\n    \"states\": [\n      {\n        \"name\": \"up\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"down\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      204\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 220,\n    \"displayName\": \"Mushroom Stem\",\n    \"name\": \"mushroom_stem\",\n    \"hardness\": 0.2,\n    \"minStateId\": 4115,\n    \"maxStateId\": 4178,\n    \"states\": [\n      {\n        \"name\": \"up\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"down\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      205\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 221,\n    \"displayName\": \"Iron Bars\",\n    \"name\": \"iron_bars\",\n    \"hardness\": 5,\n    \"minStateId\": 4179,\n    \"maxStateId\": 4210,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      206\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 222,\n    \"displayName\": \"Glass Pane\",\n    \"name\": \"glass_pane\",\n    \"hardness\": 0.3,\n    \"minStateId\": 4211,\n    \"maxStateId\": 4242,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      207\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 223,\n    \"displayName\": \"Melon\",\n    \"name\": \"melon\",\n    \"hardness\": 1,\n    \"minStateId\": 4243,\n    \"maxStateId\": 4243,\n    \"states\": [],\n    \"drops\": [\n      208\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 224,\n    \"displayName\": \"Attached Pumpkin Stem\",\n    \"name\": \"attached_pumpkin_stem\",\n    \"hardness\": 0,\n    \"minStateId\": 4244,\n    \"maxStateId\": 4247,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 225,\n    \"displayName\": \"Attached Melon Stem\",\n    \"name\": \"attached_melon_stem\",\n    \"hardness\": 0,\n    \"minStateId\": 4248,\n    \"maxStateId\": 4251,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 226,\n    \"displayName\": \"Pumpkin Stem\",\n    \"name\": \"pumpkin_stem\",\n    \"hardness\": 0,\n    \"minStateId\": 4252,\n    \"maxStateId\": 4259,\n    \"states\": [\n      {\n        \"name\": \"age\",\n        \"type\": \"int\",\n        \"num_values\": 8\n      }\n    ],\n    \"drops\": [\n      612\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 227,\n    \"displayName\": \"Melon Stem\",\n    \"name\": \"melon_stem\",\n    \"hardness\": 0,\n    \"minStateId\": 4260,\n    \"maxStateId\": 4267,\n    \"states\": [\n      {\n        \"name\": \"age\",\n        \"type\": \"int\",\n        \"num_values\": 8\n      }\n    ],\n    \"drops\": [\n      613\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 228,\n    \"displayName\": \"Vines\",\n    \"name\": \"vine\",\n    \"hardness\": 0.2,\n    \"minStateId\": 4268,\n    \"maxStateId\": 4299,\n    \"states\": [\n      {\n        \"name\": \"up\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      209\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 229,\n    \"displayName\": \"Oak Fence Gate\",\n    \"name\": \"oak_fence_gate\",\n    \"hardness\": 2,\n    \"minStateId\": 4300,\n    \"maxStateId\": 4331,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"in_wall\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      210\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 230,\n    \"displayName\": \"Brick Stairs\",\n    \"name\": \"brick_stairs\",\n    \"hardness\": 2,\n    \"minStateId\": 4332,\n    \"maxStateId\": 4411,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"STRAIGHT\",\n          \"INNER_LEFT\",\n          \"INNER_RIGHT\",\n          \"OUTER_LEFT\",\n          \"OUTER_RIGHT\"\n        ],\n        \"num_values\": 5\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      216\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 231,\n    \"displayName\": \"Stone Brick Stairs\",\n    \"name\": \"stone_brick_stairs\",\n    \"hardness\": 1.5,\n    \"minStateId\": 4412,\n    \"maxStateId\": 4491,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"STRAIGHT\",\n          \"INNER_LEFT\",\n          \"INNER_RIGHT\",\n          \"OUTER_LEFT\",\n          \"OUTER_RIGHT\"\n        ],\n        \"num_values\": 5\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      217\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 232,\n    \"displayName\": \"Mycelium\",\n    \"name\": \"mycelium\",\n    \"hardness\": 0.6,\n    \"minStateId\": 4492,\n    \"maxStateId\": 4493,\n    \"states\": [\n      {\n        \"name\": \"snowy\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      218\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 233,\n    \"displayName\": \"Lily Pad\",\n    \"name\": \"lily_pad\",\n    \"hardness\": 0,\n    \"minStateId\": 4494,\n    \"maxStateId\": 4494,\n    \"states\": [],\n    \"drops\": [\n      219\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 234,\n    \"displayName\": \"Nether Bricks\",\n    \"name\": \"nether_bricks\",\n    \"hardness\": 2,\n    \"minStateId\": 4495,\n    \"maxStateId\": 4495,\n    \"states\": [],\n    \"drops\": [\n      220\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 235,\n    \"displayName\": \"Nether Brick Fence\",\n    \"name\": \"nether_brick_fence\",\n    \"hardness\": 2,\n    \"minStateId\": 4496,\n    \"maxStateId\": 4527,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      221\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 236,\n    \"displayName\": \"Nether Brick Stairs\",\n    \"name\": \"nether_brick_stairs\",\n    \"hardness\": 2,\n    \"minStateId\": 4528,\n    \"maxStateId\": 4607,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"STRAIGHT\",\n          \"INNER_LEFT\",\n          \"INNER_RIGHT\",\n          \"OUTER_LEFT\",\n          \"OUTER_RIGHT\"\n        ],\n        \"num_values\": 5\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      222\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 237,\n    \"displayName\": \"Nether Wart\",\n    \"name\": \"nether_wart\",\n    \"hardness\": 0,\n    \"minStateId\": 4608,\n    \"maxStateId\": 4611,\n    \"states\": [\n      {\n        \"name\": \"age\",\n        \"type\": \"int\",\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      623\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 238,\n    \"displayName\": \"Enchanting Table\",\n    \"name\": \"enchanting_table\",\n    \"hardness\": 5,\n    \"minStateId\": 4612,\n    \"maxStateId\": 4612,\n    \"states\": [],\n    \"drops\": [\n      223\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 239,\n    \"displayName\": \"Brewing Stand\",\n    \"name\": \"brewing_stand\",\n    \"hardness\": 0.5,\n    \"minStateId\": 4613,\n    \"maxStateId\": 4620,\n    \"states\": [\n      {\n        \"name\": \"has_bottle_0\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"has_bottle_1\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"has_bottle_2\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      630\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 1,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 240,\n    \"displayName\": \"Cauldron\",\n    \"name\": \"cauldron\",\n    \"hardness\": 2,\n    \"minStateId\": 4621,\n    \"maxStateId\": 4624,\n    \"states\": [\n      {\n        \"name\": \"level\",\n        \"type\": \"int\",\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      631\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 241,\n    \"displayName\": \"End Portal\",\n    \"name\": \"end_portal\",\n    \"hardness\": null,\n    \"minStateId\": 4625,\n    \"maxStateId\": 4625,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 15,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 242,\n    \"displayName\": \"End Portal Frame\",\n    \"name\": \"end_portal_frame\",\n    \"hardness\": null,\n    \"minStateId\": 4626,\n    \"maxStateId\": 4633,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"eye\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      224\n    ],\n    \"diggable\": false,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 1,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 243,\n    \"displayName\": \"End Stone\",\n    \"name\": \"end_stone\",\n    \"hardness\": 3,\n    \"minStateId\": 4634,\n    \"maxStateId\": 4634,\n    \"states\": [],\n    \"drops\": [\n      225\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 244,\n    \"displayName\": \"Dragon Egg\",\n    \"name\": \"dragon_egg\",\n    \"hardness\": 3,\n    \"minStateId\": 4635,\n    \"maxStateId\": 4635,\n    \"states\": [],\n    \"drops\": [\n      227\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 245,\n    \"displayName\": \"Redstone Lamp\",\n    \"name\": \"redstone_lamp\",\n    \"hardness\": 0.3,\n    \"minStateId\": 4636,\n    \"maxStateId\": 4637,\n    \"states\": [\n      {\n        \"name\": \"lit\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      228\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 15,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 246,\n    \"displayName\": \"Cocoa\",\n    \"name\": \"cocoa\",\n    \"hardness\": 0.2,\n    \"minStateId\": 4638,\n    \"maxStateId\": 4649,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"age\",\n        \"type\": \"int\",\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      575\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 247,\n    \"displayName\": \"Sandstone Stairs\",\n    \"name\": \"sandstone_stairs\",\n    \"hardness\": 0.8,\n    \"minStateId\": 4650,\n    \"maxStateId\": 4729,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"STRAIGHT\",\n          \"INNER_LEFT\",\n          \"INNER_RIGHT\",\n          \"OUTER_LEFT\",\n          \"OUTER_RIGHT\"\n        ],\n        \"num_values\": 5\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      229\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 248,\n    \"displayName\": \"Emerald Ore\",\n    \"name\": \"emerald_ore\",\n    \"hardness\": 3,\n    \"minStateId\": 4730,\n    \"maxStateId\": 4730,\n    \"states\": [],\n    \"drops\": [\n      230\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"490\": true\n    }\n  },\n  {\n    \"id\": 249,\n    \"displayName\": \"Ender Chest\",\n    \"name\": \"ender_chest\",\n    \"hardness\": 22.5,\n    \"minStateId\": 4731,\n    \"maxStateId\": 4738,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      231\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 250,\n    \"displayName\": \"Tripwire Hook\",\n    \"name\": \"tripwire_hook\",\n    \"hardness\": 0,\n    \"minStateId\": 4739,\n    \"maxStateId\": 4754,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"attached\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      232\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 251,\n    \"displayName\": \"Tripwire\",\n    \"name\": \"tripwire\",\n    \"hardness\": 0,\n    \"minStateId\": 4755,\n    \"maxStateId\": 4882,\n    \"states\": [\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"attached\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"disarmed\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      499\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 252,\n    \"displayName\": \"Block of Emerald\",\n    \"name\": \"emerald_block\",\n    \"hardness\": 5,\n    \"minStateId\": 4883,\n    \"maxStateId\": 4883,\n    \"states\": [],\n    \"drops\": [\n      233\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"490\": true\n    }\n  },\n  {\n    \"id\": 253,\n    \"displayName\": \"Spruce Stairs\",\n    \"name\": \"spruce_stairs\",\n    \"hardness\": 2,\n    \"minStateId\": 4884,\n    \"maxStateId\": 4963,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"STRAIGHT\",\n          \"INNER_LEFT\",\n          \"INNER_RIGHT\",\n          \"OUTER_LEFT\",\n          \"OUTER_RIGHT\"\n        ],\n        \"num_values\": 5\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      234\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 254,\n    \"displayName\": \"Birch Stairs\",\n    \"name\": \"birch_stairs\",\n    \"hardness\": 2,\n    \"minStateId\": 4964,\n    \"maxStateId\": 5043,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"STRAIGHT\",\n          \"INNER_LEFT\",\n          \"INNER_RIGHT\",\n          \"OUTER_LEFT\",\n          \"OUTER_RIGHT\"\n        ],\n        \"num_values\": 5\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      235\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 255,\n    \"displayName\": \"Jungle Stairs\",\n    \"name\": \"jungle_stairs\",\n    \"hardness\": 2,\n    \"minStateId\": 5044,\n    \"maxStateId\": 5123,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"STRAIGHT\",\n          \"INNER_LEFT\",\n          \"INNER_RIGHT\",\n          \"OUTER_LEFT\",\n          \"OUTER_RIGHT\"\n        ],\n        \"num_values\": 5\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      236\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 256,\n    \"displayName\": \"Command Block\",\n    \"name\": \"command_block\",\n    \"hardness\": null,\n    \"minStateId\": 5124,\n    \"maxStateId\": 5135,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      },\n      {\n        \"name\": \"conditional\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      237\n    ],\n    \"diggable\": false,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 257,\n    \"displayName\": \"Beacon\",\n    \"name\": \"beacon\",\n    \"hardness\": 3,\n    \"minStateId\": 5136,\n    \"maxStateId\": 5136,\n    \"states\": [],\n    \"drops\": [\n      238\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 15,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 258,\n    \"displayName\": \"Cobblestone Wall\",\n    \"name\": \"cobblestone_wall\",\n    \"hardness\": 2,\n    \"minStateId\": 5137,\n    \"maxStateId\": 5200,\n    \"states\": [\n      {\n        \"name\": \"up\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      239\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 259,\n    \"displayName\": \"Mossy Cobblestone Wall\",\n    \"name\": \"mossy_cobblestone_wall\",\n    \"hardness\": 2,\n    \"minStateId\": 5201,\n    \"maxStateId\": 5264,\n    \"states\": [\n      {\n        \"name\": \"up\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      240\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 260,\n    \"displayName\": \"Flower Pot\",\n    \"name\": \"flower_pot\",\n    \"hardness\": 0,\n    \"minStateId\": 5265,\n    \"maxStateId\": 5265,\n    \"states\": [],\n    \"drops\": [\n      691\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 261,\n    \"displayName\": \"Potted Oak Sapling\",\n    \"name\": \"potted_oak_sapling\",\n    \"hardness\": 0,\n    \"minStateId\": 5266,\n    \"maxStateId\": 5266,\n    \"states\": [],\n    \"drops\": [\n      691,\n      19\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 262,\n    \"displayName\": \"Potted Spruce Sapling\",\n    \"name\": \"potted_spruce_sapling\",\n    \"hardness\": 0,\n    \"minStateId\": 5267,\n    \"maxStateId\": 5267,\n    \"states\": [],\n    \"drops\": [\n      691,\n      20\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 263,\n    \"displayName\": \"Potted Birch Sapling\",\n    \"name\": \"potted_birch_sapling\",\n    \"hardness\": 0,\n    \"minStateId\": 5268,\n    \"maxStateId\": 5268,\n    \"states\": [],\n    \"drops\": [\n      691,\n      21\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 264,\n    \"displayName\": \"Potted Jungle Sapling\",\n    \"name\": \"potted_jungle_sapling\",\n    \"hardness\": 0,\n    \"minStateId\": 5269,\n    \"maxStateId\": 5269,\n    \"states\": [],\n    \"drops\": [\n      691,\n      22\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 265,\n    \"displayName\": \"Potted Acacia Sapling\",\n    \"name\": \"potted_acacia_sapling\",\n    \"hardness\": 0,\n    \"minStateId\": 5270,\n    \"maxStateId\": 5270,\n    \"states\": [],\n    \"drops\": [\n      691,\n      23\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 266,\n    \"displayName\": \"Potted Dark Oak Sapling\",\n    \"name\": \"potted_dark_oak_sapling\",\n    \"hardness\": 0,\n    \"minStateId\": 5271,\n    \"maxStateId\": 5271,\n    \"states\": [],\n    \"drops\": [\n      691,\n      24\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 267,\n    \"displayName\": \"Potted Fern\",\n    \"name\": \"potted_fern\",\n    \"hardness\": 0,\n    \"minStateId\": 5272,\n    \"maxStateId\": 5272,\n    \"states\": [],\n    \"drops\": [\n      691,\n      77\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 268,\n    \"displayName\": \"Potted Dandelion\",\n    \"name\": \"potted_dandelion\",\n    \"hardness\": 0,\n    \"minStateId\": 5273,\n    \"maxStateId\": 5273,\n    \"states\": [],\n    \"drops\": [\n      691,\n      98\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 269,\n    \"displayName\": \"Potted Poppy\",\n    \"name\": \"potted_poppy\",\n    \"hardness\": 0,\n    \"minStateId\": 5274,\n    \"maxStateId\": 5274,\n    \"states\": [],\n    \"drops\": [\n      691,\n      99\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 270,\n    \"displayName\": \"Potted Blue Orchid\",\n    \"name\": \"potted_blue_orchid\",\n    \"hardness\": 0,\n    \"minStateId\": 5275,\n    \"maxStateId\": 5275,\n    \"states\": [],\n    \"drops\": [\n      691,\n      100\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 271,\n    \"displayName\": \"Potted Allium\",\n    \"name\": \"potted_allium\",\n    \"hardness\": 0,\n    \"minStateId\": 5276,\n    \"maxStateId\": 5276,\n    \"states\": [],\n    \"drops\": [\n      691,\n      101\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 272,\n    \"displayName\": \"Potted Azure Bluet\",\n    \"name\": \"potted_azure_bluet\",\n    \"hardness\": 0,\n    \"minStateId\": 5277,\n    \"maxStateId\": 5277,\n    \"states\": [],\n    \"drops\": [\n      691,\n      102\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 273,\n    \"displayName\": \"Potted Red Tulip\",\n    \"name\": \"potted_red_tulip\",\n    \"hardness\": 0,\n    \"minStateId\": 5278,\n    \"maxStateId\": 5278,\n    \"states\": [],\n    \"drops\": [\n      691,\n      103\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 274,\n    \"displayName\": \"Potted Orange Tulip\",\n    \"name\": \"potted_orange_tulip\",\n    \"hardness\": 0,\n    \"minStateId\": 5279,\n    \"maxStateId\": 5279,\n    \"states\": [],\n    \"drops\": [\n      691,\n      104\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 275,\n    \"displayName\": \"Potted White Tulip\",\n    \"name\": \"potted_white_tulip\",\n    \"hardness\": 0,\n    \"minStateId\": 5280,\n    \"maxStateId\": 5280,\n    \"states\": [],\n    \"drops\": [\n      691,\n      105\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 276,\n    \"displayName\": \"Potted Pink Tulip\",\n    \"name\": \"potted_pink_tulip\",\n    \"hardness\": 0,\n    \"minStateId\": 5281,\n    \"maxStateId\": 5281,\n    \"states\": [],\n    \"drops\": [\n      691,\n      106\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 277,\n    \"displayName\": \"Potted Oxeye Daisy\",\n    \"name\": \"potted_oxeye_daisy\",\n    \"hardness\": 0,\n    \"minStateId\": 5282,\n    \"maxStateId\": 5282,\n    \"states\": [],\n    \"drops\": [\n      691,\n      107\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 278,\n    \"displayName\": \"Potted Red Mushroom\",\n    \"name\": \"potted_red_mushroom\",\n    \"hardness\": 0,\n    \"minStateId\": 5283,\n    \"maxStateId\": 5283,\n    \"states\": [],\n    \"drops\": [\n      691,\n      109\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 279,\n    \"displayName\": \"Potted Brown Mushroom\",\n    \"name\": \"potted_brown_mushroom\",\n    \"hardness\": 0,\n    \"minStateId\": 5284,\n    \"maxStateId\": 5284,\n    \"states\": [],\n    \"drops\": [\n      691,\n      108\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 280,\n    \"displayName\": \"Potted Dead Bush\",\n    \"name\": \"potted_dead_bush\",\n    \"hardness\": 0,\n    \"minStateId\": 5285,\n    \"maxStateId\": 5285,\n    \"states\": [],\n    \"drops\": [\n      691,\n      78\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 281,\n    \"displayName\": \"Potted Cactus\",\n    \"name\": \"potted_cactus\",\n    \"hardness\": 0,\n    \"minStateId\": 5286,\n    \"maxStateId\": 5286,\n    \"states\": [],\n    \"drops\": [\n      691,\n      172\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 282,\n    \"displayName\": \"Carrots\",\n    \"name\": \"carrots\",\n    \"hardness\": 0,\n    \"minStateId\": 5287,\n    \"maxStateId\": 5294,\n    \"states\": [\n      {\n        \"name\": \"age\",\n        \"type\": \"int\",\n        \"num_values\": 8\n      }\n    ],\n    \"drops\": [\n      692\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 283,\n    \"displayName\": \"Potatoes\",\n    \"name\": \"potatoes\",\n    \"hardness\": 0,\n    \"minStateId\": 5295,\n    \"maxStateId\": 5302,\n    \"states\": [\n      {\n        \"name\": \"age\",\n        \"type\": \"int\",\n        \"num_values\": 8\n      }\n    ],\n    \"drops\": [\n      693\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 284,\n    \"displayName\": \"Oak Button\",\n    \"name\": \"oak_button\",\n    \"hardness\": 0.5,\n    \"minStateId\": 5303,\n    \"maxStateId\": 5326,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"face\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"FLOOR\",\n          \"WALL\",\n          \"CEILING\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      241\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 285,\n    \"displayName\": \"Spruce Button\",\n    \"name\": \"spruce_button\",\n    \"hardness\": 0.5,\n    \"minStateId\": 5327,\n    \"maxStateId\": 5350,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"face\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"FLOOR\",\n          \"WALL\",\n          \"CEILING\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      242\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 286,\n    \"displayName\": \"Birch Button\",\n    \"name\": \"birch_button\",\n    \"hardness\": 0.5,\n    \"minStateId\": 5351,\n    \"maxStateId\": 5374,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"face\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"FLOOR\",\n          \"WALL\",\n          \"CEILING\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      243\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 287,\n    \"displayName\": \"Jungle Button\",\n    \"name\": \"jungle_button\",\n    \"hardness\": 0.5,\n    \"minStateId\": 5375,\n    \"maxStateId\": 5398,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"face\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"FLOOR\",\n          \"WALL\",\n          \"CEILING\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      244\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 288,\n    \"displayName\": \"Acacia Button\",\n    \"name\": \"acacia_button\",\n    \"hardness\": 0.5,\n    \"minStateId\": 5399,\n    \"maxStateId\": 5422,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"face\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"FLOOR\",\n          \"WALL\",\n          \"CEILING\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      245\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 289,\n    \"displayName\": \"Dark Oak Button\",\n    \"name\": \"dark_oak_button\",\n    \"hardness\": 0.5,\n    \"minStateId\": 5423,\n    \"maxStateId\": 5446,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"face\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"FLOOR\",\n          \"WALL\",\n          \"CEILING\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      246\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 290,\n    \"displayName\": \"Skeleton Wall Skull\",\n    \"name\": \"skeleton_wall_skull\",\n    \"hardness\": 1,\n    \"minStateId\": 5447,\n    \"maxStateId\": 5450,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      698\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 291,\n    \"displayName\": \"Skeleton Skull\",\n    \"name\": \"skeleton_skull\",\n    \"hardness\": 1,\n    \"minStateId\": 5451,\n    \"maxStateId\": 5466,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      698\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 292,\n    \"displayName\": \"Wither Skeleton Wall Skull\",\n    \"name\": \"wither_skeleton_wall_skull\",\n    \"hardness\": 1,\n    \"minStateId\": 5467,\n    \"maxStateId\": 5470,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      699\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 293,\n    \"displayName\": \"Wither Skeleton Skull\",\n    \"name\": \"wither_skeleton_skull\",\n    \"hardness\": 1,\n    \"minStateId\": 5471,\n    \"maxStateId\": 5486,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      699\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 294,\n    \"displayName\": \"Zombie Wall Head\",\n    \"name\": \"zombie_wall_head\",\n    \"hardness\": 1,\n    \"minStateId\": 5487,\n    \"maxStateId\": 5490,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      701\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 295,\n    \"displayName\": \"Zombie Head\",\n    \"name\": \"zombie_head\",\n    \"hardness\": 1,\n    \"minStateId\": 5491,\n    \"maxStateId\": 5506,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      701\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 296,\n    \"displayName\": \"Player Wall Head\",\n    \"name\": \"player_wall_head\",\n    \"hardness\": 1,\n    \"minStateId\": 5507,\n    \"maxStateId\": 5510,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      700\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 297,\n    \"displayName\": \"Player Head\",\n    \"name\": \"player_head\",\n    \"hardness\": 1,\n    \"minStateId\": 5511,\n    \"maxStateId\": 5526,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      700\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 298,\n    \"displayName\": \"Creeper Wall Head\",\n    \"name\": \"creeper_wall_head\",\n    \"hardness\": 1,\n    \"minStateId\": 5527,\n    \"maxStateId\": 5530,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      702\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 299,\n    \"displayName\": \"Creeper Head\",\n    \"name\": \"creeper_head\",\n    \"hardness\": 1,\n    \"minStateId\": 5531,\n    \"maxStateId\": 5546,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      702\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 300,\n    \"displayName\": \"Dragon Wall Head\",\n    \"name\": \"dragon_wall_head\",\n    \"hardness\": 1,\n    \"minStateId\": 5547,\n    \"maxStateId\": 5550,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      703\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 301,\n    \"displayName\": \"Dragon Head\",\n    \"name\": \"dragon_head\",\n    \"hardness\": 1,\n    \"minStateId\": 5551,\n    \"maxStateId\": 5566,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      703\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 302,\n    \"displayName\": \"Anvil\",\n    \"name\": \"anvil\",\n    \"hardness\": 5,\n    \"minStateId\": 5567,\n    \"maxStateId\": 5570,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      247\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 303,\n    \"displayName\": \"Chipped Anvil\",\n    \"name\": \"chipped_anvil\",\n    \"hardness\": 5,\n    \"minStateId\": 5571,\n    \"maxStateId\": 5574,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      248\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 304,\n    \"displayName\": \"Damaged Anvil\",\n    \"name\": \"damaged_anvil\",\n    \"hardness\": 5,\n    \"minStateId\": 5575,\n    \"maxStateId\": 5578,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      249\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 305,\n    \"displayName\": \"Trapped Chest\",\n    \"name\": \"trapped_chest\",\n    \"hardness\": 2.5,\n    \"minStateId\": 5579,\n    \"maxStateId\": 5602,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"SINGLE\",\n          \"LEFT\",\n          \"RIGHT\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      250\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 306,\n    \"displayName\": \"Light Weighted Pressure Plate\",\n    \"name\": \"light_weighted_pressure_plate\",\n    \"hardness\": 0.5,\n    \"minStateId\": 5603,\n    \"maxStateId\": 5618,\n    \"states\": [\n      {\n        \"name\": \"power\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      251\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 307,\n    \"displayName\": \"Heavy Weighted Pressure Plate\",\n    \"name\": \"heavy_weighted_pressure_plate\",\n    \"hardness\": 0.5,\n    \"minStateId\": 5619,\n    \"maxStateId\": 5634,\n    \"states\": [\n      {\n        \"name\": \"power\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      252\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 308,\n    \"displayName\": \"Redstone Comparator\",\n    \"name\": \"comparator\",\n    \"hardness\": 0,\n    \"minStateId\": 5635,\n    \"maxStateId\": 5650,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"mode\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"COMPARE\",\n          \"SUBTRACT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      463\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 309,\n    \"displayName\": \"Daylight Detector\",\n    \"name\": \"daylight_detector\",\n    \"hardness\": 0.2,\n    \"minStateId\": 5651,\n    \"maxStateId\": 5682,\n    \"states\": [\n      {\n        \"name\": \"power\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      },\n      {\n        \"name\": \"inverted\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      253\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 310,\n    \"displayName\": \"Block of Redstone\",\n    \"name\": \"redstone_block\",\n    \"hardness\": 5,\n    \"minStateId\": 5683,\n    \"maxStateId\": 5683,\n    \"states\": [],\n    \"drops\": [\n      254\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 311,\n    \"displayName\": \"Nether Quartz Ore\",\n    \"name\": \"nether_quartz_ore\",\n    \"hardness\": 3,\n    \"minStateId\": 5684,\n    \"maxStateId\": 5684,\n    \"states\": [],\n    \"drops\": [\n      255\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 312,\n    \"displayName\": \"Hopper\",\n    \"name\": \"hopper\",\n    \"hardness\": 3,\n    \"minStateId\": 5685,\n    \"maxStateId\": 5694,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"DOWN\",\n          \"NORTH\",\n          \"SOUTH\",\n          \"EAST\",\n          \"WEST\"\n        ],\n        \"num_values\": 5\n      },\n      {\n        \"name\": \"enabled\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      256\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 313,\n    \"displayName\": \"Block of Quartz\",\n    \"name\": \"quartz_block\",\n    \"hardness\": 0.8,\n    \"minStateId\": 5695,\n    \"maxStateId\": 5695,\n    \"states\": [],\n    \"drops\": [\n      258\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 314,\n    \"displayName\": \"Chiseled Quartz Block\",\n    \"name\": \"chiseled_quartz_block\",\n    \"hardness\": 0.8,\n    \"minStateId\": 5696,\n    \"maxStateId\": 5696,\n    \"states\": [],\n    \"drops\": [\n      257\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 315,\n    \"displayName\": \"Quartz Pillar\",\n    \"name\": \"quartz_pillar\",\n    \"hardness\": 0.8,\n    \"minStateId\": 5697,\n    \"maxStateId\": 5699,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      259\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 316,\n    \"displayName\": \"Quartz Stairs\",\n    \"name\": \"quartz_stairs\",\n    \"hardness\": 0.8,\n    \"minStateId\": 5700,\n    \"maxStateId\": 5779,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"STRAIGHT\",\n          \"INNER_LEFT\",\n          \"INNER_RIGHT\",\n          \"OUTER_LEFT\",\n          \"OUTER_RIGHT\"\n        ],\n        \"num_values\": 5\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      260\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 317,\n    \"displayName\": \"Activator Rail\",\n    \"name\": \"activator_rail\",\n    \"hardness\": 0.7,\n    \"minStateId\": 5780,\n    \"maxStateId\": 5791,\n    \"states\": [\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"NORTH_SOUTH\",\n          \"EAST_WEST\",\n          \"ASCENDING_EAST\",\n          \"ASCENDING_WEST\",\n          \"ASCENDING_NORTH\",\n          \"ASCENDING_SOUTH\"\n        ],\n        \"num_values\": 6\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      261\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 318,\n    \"displayName\": \"Dropper\",\n    \"name\": \"dropper\",\n    \"hardness\": 3.5,\n    \"minStateId\": 5792,\n    \"maxStateId\": 5803,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      },\n      {\n        \"name\": \"triggered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      262\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 319,\n    \"displayName\": \"White Terracotta\",\n    \"name\": \"white_terracotta\",\n    \"hardness\": 1.25,\n    \"minStateId\": 5804,\n    \"maxStateId\": 5804,\n    \"states\": [],\n    \"drops\": [\n      263\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 320,\n    \"displayName\": \"Orange Terracotta\",\n    \"name\": \"orange_terracotta\",\n    \"hardness\": 1.25,\n    \"minStateId\": 5805,\n    \"maxStateId\": 5805,\n    \"states\": [],\n    \"drops\": [\n      264\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 321,\n    \"displayName\": \"Magenta Terracotta\",\n    \"name\": \"magenta_terracotta\",\n    \"hardness\": 1.25,\n    \"minStateId\": 5806,\n    \"maxStateId\": 5806,\n    \"states\": [],\n    \"drops\": [\n      265\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 322,\n    \"displayName\": \"Light Blue Terracotta\",\n    \"name\": \"light_blue_terracotta\",\n    \"hardness\": 1.25,\n    \"minStateId\": 5807,\n    \"maxStateId\": 5807,\n    \"states\": [],\n    \"drops\": [\n      266\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 323,\n    \"displayName\": \"Yellow Terracotta\",\n    \"name\": \"yellow_terracotta\",\n    \"hardness\": 1.25,\n    \"minStateId\": 5808,\n    \"maxStateId\": 5808,\n    \"states\": [],\n    \"drops\": [\n      267\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 324,\n    \"displayName\": \"Lime Terracotta\",\n    \"name\": \"lime_terracotta\",\n    \"hardness\": 1.25,\n    \"minStateId\": 5809,\n    \"maxStateId\": 5809,\n    \"states\": [],\n    \"drops\": [\n      268\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 325,\n    \"displayName\": \"Pink Terracotta\",\n    \"name\": \"pink_terracotta\",\n    \"hardness\": 1.25,\n    \"minStateId\": 5810,\n    \"maxStateId\": 5810,\n    \"states\": [],\n    \"drops\": [\n      269\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 326,\n    \"displayName\": \"Gray Terracotta\",\n    \"name\": \"gray_terracotta\",\n    \"hardness\": 1.25,\n    \"minStateId\": 5811,\n    \"maxStateId\": 5811,\n    \"states\": [],\n    \"drops\": [\n      270\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 327,\n    \"displayName\": \"Light Gray Terracotta\",\n    \"name\": \"light_gray_terracotta\",\n    \"hardness\": 1.25,\n    \"minStateId\": 5812,\n    \"maxStateId\": 5812,\n    \"states\": [],\n    \"drops\": [\n      271\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 328,\n    \"displayName\": \"Cyan Terracotta\",\n    \"name\": \"cyan_terracotta\",\n    \"hardness\": 1.25,\n    \"minStateId\": 5813,\n    \"maxStateId\": 5813,\n    \"states\": [],\n    \"drops\": [\n      272\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 329,\n    \"displayName\": \"Purple Terracotta\",\n    \"name\": \"purple_terracotta\",\n    \"hardness\": 1.25,\n    \"minStateId\": 5814,\n    \"maxStateId\": 5814,\n    \"states\": [],\n    \"drops\": [\n      273\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 330,\n    \"displayName\": \"Blue Terracotta\",\n    \"name\": \"blue_terracotta\",\n    \"hardness\": 1.25,\n    \"minStateId\": 5815,\n    \"maxStateId\": 5815,\n    \"states\": [],\n    \"drops\": [\n      274\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 331,\n    \"displayName\": \"Brown Terracotta\",\n    \"name\": \"brown_terracotta\",\n    \"hardness\": 1.25,\n    \"minStateId\": 5816,\n    \"maxStateId\": 5816,\n    \"states\": [],\n    \"drops\": [\n      275\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 332,\n    \"displayName\": \"Green Terracotta\",\n    \"name\": \"green_terracotta\",\n    \"hardness\": 1.25,\n    \"minStateId\": 5817,\n    \"maxStateId\": 5817,\n    \"states\": [],\n    \"drops\": [\n      276\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 333,\n    \"displayName\": \"Red Terracotta\",\n    \"name\": \"red_terracotta\",\n    \"hardness\": 1.25,\n    \"minStateId\": 5818,\n    \"maxStateId\": 5818,\n    \"states\": [],\n    \"drops\": [\n      277\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 334,\n    \"displayName\": \"Black Terracotta\",\n    \"name\": \"black_terracotta\",\n    \"hardness\": 1.25,\n    \"minStateId\": 5819,\n    \"maxStateId\": 5819,\n    \"states\": [],\n    \"drops\": [\n      278\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 335,\n    \"displayName\": \"White Stained Glass Pane\",\n    \"name\": \"white_stained_glass_pane\",\n    \"hardness\": 0.3,\n    \"minStateId\": 5820,\n    \"maxStateId\": 5851,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      327\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 336,\n    \"displayName\": \"Orange Stained Glass Pane\",\n    \"name\": \"orange_stained_glass_pane\",\n    \"hardness\": 0.3,\n    \"minStateId\": 5852,\n    \"maxStateId\": 5883,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      328\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 337,\n    \"displayName\": \"Magenta Stained Glass Pane\",\n    \"name\": \"magenta_stained_glass_pane\",\n    \"hardness\": 0.3,\n    \"minStateId\": 5884,\n    \"maxStateId\": 5915,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      329\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 338,\n    \"displayName\": \"Light Blue Stained Glass Pane\",\n    \"name\": \"light_blue_stained_glass_pane\",\n    \"hardness\": 0.3,\n    \"minStateId\": 5916,\n    \"maxStateId\": 5947,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      330\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 339,\n    \"displayName\": \"Yellow Stained Glass Pane\",\n    \"name\": \"yellow_stained_glass_pane\",\n    \"hardness\": 0.3,\n    \"minStateId\": 5948,\n    \"maxStateId\": 5979,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      331\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 340,\n    \"displayName\": \"Lime Stained Glass Pane\",\n    \"name\": \"lime_stained_glass_pane\",\n    \"hardness\": 0.3,\n    \"minStateId\": 5980,\n    \"maxStateId\": 6011,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      332\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 341,\n    \"displayName\": \"Pink Stained Glass Pane\",\n    \"name\": \"pink_stained_glass_pane\",\n    \"hardness\": 0.3,\n    \"minStateId\": 6012,\n    \"maxStateId\": 6043,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      333\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 342,\n    \"displayName\": \"Gray Stained Glass Pane\",\n    \"name\": \"gray_stained_glass_pane\",\n    \"hardness\": 0.3,\n    \"minStateId\": 6044,\n    \"maxStateId\": 6075,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      334\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 343,\n    \"displayName\": \"Light Gray Stained Glass Pane\",\n    \"name\": \"light_gray_stained_glass_pane\",\n    \"hardness\": 0.3,\n    \"minStateId\": 6076,\n    \"maxStateId\": 6107,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      335\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 344,\n    \"displayName\": \"Cyan Stained Glass Pane\",\n    \"name\": \"cyan_stained_glass_pane\",\n    \"hardness\": 0.3,\n    \"minStateId\": 6108,\n    \"maxStateId\": 6139,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      336\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 345,\n    \"displayName\": \"Purple Stained Glass Pane\",\n    \"name\": \"purple_stained_glass_pane\",\n    \"hardness\": 0.3,\n    \"minStateId\": 6140,\n    \"maxStateId\": 6171,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      337\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 346,\n    \"displayName\": \"Blue Stained Glass Pane\",\n    \"name\": \"blue_stained_glass_pane\",\n    \"hardness\": 0.3,\n    \"minStateId\": 6172,\n    \"maxStateId\": 6203,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      338\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 347,\n    \"displayName\": \"Brown Stained Glass Pane\",\n    \"name\": \"brown_stained_glass_pane\",\n    \"hardness\": 0.3,\n    \"minStateId\": 6204,\n    \"maxStateId\": 6235,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      339\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 348,\n    \"displayName\": \"Green Stained Glass Pane\",\n    \"name\": \"green_stained_glass_pane\",\n    \"hardness\": 0.3,\n    \"minStateId\": 6236,\n    \"maxStateId\": 6267,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      340\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 349,\n    \"displayName\": \"Red Stained Glass Pane\",\n    \"name\": \"red_stained_glass_pane\",\n    \"hardness\": 0.3,\n    \"minStateId\": 6268,\n    \"maxStateId\": 6299,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      341\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 350,\n    \"displayName\": \"Black Stained Glass Pane\",\n    \"name\": \"black_stained_glass_pane\",\n    \"hardness\": 0.3,\n    \"minStateId\": 6300,\n    \"maxStateId\": 6331,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      342\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 351,\n    \"displayName\": \"Acacia Stairs\",\n    \"name\": \"acacia_stairs\",\n    \"hardness\": 2,\n    \"minStateId\": 6332,\n    \"maxStateId\": 6411,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"STRAIGHT\",\n          \"INNER_LEFT\",\n          \"INNER_RIGHT\",\n          \"OUTER_LEFT\",\n          \"OUTER_RIGHT\"\n        ],\n        \"num_values\": 5\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      301\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 352,\n    \"displayName\": \"Dark Oak Stairs\",\n    \"name\": \"dark_oak_stairs\",\n    \"hardness\": 2,\n    \"minStateId\": 6412,\n    \"maxStateId\": 6491,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"STRAIGHT\",\n          \"INNER_LEFT\",\n          \"INNER_RIGHT\",\n          \"OUTER_LEFT\",\n          \"OUTER_RIGHT\"\n        ],\n        \"num_values\": 5\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      302\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 353,\n    \"displayName\": \"Slime Block\",\n    \"name\": \"slime_block\",\n    \"hardness\": 0,\n    \"minStateId\": 6492,\n    \"maxStateId\": 6492,\n    \"states\": [],\n    \"drops\": [\n      303\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 354,\n    \"displayName\": \"Barrier\",\n    \"name\": \"barrier\",\n    \"hardness\": null,\n    \"minStateId\": 6493,\n    \"maxStateId\": 6493,\n    \"states\": [],\n    \"drops\": [\n      279\n    ],\n    \"diggable\": false,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 355,\n    \"displayName\": \"Iron Trapdoor\",\n    \"name\": \"iron_trapdoor\",\n    \"hardness\": 5,\n    \"minStateId\": 6494,\n    \"maxStateId\": 6557,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      280\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 356,\n    \"displayName\": \"Prismarine\",\n    \"name\": \"prismarine\",\n    \"hardness\": 1.5,\n    \"minStateId\": 6558,\n    \"maxStateId\": 6558,\n    \"states\": [],\n    \"drops\": [\n      343\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 357,\n    \"displayName\": \"Prismarine Bricks\",\n    \"name\": \"prismarine_bricks\",\n    \"hardness\": 1.5,\n    \"minStateId\": 6559,\n    \"maxStateId\": 6559,\n    \"states\": [],\n    \"drops\": [\n      344\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 358,\n    \"displayName\": \"Dark Prismarine\",\n    \"name\": \"dark_prismarine\",\n    \"hardness\": 1.5,\n    \"minStateId\": 6560,\n    \"maxStateId\": 6560,\n    \"states\": [],\n    \"drops\": [\n      345\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 359,\n    \"displayName\": \"Prismarine Stairs\",\n    \"name\": \"prismarine_stairs\",\n    \"hardness\": 1.5,\n    \"minStateId\": 6561,\n    \"maxStateId\": 6640,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"STRAIGHT\",\n          \"INNER_LEFT\",\n          \"INNER_RIGHT\",\n          \"OUTER_LEFT\",\n          \"OUTER_RIGHT\"\n        ],\n        \"num_values\": 5\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      346\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 360,\n    \"displayName\": \"Prismarine Brick Stairs\",\n    \"name\": \"prismarine_brick_stairs\",\n    \"hardness\": 1.5,\n    \"minStateId\": 6641,\n    \"maxStateId\": 6720,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"STRAIGHT\",\n          \"INNER_LEFT\",\n          \"INNER_RIGHT\",\n          \"OUTER_LEFT\",\n          \"OUTER_RIGHT\"\n        ],\n        \"num_values\": 5\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      347\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 361,\n    \"displayName\": \"Dark Prismarine Stairs\",\n    \"name\": \"dark_prismarine_stairs\",\n    \"hardness\": 1.5,\n    \"minStateId\": 6721,\n    \"maxStateId\": 6800,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"STRAIGHT\",\n          \"INNER_LEFT\",\n          \"INNER_RIGHT\",\n          \"OUTER_LEFT\",\n          \"OUTER_RIGHT\"\n        ],\n        \"num_values\": 5\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      348\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 362,\n    \"displayName\": \"Prismarine Slab\",\n    \"name\": \"prismarine_slab\",\n    \"hardness\": 1.5,\n    \"minStateId\": 6801,\n    \"maxStateId\": 6806,\n    \"states\": [\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\",\n          \"DOUBLE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      128\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 363,\n    \"displayName\": \"Prismarine Brick Slab\",\n    \"name\": \"prismarine_brick_slab\",\n    \"hardness\": 1.5,\n    \"minStateId\": 6807,\n    \"maxStateId\": 6812,\n    \"states\": [\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\",\n          \"DOUBLE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      129\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 364,\n    \"displayName\": \"Dark Prismarine Slab\",\n    \"name\": \"dark_prismarine_slab\",\n    \"hardness\": 1.5,\n    \"minStateId\": 6813,\n    \"maxStateId\": 6818,\n    \"states\": [\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\",\n          \"DOUBLE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      130\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 365,\n    \"displayName\": \"Sea Lantern\",\n    \"name\": \"sea_lantern\",\n    \"hardness\": 0.3,\n    \"minStateId\": 6819,\n    \"maxStateId\": 6819,\n    \"states\": [],\n    \"drops\": [\n      349\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 366,\n    \"displayName\": \"Hay Bale\",\n    \"name\": \"hay_block\",\n    \"hardness\": 0.5,\n    \"minStateId\": 6820,\n    \"maxStateId\": 6822,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      281\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 367,\n    \"displayName\": \"White Carpet\",\n    \"name\": \"white_carpet\",\n    \"hardness\": 0.1,\n    \"minStateId\": 6823,\n    \"maxStateId\": 6823,\n    \"states\": [],\n    \"drops\": [\n      282\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 368,\n    \"displayName\": \"Orange Carpet\",\n    \"name\": \"orange_carpet\",\n    \"hardness\": 0.1,\n    \"minStateId\": 6824,\n    \"maxStateId\": 6824,\n    \"states\": [],\n    \"drops\": [\n      283\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 369,\n    \"displayName\": \"Magenta Carpet\",\n    \"name\": \"magenta_carpet\",\n    \"hardness\": 0.1,\n    \"minStateId\": 6825,\n    \"maxStateId\": 6825,\n    \"states\": [],\n    \"drops\": [\n      284\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 370,\n    \"displayName\": \"Light Blue Carpet\",\n    \"name\": \"light_blue_carpet\",\n    \"hardness\": 0.1,\n    \"minStateId\": 6826,\n    \"maxStateId\": 6826,\n    \"states\": [],\n    \"drops\": [\n      285\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 371,\n    \"displayName\": \"Yellow Carpet\",\n    \"name\": \"yellow_carpet\",\n    \"hardness\": 0.1,\n    \"minStateId\": 6827,\n    \"maxStateId\": 6827,\n    \"states\": [],\n    \"drops\": [\n      286\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 372,\n    \"displayName\": \"Lime Carpet\",\n    \"name\": \"lime_carpet\",\n    \"hardness\": 0.1,\n    \"minStateId\": 6828,\n    \"maxStateId\": 6828,\n    \"states\": [],\n    \"drops\": [\n      287\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 373,\n    \"displayName\": \"Pink Carpet\",\n    \"name\": \"pink_carpet\",\n    \"hardness\": 0.1,\n    \"minStateId\": 6829,\n    \"maxStateId\": 6829,\n    \"states\": [],\n    \"drops\": [\n      288\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 374,\n    \"displayName\": \"Gray Carpet\",\n    \"name\": \"gray_carpet\",\n    \"hardness\": 0.1,\n    \"minStateId\": 6830,\n    \"maxStateId\": 6830,\n    \"states\": [],\n    \"drops\": [\n      289\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 375,\n    \"displayName\": \"Light Gray Carpet\",\n    \"name\": \"light_gray_carpet\",\n    \"hardness\": 0.1,\n    \"minStateId\": 6831,\n    \"maxStateId\": 6831,\n    \"states\": [],\n    \"drops\": [\n      290\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 376,\n    \"displayName\": \"Cyan Carpet\",\n    \"name\": \"cyan_carpet\",\n    \"hardness\": 0.1,\n    \"minStateId\": 6832,\n    \"maxStateId\": 6832,\n    \"states\": [],\n    \"drops\": [\n      291\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 377,\n    \"displayName\": \"Purple Carpet\",\n    \"name\": \"purple_carpet\",\n    \"hardness\": 0.1,\n    \"minStateId\": 6833,\n    \"maxStateId\": 6833,\n    \"states\": [],\n    \"drops\": [\n      292\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 378,\n    \"displayName\": \"Blue Carpet\",\n    \"name\": \"blue_carpet\",\n    \"hardness\": 0.1,\n    \"minStateId\": 6834,\n    \"maxStateId\": 6834,\n    \"states\": [],\n    \"drops\": [\n      293\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 379,\n    \"displayName\": \"Brown Carpet\",\n    \"name\": \"brown_carpet\",\n    \"hardness\": 0.1,\n    \"minStateId\": 6835,\n    \"maxStateId\": 6835,\n    \"states\": [],\n    \"drops\": [\n      294\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 380,\n    \"displayName\": \"Green Carpet\",\n    \"name\": \"green_carpet\",\n    \"hardness\": 0.1,\n    \"minStateId\": 6836,\n    \"maxStateId\": 6836,\n    \"states\": [],\n    \"drops\": [\n      295\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 381,\n    \"displayName\": \"Red Carpet\",\n    \"name\": \"red_carpet\",\n    \"hardness\": 0.1,\n    \"minStateId\": 6837,\n    \"maxStateId\": 6837,\n    \"states\": [],\n    \"drops\": [\n      296\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 382,\n    \"displayName\": \"Black Carpet\",\n    \"name\": \"black_carpet\",\n    \"hardness\": 0.1,\n    \"minStateId\": 6838,\n    \"maxStateId\": 6838,\n    \"states\": [],\n    \"drops\": [\n      297\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 383,\n    \"displayName\": \"Terracotta\",\n    \"name\": \"terracotta\",\n    \"hardness\": 1.25,\n    \"minStateId\": 6839,\n    \"maxStateId\": 6839,\n    \"states\": [],\n    \"drops\": [\n      298\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 384,\n    \"displayName\": \"Block of Coal\",\n    \"name\": \"coal_block\",\n    \"hardness\": 5,\n    \"minStateId\": 6840,\n    \"maxStateId\": 6840,\n    \"states\": [],\n    \"drops\": [\n      299\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 385,\n    \"displayName\": \"Packed Ice\",\n    \"name\": \"packed_ice\",\n    \"hardness\": 0.5,\n    \"minStateId\": 6841,\n    \"maxStateId\": 6841,\n    \"states\": [],\n    \"drops\": [\n      300\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 386,\n    \"displayName\": \"Sunflower\",\n    \"name\": \"sunflower\",\n    \"hardness\": 0,\n    \"minStateId\": 6842,\n    \"maxStateId\": 6843,\n    \"states\": [\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"UPPER\",\n          \"LOWER\"\n        ],\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      305\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 387,\n    \"displayName\": \"Lilac\",\n    \"name\": \"lilac\",\n    \"hardness\": 0,\n    \"minStateId\": 6844,\n    \"maxStateId\": 6845,\n    \"states\": [\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"UPPER\",\n          \"LOWER\"\n        ],\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      306\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 388,\n    \"displayName\": \"Rose Bush\",\n    \"name\": \"rose_bush\",\n    \"hardness\": 0,\n    \"minStateId\": 6846,\n    \"maxStateId\": 6847,\n    \"states\": [\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"UPPER\",\n          \"LOWER\"\n        ],\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      307\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 389,\n    \"displayName\": \"Peony\",\n    \"name\": \"peony\",\n    \"hardness\": 0,\n    \"minStateId\": 6848,\n    \"maxStateId\": 6849,\n    \"states\": [\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"UPPER\",\n          \"LOWER\"\n        ],\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      308\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 390,\n    \"displayName\": \"Tall Grass\",\n    \"name\": \"tall_grass\",\n    \"hardness\": 0,\n    \"minStateId\": 6850,\n    \"maxStateId\": 6851,\n    \"states\": [\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"UPPER\",\n          \"LOWER\"\n        ],\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      309\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 391,\n    \"displayName\": \"Large Fern\",\n    \"name\": \"large_fern\",\n    \"hardness\": 0,\n    \"minStateId\": 6852,\n    \"maxStateId\": 6853,\n    \"states\": [\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"UPPER\",\n          \"LOWER\"\n        ],\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      310\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 392,\n    \"displayName\": \"White Banner\",\n    \"name\": \"white_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 6854,\n    \"maxStateId\": 6869,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      730\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 393,\n    \"displayName\": \"Orange Banner\",\n    \"name\": \"orange_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 6870,\n    \"maxStateId\": 6885,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      731\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 394,\n    \"displayName\": \"Magenta Banner\",\n    \"name\": \"magenta_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 6886,\n    \"maxStateId\": 6901,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      732\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 395,\n    \"displayName\": \"Light Blue Banner\",\n    \"name\": \"light_blue_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 6902,\n    \"maxStateId\": 6917,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      733\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 396,\n    \"displayName\": \"Yellow Banner\",\n    \"name\": \"yellow_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 6918,\n    \"maxStateId\": 6933,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      734\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 397,\n    \"displayName\": \"Lime Banner\",\n    \"name\": \"lime_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 6934,\n    \"maxStateId\": 6949,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      735\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 398,\n    \"displayName\": \"Pink Banner\",\n    \"name\": \"pink_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 6950,\n    \"maxStateId\": 6965,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      736\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 399,\n    \"displayName\": \"Gray Banner\",\n    \"name\": \"gray_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 6966,\n    \"maxStateId\": 6981,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      737\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 400,\n    \"displayName\": \"Light Gray Banner\",\n    \"name\": \"light_gray_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 6982,\n    \"maxStateId\": 6997,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      738\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 401,\n    \"displayName\": \"Cyan Banner\",\n    \"name\": \"cyan_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 6998,\n    \"maxStateId\": 7013,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      739\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 402,\n    \"displayName\": \"Purple Banner\",\n    \"name\": \"purple_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7014,\n    \"maxStateId\": 7029,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      740\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 403,\n    \"displayName\": \"Blue Banner\",\n    \"name\": \"blue_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7030,\n    \"maxStateId\": 7045,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      741\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 404,\n    \"displayName\": \"Brown Banner\",\n    \"name\": \"brown_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7046,\n    \"maxStateId\": 7061,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      742\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 405,\n    \"displayName\": \"Green Banner\",\n    \"name\": \"green_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7062,\n    \"maxStateId\": 7077,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      743\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 406,\n    \"displayName\": \"Red Banner\",\n    \"name\": \"red_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7078,\n    \"maxStateId\": 7093,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      744\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 407,\n    \"displayName\": \"Black Banner\",\n    \"name\": \"black_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7094,\n    \"maxStateId\": 7109,\n    \"states\": [\n      {\n        \"name\": \"rotation\",\n        \"type\": \"int\",\n        \"num_values\": 16\n      }\n    ],\n    \"drops\": [\n      745\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 408,\n    \"displayName\": \"White wall banner\",\n    \"name\": \"white_wall_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7110,\n    \"maxStateId\": 7113,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      730\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 409,\n    \"displayName\": \"Orange wall banner\",\n    \"name\": \"orange_wall_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7114,\n    \"maxStateId\": 7117,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      731\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 410,\n    \"displayName\": \"Magenta wall banner\",\n    \"name\": \"magenta_wall_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7118,\n    \"maxStateId\": 7121,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      732\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 411,\n    \"displayName\": \"Light blue wall banner\",\n    \"name\": \"light_blue_wall_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7122,\n    \"maxStateId\": 7125,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      733\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 412,\n    \"displayName\": \"Yellow wall banner\",\n    \"name\": \"yellow_wall_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7126,\n    \"maxStateId\": 7129,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      734\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 413,\n    \"displayName\": \"Lime wall banner\",\n    \"name\": \"lime_wall_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7130,\n    \"maxStateId\": 7133,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      735\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 414,\n    \"displayName\": \"Pink wall banner\",\n    \"name\": \"pink_wall_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7134,\n    \"maxStateId\": 7137,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      736\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 415,\n    \"displayName\": \"Gray wall banner\",\n    \"name\": \"gray_wall_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7138,\n    \"maxStateId\": 7141,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      737\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 416,\n    \"displayName\": \"Light gray wall banner\",\n    \"name\": \"light_gray_wall_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7142,\n    \"maxStateId\": 7145,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      738\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 417,\n    \"displayName\": \"Cyan wall banner\",\n    \"name\": \"cyan_wall_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7146,\n    \"maxStateId\": 7149,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      739\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 418,\n    \"displayName\": \"Purple wall banner\",\n    \"name\": \"purple_wall_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7150,\n    \"maxStateId\": 7153,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      740\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 419,\n    \"displayName\": \"Blue wall banner\",\n    \"name\": \"blue_wall_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7154,\n    \"maxStateId\": 7157,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      741\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 420,\n    \"displayName\": \"Brown wall banner\",\n    \"name\": \"brown_wall_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7158,\n    \"maxStateId\": 7161,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      742\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 421,\n    \"displayName\": \"Green wall banner\",\n    \"name\": \"green_wall_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7162,\n    \"maxStateId\": 7165,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      743\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 422,\n    \"displayName\": \"Red wall banner\",\n    \"name\": \"red_wall_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7166,\n    \"maxStateId\": 7169,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      744\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 423,\n    \"displayName\": \"Black wall banner\",\n    \"name\": \"black_wall_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 7170,\n    \"maxStateId\": 7173,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      745\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 424,\n    \"displayName\": \"Red Sandstone\",\n    \"name\": \"red_sandstone\",\n    \"hardness\": 0.8,\n    \"minStateId\": 7174,\n    \"maxStateId\": 7174,\n    \"states\": [],\n    \"drops\": [\n      350\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 425,\n    \"displayName\": \"Chiseled Red Sandstone\",\n    \"name\": \"chiseled_red_sandstone\",\n    \"hardness\": 0.8,\n    \"minStateId\": 7175,\n    \"maxStateId\": 7175,\n    \"states\": [],\n    \"drops\": [\n      351\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 426,\n    \"displayName\": \"Cut Red Sandstone\",\n    \"name\": \"cut_red_sandstone\",\n    \"hardness\": 0.8,\n    \"minStateId\": 7176,\n    \"maxStateId\": 7176,\n    \"states\": [],\n    \"drops\": [\n      352\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 427,\n    \"displayName\": \"Red Sandstone Stairs\",\n    \"name\": \"red_sandstone_stairs\",\n    \"hardness\": 0.8,\n    \"minStateId\": 7177,\n    \"maxStateId\": 7256,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"STRAIGHT\",\n          \"INNER_LEFT\",\n          \"INNER_RIGHT\",\n          \"OUTER_LEFT\",\n          \"OUTER_RIGHT\"\n        ],\n        \"num_values\": 5\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      353\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 428,\n    \"displayName\": \"Oak Slab\",\n    \"name\": \"oak_slab\",\n    \"hardness\": 2,\n    \"minStateId\": 7257,\n    \"maxStateId\": 7262,\n    \"states\": [\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\",\n          \"DOUBLE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      112\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 429,\n    \"displayName\": \"Spruce Slab\",\n    \"name\": \"spruce_slab\",\n    \"hardness\": 2,\n    \"minStateId\": 7263,\n    \"maxStateId\": 7268,\n    \"states\": [\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\",\n          \"DOUBLE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      113\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 430,\n    \"displayName\": \"Birch Slab\",\n    \"name\": \"birch_slab\",\n    \"hardness\": 2,\n    \"minStateId\": 7269,\n    \"maxStateId\": 7274,\n    \"states\": [\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\",\n          \"DOUBLE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      114\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 431,\n    \"displayName\": \"Jungle Slab\",\n    \"name\": \"jungle_slab\",\n    \"hardness\": 2,\n    \"minStateId\": 7275,\n    \"maxStateId\": 7280,\n    \"states\": [\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\",\n          \"DOUBLE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      115\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 432,\n    \"displayName\": \"Acacia Slab\",\n    \"name\": \"acacia_slab\",\n    \"hardness\": 2,\n    \"minStateId\": 7281,\n    \"maxStateId\": 7286,\n    \"states\": [\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\",\n          \"DOUBLE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      116\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 433,\n    \"displayName\": \"Dark Oak Slab\",\n    \"name\": \"dark_oak_slab\",\n    \"hardness\": 2,\n    \"minStateId\": 7287,\n    \"maxStateId\": 7292,\n    \"states\": [\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\",\n          \"DOUBLE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      117\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 434,\n    \"displayName\": \"Stone Slab\",\n    \"name\": \"stone_slab\",\n    \"hardness\": 2,\n    \"minStateId\": 7293,\n    \"maxStateId\": 7298,\n    \"states\": [\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\",\n          \"DOUBLE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      118\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 435,\n    \"displayName\": \"Sandstone Slab\",\n    \"name\": \"sandstone_slab\",\n    \"hardness\": 2,\n    \"minStateId\": 7299,\n    \"maxStateId\": 7304,\n    \"states\": [\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\",\n          \"DOUBLE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      119\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 436,\n    \"displayName\": \"Petrified Oak Slab\",\n    \"name\": \"petrified_oak_slab\",\n    \"hardness\": 2,\n    \"minStateId\": 7305,\n    \"maxStateId\": 7310,\n    \"states\": [\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\",\n          \"DOUBLE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      120\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 437,\n    \"displayName\": \"Cobblestone Slab\",\n    \"name\": \"cobblestone_slab\",\n    \"hardness\": 2,\n    \"minStateId\": 7311,\n    \"maxStateId\": 7316,\n    \"states\": [\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\",\n          \"DOUBLE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      121\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 438,\n    \"displayName\": \"Brick Slab\",\n    \"name\": \"brick_slab\",\n    \"hardness\": 2,\n    \"minStateId\": 7317,\n    \"maxStateId\": 7322,\n    \"states\": [\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\",\n          \"DOUBLE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      122\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 439,\n    \"displayName\": \"Stone Brick Slab\",\n    \"name\": \"stone_brick_slab\",\n    \"hardness\": 2,\n    \"minStateId\": 7323,\n    \"maxStateId\": 7328,\n    \"states\": [\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\",\n          \"DOUBLE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      123\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 440,\n    \"displayName\": \"Nether Brick Slab\",\n    \"name\": \"nether_brick_slab\",\n    \"hardness\": 2,\n    \"minStateId\": 7329,\n    \"maxStateId\": 7334,\n    \"states\": [\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\",\n          \"DOUBLE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      124\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 441,\n    \"displayName\": \"Quartz Slab\",\n    \"name\": \"quartz_slab\",\n    \"hardness\": 2,\n    \"minStateId\": 7335,\n    \"maxStateId\": 7340,\n    \"states\": [\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\",\n          \"DOUBLE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      125\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 442,\n    \"displayName\": \"Red Sandstone Slab\",\n    \"name\": \"red_sandstone_slab\",\n    \"hardness\": 2,\n    \"minStateId\": 7341,\n    \"maxStateId\": 7346,\n    \"states\": [\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\",\n          \"DOUBLE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      126\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 443,\n    \"displayName\": \"Purpur Slab\",\n    \"name\": \"purpur_slab\",\n    \"hardness\": 2,\n    \"minStateId\": 7347,\n    \"maxStateId\": 7352,\n    \"states\": [\n      {\n        \"name\": \"type\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\",\n          \"DOUBLE\"\n        ],\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      127\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 444,\n    \"displayName\": \"Smooth Stone\",\n    \"name\": \"smooth_stone\",\n    \"hardness\": 2,\n    \"minStateId\": 7353,\n    \"maxStateId\": 7353,\n    \"states\": [],\n    \"drops\": [\n      134\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 445,\n    \"displayName\": \"Smooth Sandstone\",\n    \"name\": \"smooth_sandstone\",\n    \"hardness\": 2,\n    \"minStateId\": 7354,\n    \"maxStateId\": 7354,\n    \"states\": [],\n    \"drops\": [\n      133\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 446,\n    \"displayName\": \"Smooth Quartz\",\n    \"name\": \"smooth_quartz\",\n    \"hardness\": 2,\n    \"minStateId\": 7355,\n    \"maxStateId\": 7355,\n    \"states\": [],\n    \"drops\": [\n      131\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 447,\n    \"displayName\": \"Smooth Red Sandstone\",\n    \"name\": \"smooth_red_sandstone\",\n    \"hardness\": 2,\n    \"minStateId\": 7356,\n    \"maxStateId\": 7356,\n    \"states\": [],\n    \"drops\": [\n      132\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 448,\n    \"displayName\": \"Spruce Fence Gate\",\n    \"name\": \"spruce_fence_gate\",\n    \"hardness\": 2,\n    \"minStateId\": 7357,\n    \"maxStateId\": 7388,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"in_wall\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      211\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 449,\n    \"displayName\": \"Birch Fence Gate\",\n    \"name\": \"birch_fence_gate\",\n    \"hardness\": 2,\n    \"minStateId\": 7389,\n    \"maxStateId\": 7420,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"in_wall\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      212\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 450,\n    \"displayName\": \"Jungle Fence Gate\",\n    \"name\": \"jungle_fence_gate\",\n    \"hardness\": 2,\n    \"minStateId\": 7421,\n    \"maxStateId\": 7452,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"in_wall\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      213\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 451,\n    \"displayName\": \"Acacia Fence Gate\",\n    \"name\": \"acacia_fence_gate\",\n    \"hardness\": 2,\n    \"minStateId\": 7453,\n    \"maxStateId\": 7484,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"in_wall\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      214\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 452,\n    \"displayName\": \"Dark Oak Fence Gate\",\n    \"name\": \"dark_oak_fence_gate\",\n    \"hardness\": 2,\n    \"minStateId\": 7485,\n    \"maxStateId\": 7516,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"in_wall\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      215\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 453,\n    \"displayName\": \"Spruce Fence\",\n    \"name\": \"spruce_fence\",\n    \"hardness\": 2,\n    \"minStateId\": 7517,\n    \"maxStateId\": 7548,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      176\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 454,\n    \"displayName\": \"Birch Fence\",\n    \"name\": \"birch_fence\",\n    \"hardness\": 2,\n    \"minStateId\": 7549,\n    \"maxStateId\": 7580,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      177\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 455,\n    \"displayName\": \"Jungle Fence\",\n    \"name\": \"jungle_fence\",\n    \"hardness\": 2,\n    \"minStateId\": 7581,\n    \"maxStateId\": 7612,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      178\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 456,\n    \"displayName\": \"Acacia Fence\",\n    \"name\": \"acacia_fence\",\n    \"hardness\": 2,\n    \"minStateId\": 7613,\n    \"maxStateId\": 7644,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      179\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 457,\n    \"displayName\": \"Dark Oak Fence\",\n    \"name\": \"dark_oak_fence\",\n    \"hardness\": 2,\n    \"minStateId\": 7645,\n    \"maxStateId\": 7676,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      180\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 458,\n    \"displayName\": \"Spruce Door\",\n    \"name\": \"spruce_door\",\n    \"hardness\": 3,\n    \"minStateId\": 7677,\n    \"maxStateId\": 7740,\n    \"states\": [\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"UPPER\",\n          \"LOWER\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"hinge\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"LEFT\",\n          \"RIGHT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      457\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 459,\n    \"displayName\": \"Birch Door\",\n    \"name\": \"birch_door\",\n    \"hardness\": 3,\n    \"minStateId\": 7741,\n    \"maxStateId\": 7804,\n    \"states\": [\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"UPPER\",\n          \"LOWER\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"hinge\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"LEFT\",\n          \"RIGHT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      458\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 460,\n    \"displayName\": \"Jungle Door\",\n    \"name\": \"jungle_door\",\n    \"hardness\": 3,\n    \"minStateId\": 7805,\n    \"maxStateId\": 7868,\n    \"states\": [\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"UPPER\",\n          \"LOWER\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"hinge\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"LEFT\",\n          \"RIGHT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      459\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 461,\n    \"displayName\": \"Acacia Door\",\n    \"name\": \"acacia_door\",\n    \"hardness\": 3,\n    \"minStateId\": 7869,\n    \"maxStateId\": 7932,\n    \"states\": [\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"UPPER\",\n          \"LOWER\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"hinge\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"LEFT\",\n          \"RIGHT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      460\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 462,\n    \"displayName\": \"Dark Oak Door\",\n    \"name\": \"dark_oak_door\",\n    \"hardness\": 3,\n    \"minStateId\": 7933,\n    \"maxStateId\": 7996,\n    \"states\": [\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"UPPER\",\n          \"LOWER\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"open\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"hinge\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"LEFT\",\n          \"RIGHT\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      461\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 463,\n    \"displayName\": \"End Rod\",\n    \"name\": \"end_rod\",\n    \"hardness\": 0,\n    \"minStateId\": 7997,\n    \"maxStateId\": 8002,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      }\n    ],\n    \"drops\": [\n      141\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 14,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 464,\n    \"displayName\": \"Chorus Plant\",\n    \"name\": \"chorus_plant\",\n    \"hardness\": 0.4,\n    \"minStateId\": 8003,\n    \"maxStateId\": 8066,\n    \"states\": [\n      {\n        \"name\": \"north\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"east\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"south\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"west\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"up\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"down\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      142\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 465,\n    \"displayName\": \"Chorus Flower\",\n    \"name\": \"chorus_flower\",\n    \"hardness\": 0.4,\n    \"minStateId\": 8067,\n    \"maxStateId\": 8072,\n    \"states\": [\n      {\n        \"name\": \"age\",\n        \"type\": \"int\",\n        \"num_values\": 6\n      }\n    ],\n    \"drops\": [\n      143\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 466,\n    \"displayName\": \"Purpur Block\",\n    \"name\": \"purpur_block\",\n    \"hardness\": 1.5,\n    \"minStateId\": 8073,\n    \"maxStateId\": 8073,\n    \"states\": [],\n    \"drops\": [\n      144\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 467,\n    \"displayName\": \"Purpur Pillar\",\n    \"name\": \"purpur_pillar\",\n    \"hardness\": 1.5,\n    \"minStateId\": 8074,\n    \"maxStateId\": 8076,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      145\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 468,\n    \"displayName\": \"Purpur Stairs\",\n    \"name\": \"purpur_stairs\",\n    \"hardness\": 1.5,\n    \"minStateId\": 8077,\n    \"maxStateId\": 8156,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"half\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"TOP\",\n          \"BOTTOM\"\n        ],\n        \"num_values\": 2\n      },\n      {\n        \"name\": \"shape\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"STRAIGHT\",\n          \"INNER_LEFT\",\n          \"INNER_RIGHT\",\n          \"OUTER_LEFT\",\n          \"OUTER_RIGHT\"\n        ],\n        \"num_values\": 5\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      146\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 469,\n    \"displayName\": \"End Stone Bricks\",\n    \"name\": \"end_stone_bricks\",\n    \"hardness\": 0.8,\n    \"minStateId\": 8157,\n    \"maxStateId\": 8157,\n    \"states\": [],\n    \"drops\": [\n      226\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 470,\n    \"displayName\": \"Beetroots\",\n    \"name\": \"beetroots\",\n    \"hardness\": 0,\n    \"minStateId\": 8158,\n    \"maxStateId\": 8161,\n    \"states\": [\n      {\n        \"name\": \"age\",\n        \"type\": \"int\",\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      749\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 471,\n    \"displayName\": \"Grass Path\",\n    \"name\": \"grass_path\",\n    \"hardness\": 0.65,\n    \"minStateId\": 8162,\n    \"maxStateId\": 8162,\n    \"states\": [],\n    \"drops\": [\n      304\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 472,\n    \"displayName\": \"End Gateway\",\n    \"name\": \"end_gateway\",\n    \"hardness\": null,\n    \"minStateId\": 8163,\n    \"maxStateId\": 8163,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 15,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 473,\n    \"displayName\": \"Repeating Command Block\",\n    \"name\": \"repeating_command_block\",\n    \"hardness\": null,\n    \"minStateId\": 8164,\n    \"maxStateId\": 8175,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      },\n      {\n        \"name\": \"conditional\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      354\n    ],\n    \"diggable\": false,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 474,\n    \"displayName\": \"Chain Command Block\",\n    \"name\": \"chain_command_block\",\n    \"hardness\": null,\n    \"minStateId\": 8176,\n    \"maxStateId\": 8187,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      },\n      {\n        \"name\": \"conditional\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      355\n    ],\n    \"diggable\": false,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 475,\n    \"displayName\": \"Frosted Ice\",\n    \"name\": \"frosted_ice\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8188,\n    \"maxStateId\": 8191,\n    \"states\": [\n      {\n        \"name\": \"age\",\n        \"type\": \"int\",\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 2,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 476,\n    \"displayName\": \"Magma Block\",\n    \"name\": \"magma_block\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8192,\n    \"maxStateId\": 8192,\n    \"states\": [],\n    \"drops\": [\n      356\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 477,\n    \"displayName\": \"Nether Wart Block\",\n    \"name\": \"nether_wart_block\",\n    \"hardness\": 1,\n    \"minStateId\": 8193,\n    \"maxStateId\": 8193,\n    \"states\": [],\n    \"drops\": [\n      357\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 478,\n    \"displayName\": \"Red Nether Bricks\",\n    \"name\": \"red_nether_bricks\",\n    \"hardness\": 2,\n    \"minStateId\": 8194,\n    \"maxStateId\": 8194,\n    \"states\": [],\n    \"drops\": [\n      358\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 479,\n    \"displayName\": \"Bone Block\",\n    \"name\": \"bone_block\",\n    \"hardness\": 2,\n    \"minStateId\": 8195,\n    \"maxStateId\": 8197,\n    \"states\": [\n      {\n        \"name\": \"axis\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"X\",\n          \"Y\",\n          \"Z\"\n        ],\n        \"num_values\": 3\n      }\n    ],\n    \"drops\": [\n      359\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 480,\n    \"displayName\": \"Structure Void\",\n    \"name\": \"structure_void\",\n    \"hardness\": 0,\n    \"minStateId\": 8198,\n    \"maxStateId\": 8198,\n    \"states\": [],\n    \"drops\": [\n      360\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 481,\n    \"displayName\": \"Observer\",\n    \"name\": \"observer\",\n    \"hardness\": 3,\n    \"minStateId\": 8199,\n    \"maxStateId\": 8210,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      },\n      {\n        \"name\": \"powered\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      361\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 482,\n    \"displayName\": \"Shulker Box\",\n    \"name\": \"shulker_box\",\n    \"hardness\": 2,\n    \"minStateId\": 8211,\n    \"maxStateId\": 8216,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      }\n    ],\n    \"drops\": [\n      362\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 483,\n    \"displayName\": \"White Shulker Box\",\n    \"name\": \"white_shulker_box\",\n    \"hardness\": 2,\n    \"minStateId\": 8217,\n    \"maxStateId\": 8222,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      }\n    ],\n    \"drops\": [\n      363\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 484,\n    \"displayName\": \"Orange Shulker Box\",\n    \"name\": \"orange_shulker_box\",\n    \"hardness\": 2,\n    \"minStateId\": 8223,\n    \"maxStateId\": 8228,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      }\n    ],\n    \"drops\": [\n      364\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 485,\n    \"displayName\": \"Magenta Shulker Box\",\n    \"name\": \"magenta_shulker_box\",\n    \"hardness\": 2,\n    \"minStateId\": 8229,\n    \"maxStateId\": 8234,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      }\n    ],\n    \"drops\": [\n      365\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 486,\n    \"displayName\": \"Light Blue Shulker Box\",\n    \"name\": \"light_blue_shulker_box\",\n    \"hardness\": 2,\n    \"minStateId\": 8235,\n    \"maxStateId\": 8240,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      }\n    ],\n    \"drops\": [\n      366\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 487,\n    \"displayName\": \"Yellow Shulker Box\",\n    \"name\": \"yellow_shulker_box\",\n    \"hardness\": 2,\n    \"minStateId\": 8241,\n    \"maxStateId\": 8246,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      }\n    ],\n    \"drops\": [\n      367\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 488,\n    \"displayName\": \"Lime Shulker Box\",\n    \"name\": \"lime_shulker_box\",\n    \"hardness\": 2,\n    \"minStateId\": 8247,\n    \"maxStateId\": 8252,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      }\n    ],\n    \"drops\": [\n      368\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 489,\n    \"displayName\": \"Pink Shulker Box\",\n    \"name\": \"pink_shulker_box\",\n    \"hardness\": 2,\n    \"minStateId\": 8253,\n    \"maxStateId\": 8258,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      }\n    ],\n    \"drops\": [\n      369\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 490,\n    \"displayName\": \"Gray Shulker Box\",\n    \"name\": \"gray_shulker_box\",\n    \"hardness\": 2,\n    \"minStateId\": 8259,\n    \"maxStateId\": 8264,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      }\n    ],\n    \"drops\": [\n      370\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 491,\n    \"displayName\": \"Light Gray Shulker Box\",\n    \"name\": \"light_gray_shulker_box\",\n    \"hardness\": 2,\n    \"minStateId\": 8265,\n    \"maxStateId\": 8270,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      }\n    ],\n    \"drops\": [\n      371\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 492,\n    \"displayName\": \"Cyan Shulker Box\",\n    \"name\": \"cyan_shulker_box\",\n    \"hardness\": 2,\n    \"minStateId\": 8271,\n    \"maxStateId\": 8276,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      }\n    ],\n    \"drops\": [\n      372\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 493,\n    \"displayName\": \"Purple Shulker Box\",\n    \"name\": \"purple_shulker_box\",\n    \"hardness\": 2,\n    \"minStateId\": 8277,\n    \"maxStateId\": 8282,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      }\n    ],\n    \"drops\": [\n      373\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 494,\n    \"displayName\": \"Blue Shulker Box\",\n    \"name\": \"blue_shulker_box\",\n    \"hardness\": 2,\n    \"minStateId\": 8283,\n    \"maxStateId\": 8288,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      }\n    ],\n    \"drops\": [\n      374\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 495,\n    \"displayName\": \"Brown Shulker Box\",\n    \"name\": \"brown_shulker_box\",\n    \"hardness\": 2,\n    \"minStateId\": 8289,\n    \"maxStateId\": 8294,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      }\n    ],\n    \"drops\": [\n      375\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 496,\n    \"displayName\": \"Green Shulker Box\",\n    \"name\": \"green_shulker_box\",\n    \"hardness\": 2,\n    \"minStateId\": 8295,\n    \"maxStateId\": 8300,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      }\n    ],\n    \"drops\": [\n      376\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 497,\n    \"displayName\": \"Red Shulker Box\",\n    \"name\": \"red_shulker_box\",\n    \"hardness\": 2,\n    \"minStateId\": 8301,\n    \"maxStateId\": 8306,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      }\n    ],\n    \"drops\": [\n      377\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 498,\n    \"displayName\": \"Black Shulker Box\",\n    \"name\": \"black_shulker_box\",\n    \"hardness\": 2,\n    \"minStateId\": 8307,\n    \"maxStateId\": 8312,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\",\n          \"UP\",\n          \"DOWN\"\n        ],\n        \"num_values\": 6\n      }\n    ],\n    \"drops\": [\n      378\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 499,\n    \"displayName\": \"White Glazed Terracotta\",\n    \"name\": \"white_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 8313,\n    \"maxStateId\": 8316,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      379\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 500,\n    \"displayName\": \"Orange Glazed Terracotta\",\n    \"name\": \"orange_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 8317,\n    \"maxStateId\": 8320,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      380\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 501,\n    \"displayName\": \"Magenta Glazed Terracotta\",\n    \"name\": \"magenta_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 8321,\n    \"maxStateId\": 8324,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      381\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 502,\n    \"displayName\": \"Light Blue Glazed Terracotta\",\n    \"name\": \"light_blue_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 8325,\n    \"maxStateId\": 8328,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      382\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 503,\n    \"displayName\": \"Yellow Glazed Terracotta\",\n    \"name\": \"yellow_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 8329,\n    \"maxStateId\": 8332,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      383\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 504,\n    \"displayName\": \"Lime Glazed Terracotta\",\n    \"name\": \"lime_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 8333,\n    \"maxStateId\": 8336,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      384\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 505,\n    \"displayName\": \"Pink Glazed Terracotta\",\n    \"name\": \"pink_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 8337,\n    \"maxStateId\": 8340,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      385\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 506,\n    \"displayName\": \"Gray Glazed Terracotta\",\n    \"name\": \"gray_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 8341,\n    \"maxStateId\": 8344,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      386\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 507,\n    \"displayName\": \"Light Gray Glazed Terracotta\",\n    \"name\": \"light_gray_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 8345,\n    \"maxStateId\": 8348,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      387\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 508,\n    \"displayName\": \"Cyan Glazed Terracotta\",\n    \"name\": \"cyan_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 8349,\n    \"maxStateId\": 8352,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      388\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 509,\n    \"displayName\": \"Purple Glazed Terracotta\",\n    \"name\": \"purple_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 8353,\n    \"maxStateId\": 8356,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      389\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 510,\n    \"displayName\": \"Blue Glazed Terracotta\",\n    \"name\": \"blue_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 8357,\n    \"maxStateId\": 8360,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      390\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 511,\n    \"displayName\": \"Brown Glazed Terracotta\",\n    \"name\": \"brown_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 8361,\n    \"maxStateId\": 8364,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      391\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 512,\n    \"displayName\": \"Green Glazed Terracotta\",\n    \"name\": \"green_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 8365,\n    \"maxStateId\": 8368,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      392\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 513,\n    \"displayName\": \"Red Glazed Terracotta\",\n    \"name\": \"red_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 8369,\n    \"maxStateId\": 8372,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      393\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 514,\n    \"displayName\": \"Black Glazed Terracotta\",\n    \"name\": \"black_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 8373,\n    \"maxStateId\": 8376,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      394\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 515,\n    \"displayName\": \"White Concrete\",\n    \"name\": \"white_concrete\",\n    \"hardness\": 1.8,\n    \"minStateId\": 8377,\n    \"maxStateId\": 8377,\n    \"states\": [],\n    \"drops\": [\n      395\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 516,\n    \"displayName\": \"Orange Concrete\",\n    \"name\": \"orange_concrete\",\n    \"hardness\": 1.8,\n    \"minStateId\": 8378,\n    \"maxStateId\": 8378,\n    \"states\": [],\n    \"drops\": [\n      396\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 517,\n    \"displayName\": \"Magenta Concrete\",\n    \"name\": \"magenta_concrete\",\n    \"hardness\": 1.8,\n    \"minStateId\": 8379,\n    \"maxStateId\": 8379,\n    \"states\": [],\n    \"drops\": [\n      397\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 518,\n    \"displayName\": \"Light Blue Concrete\",\n    \"name\": \"light_blue_concrete\",\n    \"hardness\": 1.8,\n    \"minStateId\": 8380,\n    \"maxStateId\": 8380,\n    \"states\": [],\n    \"drops\": [\n      398\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 519,\n    \"displayName\": \"Yellow Concrete\",\n    \"name\": \"yellow_concrete\",\n    \"hardness\": 1.8,\n    \"minStateId\": 8381,\n    \"maxStateId\": 8381,\n    \"states\": [],\n    \"drops\": [\n      399\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 520,\n    \"displayName\": \"Lime Concrete\",\n    \"name\": \"lime_concrete\",\n    \"hardness\": 1.8,\n    \"minStateId\": 8382,\n    \"maxStateId\": 8382,\n    \"states\": [],\n    \"drops\": [\n      400\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 521,\n    \"displayName\": \"Pink Concrete\",\n    \"name\": \"pink_concrete\",\n    \"hardness\": 1.8,\n    \"minStateId\": 8383,\n    \"maxStateId\": 8383,\n    \"states\": [],\n    \"drops\": [\n      401\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 522,\n    \"displayName\": \"Gray Concrete\",\n    \"name\": \"gray_concrete\",\n    \"hardness\": 1.8,\n    \"minStateId\": 8384,\n    \"maxStateId\": 8384,\n    \"states\": [],\n    \"drops\": [\n      402\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 523,\n    \"displayName\": \"Light Gray Concrete\",\n    \"name\": \"light_gray_concrete\",\n    \"hardness\": 1.8,\n    \"minStateId\": 8385,\n    \"maxStateId\": 8385,\n    \"states\": [],\n    \"drops\": [\n      403\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 524,\n    \"displayName\": \"Cyan Concrete\",\n    \"name\": \"cyan_concrete\",\n    \"hardness\": 1.8,\n    \"minStateId\": 8386,\n    \"maxStateId\": 8386,\n    \"states\": [],\n    \"drops\": [\n      404\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 525,\n    \"displayName\": \"Purple Concrete\",\n    \"name\": \"purple_concrete\",\n    \"hardness\": 1.8,\n    \"minStateId\": 8387,\n    \"maxStateId\": 8387,\n    \"states\": [],\n    \"drops\": [\n      405\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 526,\n    \"displayName\": \"Blue Concrete\",\n    \"name\": \"blue_concrete\",\n    \"hardness\": 1.8,\n    \"minStateId\": 8388,\n    \"maxStateId\": 8388,\n    \"states\": [],\n    \"drops\": [\n      406\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 527,\n    \"displayName\": \"Brown Concrete\",\n    \"name\": \"brown_concrete\",\n    \"hardness\": 1.8,\n    \"minStateId\": 8389,\n    \"maxStateId\": 8389,\n    \"states\": [],\n    \"drops\": [\n      407\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 528,\n    \"displayName\": \"Green Concrete\",\n    \"name\": \"green_concrete\",\n    \"hardness\": 1.8,\n    \"minStateId\": 8390,\n    \"maxStateId\": 8390,\n    \"states\": [],\n    \"drops\": [\n      408\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 529,\n    \"displayName\": \"Red Concrete\",\n    \"name\": \"red_concrete\",\n    \"hardness\": 1.8,\n    \"minStateId\": 8391,\n    \"maxStateId\": 8391,\n    \"states\": [],\n    \"drops\": [\n      409\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 530,\n    \"displayName\": \"Black Concrete\",\n    \"name\": \"black_concrete\",\n    \"hardness\": 1.8,\n    \"minStateId\": 8392,\n    \"maxStateId\": 8392,\n    \"states\": [],\n    \"drops\": [\n      410\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 531,\n    \"displayName\": \"White Concrete Powder\",\n    \"name\": \"white_concrete_powder\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8393,\n    \"maxStateId\": 8393,\n    \"states\": [],\n    \"drops\": [\n      411\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 532,\n    \"displayName\": \"Orange Concrete Powder\",\n    \"name\": \"orange_concrete_powder\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8394,\n    \"maxStateId\": 8394,\n    \"states\": [],\n    \"drops\": [\n      412\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 533,\n    \"displayName\": \"Magenta Concrete Powder\",\n    \"name\": \"magenta_concrete_powder\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8395,\n    \"maxStateId\": 8395,\n    \"states\": [],\n    \"drops\": [\n      413\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 534,\n    \"displayName\": \"Light Blue Concrete Powder\",\n    \"name\": \"light_blue_concrete_powder\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8396,\n    \"maxStateId\": 8396,\n    \"states\": [],\n    \"drops\": [\n      414\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 535,\n    \"displayName\": \"Yellow Concrete Powder\",\n    \"name\": \"yellow_concrete_powder\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8397,\n    \"maxStateId\": 8397,\n    \"states\": [],\n    \"drops\": [\n      415\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 536,\n    \"displayName\": \"Lime Concrete Powder\",\n    \"name\": \"lime_concrete_powder\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8398,\n    \"maxStateId\": 8398,\n    \"states\": [],\n    \"drops\": [\n      416\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 537,\n    \"displayName\": \"Pink Concrete Powder\",\n    \"name\": \"pink_concrete_powder\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8399,\n    \"maxStateId\": 8399,\n    \"states\": [],\n    \"drops\": [\n      417\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 538,\n    \"displayName\": \"Gray Concrete Powder\",\n    \"name\": \"gray_concrete_powder\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8400,\n    \"maxStateId\": 8400,\n    \"states\": [],\n    \"drops\": [\n      418\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 539,\n    \"displayName\": \"Light Gray Concrete Powder\",\n    \"name\": \"light_gray_concrete_powder\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8401,\n    \"maxStateId\": 8401,\n    \"states\": [],\n    \"drops\": [\n      419\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 540,\n    \"displayName\": \"Cyan Concrete Powder\",\n    \"name\": \"cyan_concrete_powder\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8402,\n    \"maxStateId\": 8402,\n    \"states\": [],\n    \"drops\": [\n      420\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 541,\n    \"displayName\": \"Purple Concrete Powder\",\n    \"name\": \"purple_concrete_powder\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8403,\n    \"maxStateId\": 8403,\n    \"states\": [],\n    \"drops\": [\n      421\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 542,\n    \"displayName\": \"Blue Concrete Powder\",\n    \"name\": \"blue_concrete_powder\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8404,\n    \"maxStateId\": 8404,\n    \"states\": [],\n    \"drops\": [\n      422\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 543,\n    \"displayName\": \"Brown Concrete Powder\",\n    \"name\": \"brown_concrete_powder\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8405,\n    \"maxStateId\": 8405,\n    \"states\": [],\n    \"drops\": [\n      423\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 544,\n    \"displayName\": \"Green Concrete Powder\",\n    \"name\": \"green_concrete_powder\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8406,\n    \"maxStateId\": 8406,\n    \"states\": [],\n    \"drops\": [\n      424\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 545,\n    \"displayName\": \"Red Concrete Powder\",\n    \"name\": \"red_concrete_powder\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8407,\n    \"maxStateId\": 8407,\n    \"states\": [],\n    \"drops\": [\n      425\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 546,\n    \"displayName\": \"Black Concrete Powder\",\n    \"name\": \"black_concrete_powder\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8408,\n    \"maxStateId\": 8408,\n    \"states\": [],\n    \"drops\": [\n      426\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 547,\n    \"displayName\": \"Kelp\",\n    \"name\": \"kelp\",\n    \"hardness\": 0,\n    \"minStateId\": 8409,\n    \"maxStateId\": 8434,\n    \"states\": [\n      {\n        \"name\": \"age\",\n        \"type\": \"int\",\n        \"num_values\": 26\n      }\n    ],\n    \"drops\": [\n      554\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 548,\n    \"displayName\": \"Kelp Plant\",\n    \"name\": \"kelp_plant\",\n    \"hardness\": 0,\n    \"minStateId\": 8435,\n    \"maxStateId\": 8435,\n    \"states\": [],\n    \"drops\": [\n      554\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 549,\n    \"displayName\": \"Dried Kelp Block\",\n    \"name\": \"dried_kelp_block\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8436,\n    \"maxStateId\": 8436,\n    \"states\": [],\n    \"drops\": [\n      555\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 550,\n    \"displayName\": \"Turtle Egg\",\n    \"name\": \"turtle_egg\",\n    \"hardness\": 0.5,\n    \"minStateId\": 8437,\n    \"maxStateId\": 8448,\n    \"states\": [\n      {\n        \"name\": \"hatch\",\n        \"type\": \"int\",\n        \"num_values\": 3\n      },\n      {\n        \"name\": \"eggs\",\n        \"type\": \"int\",\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      427\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 551,\n    \"displayName\": \"Dead Tube Coral Block\",\n    \"name\": \"dead_tube_coral_block\",\n    \"hardness\": 1.5,\n    \"minStateId\": 8449,\n    \"maxStateId\": 8449,\n    \"states\": [],\n    \"drops\": [\n      428\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 552,\n    \"displayName\": \"Dead Brain Coral Block\",\n    \"name\": \"dead_brain_coral_block\",\n    \"hardness\": 1.5,\n    \"minStateId\": 8450,\n    \"maxStateId\": 8450,\n    \"states\": [],\n    \"drops\": [\n      429\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 553,\n    \"displayName\": \"Dead Bubble Coral Block\",\n    \"name\": \"dead_bubble_coral_block\",\n    \"hardness\": 1.5,\n    \"minStateId\": 8451,\n    \"maxStateId\": 8451,\n    \"states\": [],\n    \"drops\": [\n      430\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 554,\n    \"displayName\": \"Dead Fire Coral Block\",\n    \"name\": \"dead_fire_coral_block\",\n    \"hardness\": 1.5,\n    \"minStateId\": 8452,\n    \"maxStateId\": 8452,\n    \"states\": [],\n    \"drops\": [\n      431\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 555,\n    \"displayName\": \"Dead Horn Coral Block\",\n    \"name\": \"dead_horn_coral_block\",\n    \"hardness\": 1.5,\n    \"minStateId\": 8453,\n    \"maxStateId\": 8453,\n    \"states\": [],\n    \"drops\": [\n      432\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 556,\n    \"displayName\": \"Tube Coral Block\",\n    \"name\": \"tube_coral_block\",\n    \"hardness\": 1.5,\n    \"minStateId\": 8454,\n    \"maxStateId\": 8454,\n    \"states\": [],\n    \"drops\": [\n      433\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 557,\n    \"displayName\": \"Brain Coral Block\",\n    \"name\": \"brain_coral_block\",\n    \"hardness\": 1.5,\n    \"minStateId\": 8455,\n    \"maxStateId\": 8455,\n    \"states\": [],\n    \"drops\": [\n      434\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 558,\n    \"displayName\": \"Bubble Coral Block\",\n    \"name\": \"bubble_coral_block\",\n    \"hardness\": 1.5,\n    \"minStateId\": 8456,\n    \"maxStateId\": 8456,\n    \"states\": [],\n    \"drops\": [\n      435\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 559,\n    \"displayName\": \"Fire Coral Block\",\n    \"name\": \"fire_coral_block\",\n    \"hardness\": 1.5,\n    \"minStateId\": 8457,\n    \"maxStateId\": 8457,\n    \"states\": [],\n    \"drops\": [\n      436\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 560,\n    \"displayName\": \"Horn Coral Block\",\n    \"name\": \"horn_coral_block\",\n    \"hardness\": 1.5,\n    \"minStateId\": 8458,\n    \"maxStateId\": 8458,\n    \"states\": [],\n    \"drops\": [\n      437\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"468\": true,\n      \"482\": true,\n      \"486\": true,\n      \"490\": true,\n      \"497\": true\n    }\n  },\n  {\n    \"id\": 561,\n    \"displayName\": \"Tube Coral\",\n    \"name\": \"tube_coral\",\n    \"hardness\": 0,\n    \"minStateId\": 8459,\n    \"maxStateId\": 8459,\n    \"states\": [],\n    \"drops\": [\n      438\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 562,\n    \"displayName\": \"Brain Coral\",\n    \"name\": \"brain_coral\",\n    \"hardness\": 0,\n    \"minStateId\": 8460,\n    \"maxStateId\": 8460,\n    \"states\": [],\n    \"drops\": [\n      439\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 563,\n    \"displayName\": \"Bubble Coral\",\n    \"name\": \"bubble_coral\",\n    \"hardness\": 0,\n    \"minStateId\": 8461,\n    \"maxStateId\": 8461,\n    \"states\": [],\n    \"drops\": [\n      440\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 564,\n    \"displayName\": \"Fire Coral\",\n    \"name\": \"fire_coral\",\n    \"hardness\": 0,\n    \"minStateId\": 8462,\n    \"maxStateId\": 8462,\n    \"states\": [],\n    \"drops\": [\n      441\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 565,\n    \"displayName\": \"Horn Coral\",\n    \"name\": \"horn_coral\",\n    \"hardness\": 0,\n    \"minStateId\": 8463,\n    \"maxStateId\": 8463,\n    \"states\": [],\n    \"drops\": [\n      442\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 566,\n    \"displayName\": \"Dead Tube Coral Wall Fan\",\n    \"name\": \"dead_tube_coral_wall_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8464,\n    \"maxStateId\": 8471,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      448\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 567,\n    \"displayName\": \"Dead Brain Coral Wall Fan\",\n    \"name\": \"dead_brain_coral_wall_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8472,\n    \"maxStateId\": 8479,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      449\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 568,\n    \"displayName\": \"Dead Bubble Coral Wall Fan\",\n    \"name\": \"dead_bubble_coral_wall_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8480,\n    \"maxStateId\": 8487,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      450\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 569,\n    \"displayName\": \"Dead Fire Coral Wall Fan\",\n    \"name\": \"dead_fire_coral_wall_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8488,\n    \"maxStateId\": 8495,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      451\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 570,\n    \"displayName\": \"Dead Horn Coral Wall Fan\",\n    \"name\": \"dead_horn_coral_wall_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8496,\n    \"maxStateId\": 8503,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      452\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 571,\n    \"displayName\": \"Tube Coral Wall Fan\",\n    \"name\": \"tube_coral_wall_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8504,\n    \"maxStateId\": 8511,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      443\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 572,\n    \"displayName\": \"Brain Coral Wall Fan\",\n    \"name\": \"brain_coral_wall_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8512,\n    \"maxStateId\": 8519,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      444\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 573,\n    \"displayName\": \"Bubble Coral Wall Fan\",\n    \"name\": \"bubble_coral_wall_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8520,\n    \"maxStateId\": 8527,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      445\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 574,\n    \"displayName\": \"Fire Coral Wall Fan\",\n    \"name\": \"fire_coral_wall_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8528,\n    \"maxStateId\": 8535,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      446\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 575,\n    \"displayName\": \"Horn Coral Wall Fan\",\n    \"name\": \"horn_coral_wall_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8536,\n    \"maxStateId\": 8543,\n    \"states\": [\n      {\n        \"name\": \"facing\",\n        \"type\": \"direction\",\n        \"values\": [\n          \"NORTH\",\n          \"EAST\",\n          \"SOUTH\",\n          \"WEST\"\n        ],\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      447\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 576,\n    \"displayName\": \"Dead Tube Coral Fan\",\n    \"name\": \"dead_tube_coral_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8544,\n    \"maxStateId\": 8545,\n    \"states\": [\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      448\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 577,\n    \"displayName\": \"Dead Brain Coral Fan\",\n    \"name\": \"dead_brain_coral_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8546,\n    \"maxStateId\": 8547,\n    \"states\": [\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      449\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 578,\n    \"displayName\": \"Dead Bubble Coral Fan\",\n    \"name\": \"dead_bubble_coral_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8548,\n    \"maxStateId\": 8549,\n    \"states\": [\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      450\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 579,\n    \"displayName\": \"Dead Fire Coral Fan\",\n    \"name\": \"dead_fire_coral_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8550,\n    \"maxStateId\": 8551,\n    \"states\": [\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      451\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 580,\n    \"displayName\": \"Dead Horn Coral Fan\",\n    \"name\": \"dead_horn_coral_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8552,\n    \"maxStateId\": 8553,\n    \"states\": [\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      452\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 581,\n    \"displayName\": \"Tube Coral Fan\",\n    \"name\": \"tube_coral_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8554,\n    \"maxStateId\": 8555,\n    \"states\": [\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      443\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 582,\n    \"displayName\": \"Brain Coral Fan\",\n    \"name\": \"brain_coral_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8556,\n    \"maxStateId\": 8557,\n    \"states\": [\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      444\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 583,\n    \"displayName\": \"Bubble Coral Fan\",\n    \"name\": \"bubble_coral_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8558,\n    \"maxStateId\": 8559,\n    \"states\": [\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      445\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 584,\n    \"displayName\": \"Fire Coral Fan\",\n    \"name\": \"fire_coral_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8560,\n    \"maxStateId\": 8561,\n    \"states\": [\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      446\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 585,\n    \"displayName\": \"Horn Coral Fan\",\n    \"name\": \"horn_coral_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 8562,\n    \"maxStateId\": 8563,\n    \"states\": [\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      447\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 586,\n    \"displayName\": \"Sea Pickle\",\n    \"name\": \"sea_pickle\",\n    \"hardness\": 0,\n    \"minStateId\": 8564,\n    \"maxStateId\": 8571,\n    \"states\": [\n      {\n        \"name\": \"pickles\",\n        \"type\": \"int\",\n        \"num_values\": 4\n      },\n      {\n        \"name\": \"waterlogged\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [\n      80\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 587,\n    \"displayName\": \"Blue Ice\",\n    \"name\": \"blue_ice\",\n    \"hardness\": 2.8,\n    \"minStateId\": 8572,\n    \"maxStateId\": 8572,\n    \"states\": [],\n    \"drops\": [\n      453\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 588,\n    \"displayName\": \"Conduit\",\n    \"name\": \"conduit\",\n    \"hardness\": 3,\n    \"minStateId\": 8573,\n    \"maxStateId\": 8573,\n    \"states\": [],\n    \"drops\": [\n      454\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 589,\n    \"displayName\": \"Void Air\",\n    \"name\": \"void_air\",\n    \"hardness\": 0,\n    \"minStateId\": 8574,\n    \"maxStateId\": 8574,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 590,\n    \"displayName\": \"Cave Air\",\n    \"name\": \"cave_air\",\n    \"hardness\": 0,\n    \"minStateId\": 8575,\n    \"maxStateId\": 8575,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 591,\n    \"displayName\": \"Bubble Column\",\n    \"name\": \"bubble_column\",\n    \"hardness\": 0,\n    \"minStateId\": 8576,\n    \"maxStateId\": 8577,\n    \"states\": [\n      {\n        \"name\": \"drag\",\n        \"type\": \"bool\",\n        \"num_values\": 2\n      }\n    ],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 592,\n    \"displayName\": \"Structure Block\",\n    \"name\": \"structure_block\",\n    \"hardness\": null,\n    \"minStateId\": 8578,\n    \"maxStateId\": 8581,\n    \"states\": [\n      {\n        \"name\": \"mode\",\n        \"type\": \"enum\",\n        \"values\": [\n          \"SAVE\",\n          \"LOAD\",\n          \"CORNER\",\n          \"DATA\"\n        ],\n        \"num_values\": 4\n      }\n    ],\n    \"drops\": [\n      464\n    ],\n    \"diggable\": false,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  }\n]"
 var _Assets52d7eaeefb1c8376436656230525a63631fad3cc = "These file are from https://github.com/PrismarineJS/minecraft-data. (author: PrismarineJS)\r\n\r\nThank you, they!\r\n\r\nThey are licensed under MIT License.\r\n\r\nYou can see MIT License below.\r\nhttp://opensource.org/licenses/mit-license.php"
 var _Assetsada524eca45011d59168345317e77a72ab541eae = "[\n  {\n    \"id\": 0,\n    \"displayName\": \"Air\",\n    \"name\": \"air\",\n    \"hardness\": 0,\n    \"stackSize\": 0,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"drops\": [\n      {\n        \"drop\": 0\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 1,\n    \"displayName\": \"Stone\",\n    \"name\": \"stone\",\n    \"hardness\": 1.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 4\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 2,\n    \"displayName\": \"Grass Block\",\n    \"name\": \"grass\",\n    \"hardness\": 0.6,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 3,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 3,\n    \"displayName\": \"Dirt\",\n    \"name\": \"dirt\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Dirt\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Coarse Dirt\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Podzol\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 3\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 4,\n    \"displayName\": \"Cobblestone\",\n    \"name\": \"cobblestone\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 4\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 5,\n    \"displayName\": \"Wood Planks\",\n    \"name\": \"planks\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Oak Wood Planks\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Spruce Wood Planks\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Birch Wood Planks\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Jungle Wood Planks\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Acacia Wood Planks\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Dark Oak Wood Planks\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 5\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 6,\n    \"displayName\": \"Sapling\",\n    \"name\": \"sapling\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 6\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 7,\n    \"displayName\": \"Bedrock\",\n    \"name\": \"bedrock\",\n    \"hardness\": null,\n    \"stackSize\": 64,\n    \"diggable\": false,\n    \"boundingBox\": \"block\",\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 8,\n    \"displayName\": \"Water\",\n    \"name\": \"flowing_water\",\n    \"hardness\": 100,\n    \"stackSize\": 0,\n    \"diggable\": false,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 2\n  },\n  {\n    \"id\": 9,\n    \"displayName\": \"Stationary Water\",\n    \"name\": \"water\",\n    \"hardness\": 100,\n    \"stackSize\": 0,\n    \"diggable\": false,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 2\n  },\n  {\n    \"id\": 10,\n    \"displayName\": \"Lava\",\n    \"name\": \"flowing_lava\",\n    \"hardness\": 100,\n    \"stackSize\": 0,\n    \"diggable\": false,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 15,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 11,\n    \"displayName\": \"Stationary Lava\",\n    \"name\": \"lava\",\n    \"hardness\": 100,\n    \"stackSize\": 0,\n    \"diggable\": false,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 15,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 12,\n    \"displayName\": \"Sand\",\n    \"name\": \"sand\",\n    \"hardness\": 0.5,\n    \"stackSize\": 0,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Sand\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Red sand\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 12\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 13,\n    \"displayName\": \"Gravel\",\n    \"name\": \"gravel\",\n    \"hardness\": 0.6,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"drops\": [\n      {\n        \"drop\": 13,\n        \"minCount\": 0.9\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 14,\n    \"displayName\": \"Gold Ore\",\n    \"name\": \"gold_ore\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 14\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 15,\n    \"displayName\": \"Iron Ore\",\n    \"name\": \"iron_ore\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"274\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 15\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 16,\n    \"displayName\": \"Coal Ore\",\n    \"name\": \"coal_ore\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 263,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 17,\n    \"displayName\": \"Wood\",\n    \"name\": \"log\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 17\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 18,\n    \"displayName\": \"Leaves\",\n    \"name\": \"leaves\",\n    \"hardness\": 0.2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Oak Leaves\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Spruce Leaves\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Birch Leaves\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Jungle Leaves\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Oak Leaves (no decay)\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Spruce Leaves (no decay)\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Birch Leaves (no decay)\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Jungle Leaves (no decay)\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Oak Leaves (check decay)\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Spruce Leaves (check decay)\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Birch Leaves (check decay)\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Jungle Leaves (check decay)\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Oak Leaves (no decay and check decay)\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Spruce Leaves (no decay and check decay)\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Birch Leaves (no decay and check decay)\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Jungle Leaves (no decay and check decay)\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 6,\n        \"minCount\": 0,\n        \"maxCount\": 1\n      },\n      {\n        \"drop\": 260,\n        \"minCount\": 0,\n        \"maxCount\": 1\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 19,\n    \"displayName\": \"Sponge\",\n    \"name\": \"sponge\",\n    \"hardness\": 0.6,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Sponge\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Wet Sponge\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 19\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 20,\n    \"displayName\": \"Glass\",\n    \"name\": \"glass\",\n    \"hardness\": 0.3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"White Stained Glass\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Orange Stained Glass\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Magenta Stained Glass\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Light Blue Stained Glass\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Yellow Stained Glass\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Lime Stained Glass\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Pink Stained Glass\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Gray Stained Glass\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Light Gray Stained Glass\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Cyan Stained Glass\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Purple Stained Glass\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Blue Stained Glass\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Brown Stained Glass\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Green Stained Glass\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Red Stained Glass\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Black Stained Glass\"\n      }\n    ],\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 21,\n    \"displayName\": \"Lapis Lazuli Ore\",\n    \"name\": \"lapis_ore\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"274\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 351,\n          \"metadata\": 4\n        },\n        \"minCount\": 4,\n        \"maxCount\": 8\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 22,\n    \"displayName\": \"Lapis Lazuli Block\",\n    \"name\": \"lapis_block\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"274\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 22\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 23,\n    \"displayName\": \"Dispenser\",\n    \"name\": \"dispenser\",\n    \"hardness\": 3.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 23\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 24,\n    \"displayName\": \"Sandstone\",\n    \"name\": \"sandstone\",\n    \"hardness\": 0.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Sandstone\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Chiseled sandstone\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Smooth sandstone\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 24\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 25,\n    \"displayName\": \"Note Block\",\n    \"name\": \"noteblock\",\n    \"hardness\": 0.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 25\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 26,\n    \"displayName\": \"Bed\",\n    \"name\": \"bed\",\n    \"hardness\": 0.2,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 26\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 27,\n    \"displayName\": \"Powered Rail\",\n    \"name\": \"golden_rail\",\n    \"hardness\": 0.7,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"rock\",\n    \"drops\": [\n      {\n        \"drop\": 27\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 28,\n    \"displayName\": \"Detector Rail\",\n    \"name\": \"detector_rail\",\n    \"hardness\": 0.7,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"rock\",\n    \"drops\": [\n      {\n        \"drop\": 28\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 29,\n    \"displayName\": \"Sticky Piston\",\n    \"name\": \"sticky_piston\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 29\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 30,\n    \"displayName\": \"Cobweb\",\n    \"name\": \"web\",\n    \"hardness\": 4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"web\",\n    \"harvestTools\": {\n      \"267\": true,\n      \"268\": true,\n      \"272\": true,\n      \"276\": true,\n      \"283\": true,\n      \"359\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 287\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 31,\n    \"displayName\": \"Grass\",\n    \"name\": \"tallgrass\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Shrub\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Tall Grass\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Fern\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 295,\n        \"minCount\": 0,\n        \"maxCount\": 1\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 32,\n    \"displayName\": \"Dead Bush\",\n    \"name\": \"deadbush\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 280,\n        \"minCount\": 0,\n        \"maxCount\": 2\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 33,\n    \"displayName\": \"Piston\",\n    \"name\": \"piston\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 33\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 34,\n    \"displayName\": \"Piston Head\",\n    \"name\": \"piston_head\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 34\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 35,\n    \"displayName\": \"Wool\",\n    \"name\": \"wool\",\n    \"hardness\": 0.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wool\",\n    \"drops\": [\n      {\n        \"drop\": 35\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 36,\n    \"displayName\": \"Block moved by Piston\",\n    \"name\": \"piston_extension\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 36\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 37,\n    \"displayName\": \"Dandelion\",\n    \"name\": \"yellow_flower\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 37\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 38,\n    \"displayName\": \"Poppy\",\n    \"name\": \"red_flower\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Poppy\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Blue Orchid\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Allium\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Azure Bluet\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Red Tulip\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Orange Tulip\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"White Tulip\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Pink Tulip\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Oxeye Daisy\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 38\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 39,\n    \"displayName\": \"Brown Mushroom\",\n    \"name\": \"brown_mushroom\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 39\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 1,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 40,\n    \"displayName\": \"Red Mushroom\",\n    \"name\": \"red_mushroom\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 40\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 1,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 41,\n    \"displayName\": \"Block of Gold\",\n    \"name\": \"gold_block\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 41\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 42,\n    \"displayName\": \"Block of Iron\",\n    \"name\": \"iron_block\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"274\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 42\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 43,\n    \"displayName\": \"Double Stone Slab\",\n    \"name\": \"double_stone_slab\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Double Stone Slab\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Double Sandstone Slab\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Double (Stone) Wooden Slab\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Double Cobblestone Slab\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Double Bricks Slab\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Double Stone Brick Slab\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Double Nether Brick Slab\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Double Quartz Slab\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Smooth Double Stone Slab\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Smooth Double Sandstone Slab\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Tile Double Quartz Slab (note the underside)\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 44,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 44,\n    \"displayName\": \"Stone Slab\",\n    \"name\": \"stone_slab\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Stone Slab\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Sandstone Slab\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"(Stone) Wooden Slab\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Cobblestone Slab\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Bricks Slab\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Stone Brick Slab\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Nether Brick Slab\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Quartz Slab\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Upper Stone Slab\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Upper Sandstone Slab\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Upper (Stone) Wooden Slab\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Upper Cobblestone Slab\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Upper Bricks Slab\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Upper Stone Brick Slab\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Upper Nether Brick Slab\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Upper Quartz Slab\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 44,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 45,\n    \"displayName\": \"Bricks\",\n    \"name\": \"brick_block\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 45\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 46,\n    \"displayName\": \"TNT\",\n    \"name\": \"tnt\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Drops a TNT item when broken\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Activates when broken\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 46\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 47,\n    \"displayName\": \"Bookshelf\",\n    \"name\": \"bookshelf\",\n    \"hardness\": 1.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 340,\n        \"minCount\": 3\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 48,\n    \"displayName\": \"Moss Stone\",\n    \"name\": \"mossy_cobblestone\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 48\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 49,\n    \"displayName\": \"Obsidian\",\n    \"name\": \"obsidian\",\n    \"hardness\": 50,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 49\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 50,\n    \"displayName\": \"Torch\",\n    \"name\": \"torch\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Facing east (attached to a block to its west)\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Facing west (attached to a block to its east)\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Facing south (attached to a block to its north)\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Facing north (attached to a block to its south)\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Facing up (attached to a block beneath it)\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 50\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 51,\n    \"displayName\": \"Fire\",\n    \"name\": \"fire\",\n    \"hardness\": 0,\n    \"stackSize\": 0,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 15,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 52,\n    \"displayName\": \"Monster Spawner\",\n    \"name\": \"mob_spawner\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 53,\n    \"displayName\": \"Oak Wood Stairs\",\n    \"name\": \"oak_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 53\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 54,\n    \"displayName\": \"Chest\",\n    \"name\": \"chest\",\n    \"hardness\": 2.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 54\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 55,\n    \"displayName\": \"Redstone Wire\",\n    \"name\": \"redstone_wire\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 331\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 56,\n    \"displayName\": \"Diamond Ore\",\n    \"name\": \"diamond_ore\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 264\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 57,\n    \"displayName\": \"Block of Diamond\",\n    \"name\": \"diamond_block\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 57\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 58,\n    \"displayName\": \"Crafting Table\",\n    \"name\": \"crafting_table\",\n    \"hardness\": 2.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 58\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 59,\n    \"displayName\": \"Wheat\",\n    \"name\": \"wheat\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 295\n      },\n      {\n        \"drop\": 295,\n        \"minCount\": 0,\n        \"maxCount\": 3\n      },\n      {\n        \"drop\": 296\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 60,\n    \"displayName\": \"Farmland\",\n    \"name\": \"farmland\",\n    \"hardness\": 0.6,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 3,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 61,\n    \"displayName\": \"Furnace\",\n    \"name\": \"furnace\",\n    \"hardness\": 3.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 61\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 13,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 62,\n    \"displayName\": \"Burning Furnace\",\n    \"name\": \"lit_furnace\",\n    \"hardness\": 3.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 61\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 13,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 63,\n    \"displayName\": \"Standing Sign\",\n    \"name\": \"standing_sign\",\n    \"hardness\": 1,\n    \"stackSize\": 16,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 323\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 64,\n    \"displayName\": \"Oak Door\",\n    \"name\": \"wooden_door\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 64\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 65,\n    \"displayName\": \"Ladder\",\n    \"name\": \"ladder\",\n    \"hardness\": 0.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 65\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 66,\n    \"displayName\": \"Rail\",\n    \"name\": \"rail\",\n    \"hardness\": 0.7,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"rock\",\n    \"drops\": [\n      {\n        \"drop\": 66\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 67,\n    \"displayName\": \"Cobblestone Stairs\",\n    \"name\": \"stone_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 67\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 68,\n    \"displayName\": \"Wall Sign\",\n    \"name\": \"wall_sign\",\n    \"hardness\": 1,\n    \"stackSize\": 16,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 323\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 69,\n    \"displayName\": \"Lever\",\n    \"name\": \"lever\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"drops\": [\n      {\n        \"drop\": 69\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 70,\n    \"displayName\": \"Stone Pressure Plate\",\n    \"name\": \"stone_pressure_plate\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 70\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 71,\n    \"displayName\": \"Iron Door\",\n    \"name\": \"iron_door\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 71\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 72,\n    \"displayName\": \"Wooden Pressure Plate\",\n    \"name\": \"wooden_pressure_plate\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 72\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 73,\n    \"displayName\": \"Redstone Ore\",\n    \"name\": \"redstone_ore\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 331,\n        \"minCount\": 4,\n        \"maxCount\": 5\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 9,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 74,\n    \"displayName\": \"Glowing Redstone Ore\",\n    \"name\": \"lit_redstone_ore\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 331,\n        \"minCount\": 4,\n        \"maxCount\": 5\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 9,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 75,\n    \"displayName\": \"Redstone Torch (inactive)\",\n    \"name\": \"unlit_redstone_torch\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Facing east (attached to a block to its west)\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Facing west (attached to a block to its east)\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Facing south (attached to a block to its north)\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Facing north (attached to a block to its south)\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Facing up (attached to a block beneath it)\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 75\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 7,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 76,\n    \"displayName\": \"Redstone Torch (active)\",\n    \"name\": \"redstone_torch\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Facing east (attached to a block to its west)\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Facing west (attached to a block to its east)\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Facing south (attached to a block to its north)\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Facing north (attached to a block to its south)\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Facing up (attached to a block beneath it)\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 76\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 7,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 77,\n    \"displayName\": \"Stone Button\",\n    \"name\": \"stone_button\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"rock\",\n    \"drops\": [\n      {\n        \"drop\": 77\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 78,\n    \"displayName\": \"Snow (layer)\",\n    \"name\": \"snow_layer\",\n    \"hardness\": 0.2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"harvestTools\": {\n      \"256\": true,\n      \"269\": true,\n      \"273\": true,\n      \"277\": true,\n      \"284\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 332,\n        \"minCount\": 2,\n        \"maxCount\": 9\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 79,\n    \"displayName\": \"Ice\",\n    \"name\": \"ice\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 80,\n    \"displayName\": \"Snow\",\n    \"name\": \"snow\",\n    \"hardness\": 0.2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"harvestTools\": {\n      \"256\": true,\n      \"269\": true,\n      \"273\": true,\n      \"277\": true,\n      \"284\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 332,\n        \"minCount\": 4\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 81,\n    \"displayName\": \"Cactus\",\n    \"name\": \"cactus\",\n    \"hardness\": 0.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 81\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 82,\n    \"displayName\": \"Clay\",\n    \"name\": \"clay\",\n    \"hardness\": 0.6,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"drops\": [\n      {\n        \"drop\": 337,\n        \"minCount\": 4\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 83,\n    \"displayName\": \"Sugar Cane\",\n    \"name\": \"reeds\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 83\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 84,\n    \"displayName\": \"Jukebox\",\n    \"name\": \"jukebox\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"No disc inserted\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Contains a disc\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 84\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 85,\n    \"displayName\": \"Oak Fence\",\n    \"name\": \"fence\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 85\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 86,\n    \"displayName\": \"Pumpkin\",\n    \"name\": \"pumpkin\",\n    \"hardness\": 1,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 86\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 87,\n    \"displayName\": \"Netherrack\",\n    \"name\": \"netherrack\",\n    \"hardness\": 0.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 87\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 88,\n    \"displayName\": \"Soul Sand\",\n    \"name\": \"soul_sand\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"drops\": [\n      {\n        \"drop\": 88\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 89,\n    \"displayName\": \"Glowstone\",\n    \"name\": \"glowstone\",\n    \"hardness\": 0.3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 348,\n        \"minCount\": 2,\n        \"maxCount\": 4\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 90,\n    \"displayName\": \"Nether Portal\",\n    \"name\": \"portal\",\n    \"hardness\": null,\n    \"stackSize\": 0,\n    \"diggable\": false,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 11,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 91,\n    \"displayName\": \"Jack o'Lantern\",\n    \"name\": \"lit_pumpkin\",\n    \"hardness\": 1,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 91\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 15,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 92,\n    \"displayName\": \"Cake\",\n    \"name\": \"cake\",\n    \"hardness\": 0.5,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 93,\n    \"displayName\": \"Redstone Repeater (inactive)\",\n    \"name\": \"unpowered_repeater\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 356\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 94,\n    \"displayName\": \"Redstone Repeater (active)\",\n    \"name\": \"powered_repeater\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 356\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 95,\n    \"displayName\": \"Stained Glass\",\n    \"name\": \"stained_glass\",\n    \"hardness\": 0.3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"White Stained Glass\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Orange Stained Glass\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Magenta Stained Glass\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Light Blue Stained Glass\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Yellow Stained Glass\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Lime Stained Glass\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Pink Stained Glass\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Gray Stained Glass\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Light Gray Stained Glass\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Cyan Stained Glass\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Purple Stained Glass\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Blue Stained Glass\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Brown Stained Glass\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Green Stained Glass\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Red Stained Glass\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Black Stained Glass\"\n      }\n    ],\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 96,\n    \"displayName\": \"Trapdoor\",\n    \"name\": \"trapdoor\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 96\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 97,\n    \"displayName\": \"Monster Egg\",\n    \"name\": \"monster_egg\",\n    \"hardness\": 0.75,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Stone Monster Egg\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Cobblestone Monster Egg\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Stone Brick Monster Egg\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Mossy Stone Brick Monster Egg\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Cracked Stone Brick Monster Egg\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Chiseled Stone Brick Monster Egg\"\n      }\n    ],\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 98,\n    \"displayName\": \"Stone Bricks\",\n    \"name\": \"stonebrick\",\n    \"hardness\": 1.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Stone brick\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Mossy stone brick\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Cracked stone brick\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Chiseled stone brick\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 98\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 99,\n    \"displayName\": \"Brown Mushroom (block)\",\n    \"name\": \"brown_mushroom_block\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Pores on all sides\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Cap texture on top, west and north\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Cap texture on top and north\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Cap texture on top, north and east\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Cap texture on top and west\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Cap texture on top\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Cap texture on top and east\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Cap texture on top, south and west\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Cap texture on top and south\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Cap texture on top, east and south\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Stem texture on all four sides, pores on top and bottom\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Cap texture on all six sides\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Stem texture on all six sides\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 40,\n        \"minCount\": 0,\n        \"maxCount\": 2\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 100,\n    \"displayName\": \"Red Mushroom (block)\",\n    \"name\": \"red_mushroom_block\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Pores on all sides\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Cap texture on top, west and north\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Cap texture on top and north\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Cap texture on top, north and east\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Cap texture on top and west\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Cap texture on top\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Cap texture on top and east\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Cap texture on top, south and west\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Cap texture on top and south\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Cap texture on top, east and south\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Stem texture on all four sides, pores on top and bottom\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Cap texture on all six sides\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Stem texture on all six sides\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 40,\n        \"minCount\": 0,\n        \"maxCount\": 2\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 101,\n    \"displayName\": \"Iron Bars\",\n    \"name\": \"iron_bars\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 101\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 102,\n    \"displayName\": \"Glass Pane\",\n    \"name\": \"glass_pane\",\n    \"hardness\": 0.3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 103,\n    \"displayName\": \"Melon\",\n    \"name\": \"melon_block\",\n    \"hardness\": 1,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 360,\n        \"minCount\": 3,\n        \"maxCount\": 7\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 104,\n    \"displayName\": \"Pumpkin Stem\",\n    \"name\": \"pumpkin_stem\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 361,\n        \"minCount\": 0,\n        \"maxCount\": 3\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 105,\n    \"displayName\": \"Melon Stem\",\n    \"name\": \"melon_stem\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 362,\n        \"minCount\": 0,\n        \"maxCount\": 3\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 106,\n    \"displayName\": \"Vines\",\n    \"name\": \"vine\",\n    \"hardness\": 0.2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 106\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 107,\n    \"displayName\": \"Fence Gate\",\n    \"name\": \"fence_gate\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 107\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 108,\n    \"displayName\": \"Brick Stairs\",\n    \"name\": \"brick_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 108\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 109,\n    \"displayName\": \"Stone Brick Stairs\",\n    \"name\": \"stone_brick_stairs\",\n    \"hardness\": 1.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 109\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 110,\n    \"displayName\": \"Mycelium\",\n    \"name\": \"mycelium\",\n    \"hardness\": 0.6,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 3,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 111,\n    \"displayName\": \"Lily Pad\",\n    \"name\": \"waterlily\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 111\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 112,\n    \"displayName\": \"Nether Brick\",\n    \"name\": \"nether_brick\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 112\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 113,\n    \"displayName\": \"Nether Brick Fence\",\n    \"name\": \"nether_brick_fence\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 113\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 114,\n    \"displayName\": \"Nether Brick Stairs\",\n    \"name\": \"nether_brick_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 114\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 115,\n    \"displayName\": \"Nether Wart\",\n    \"name\": \"nether_wart\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 372\n      },\n      {\n        \"drop\": 372,\n        \"minCount\": 2,\n        \"maxCount\": 4\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 116,\n    \"displayName\": \"Enchantment Table\",\n    \"name\": \"enchanting_table\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 116\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 117,\n    \"displayName\": \"Brewing Stand\",\n    \"name\": \"brewing_stand\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 379\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 1,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 118,\n    \"displayName\": \"Cauldron\",\n    \"name\": \"cauldron\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 380\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 119,\n    \"displayName\": \"End Portal\",\n    \"name\": \"end_portal\",\n    \"hardness\": null,\n    \"stackSize\": 0,\n    \"diggable\": false,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 15,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 120,\n    \"displayName\": \"End Portal Frame\",\n    \"name\": \"end_portal_frame\",\n    \"hardness\": null,\n    \"stackSize\": 64,\n    \"diggable\": false,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 120\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 1,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 121,\n    \"displayName\": \"End Stone\",\n    \"name\": \"end_stone\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 121\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 122,\n    \"displayName\": \"Dragon Egg\",\n    \"name\": \"dragon_egg\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 122\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 123,\n    \"displayName\": \"Redstone Lamp (inactive)\",\n    \"name\": \"redstone_lamp\",\n    \"hardness\": 0.3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 123\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 15,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 124,\n    \"displayName\": \"Redstone Lamp (active)\",\n    \"name\": \"lit_redstone_lamp\",\n    \"hardness\": 0.3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 124\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 15,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 125,\n    \"displayName\": \"Double Wooden Slab\",\n    \"name\": \"double_wooden_slab\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Double Oak Wood Slab\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Double Spruce Wood Slab\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Double Birch Wood Slab\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Double Jungle Wood Slab\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Double Acacia Wood Slab\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Double Dark Oak Wood Slab\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 44,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 126,\n    \"displayName\": \"Wooden Slab\",\n    \"name\": \"wooden_slab\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Oak Wood Slab\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Spruce Wood Slab\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Birch Wood Slab\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Jungle Wood Slab\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Acacia Wood Slab\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Dark Oak Wood Slab\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Upper Oak Wood Slab\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Upper Spruce Wood Slab\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Upper Birch Wood Slab\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Upper Jungle Wood Slab\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Upper Acacia Wood Slab\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Upper Dark Oak Wood Slab\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 44,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 127,\n    \"displayName\": \"Cocoa\",\n    \"name\": \"cocoa\",\n    \"hardness\": 0.2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 351,\n          \"metadata\": 3\n        }\n      },\n      {\n        \"drop\": {\n          \"id\": 351,\n          \"metadata\": 3\n        },\n        \"minCount\": 3\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 128,\n    \"displayName\": \"Sandstone Stairs\",\n    \"name\": \"sandstone_stairs\",\n    \"hardness\": 0.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 128\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 129,\n    \"displayName\": \"Emerald Ore\",\n    \"name\": \"emerald_ore\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 388\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 130,\n    \"displayName\": \"Ender Chest\",\n    \"name\": \"ender_chest\",\n    \"hardness\": 22.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 49,\n        \"minCount\": 8\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 131,\n    \"displayName\": \"Tripwire Hook\",\n    \"name\": \"tripwire_hook\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"drops\": [\n      {\n        \"drop\": 131\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 132,\n    \"displayName\": \"Tripwire\",\n    \"name\": \"tripwire\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"drops\": [\n      {\n        \"drop\": 287\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 133,\n    \"displayName\": \"Block of Emerald\",\n    \"name\": \"emerald_block\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 133\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 134,\n    \"displayName\": \"Spruce Wood Stairs\",\n    \"name\": \"spruce_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 134\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 135,\n    \"displayName\": \"Birch Wood Stairs\",\n    \"name\": \"birch_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 135\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 136,\n    \"displayName\": \"Jungle Wood Stairs\",\n    \"name\": \"jungle_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 136\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 137,\n    \"displayName\": \"Command Block\",\n    \"name\": \"command_block\",\n    \"hardness\": null,\n    \"stackSize\": 64,\n    \"diggable\": false,\n    \"boundingBox\": \"block\",\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 138,\n    \"displayName\": \"Beacon\",\n    \"name\": \"beacon\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 138\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 15,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 139,\n    \"displayName\": \"Cobblestone Wall\",\n    \"name\": \"cobblestone_wall\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Cobblestone Wall\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Mossy Cobblestone Wall\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 139\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 140,\n    \"displayName\": \"Flower Pot\",\n    \"name\": \"flower_pot\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 140\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 141,\n    \"displayName\": \"Carrot\",\n    \"name\": \"carrots\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 141\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 142,\n    \"displayName\": \"Potato\",\n    \"name\": \"potatoes\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 142\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 143,\n    \"displayName\": \"Wooden Button\",\n    \"name\": \"wooden_button\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 143\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 144,\n    \"displayName\": \"Mob head\",\n    \"name\": \"skull\",\n    \"hardness\": 1,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Skeleton Skull\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Wither Skeleton Skull\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Zombie Head\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Head\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Creeper Head\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 144\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 145,\n    \"displayName\": \"Anvil\",\n    \"name\": \"anvil\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Anvil\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Slightly Damaged Anvil\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Very Damaged Anvil\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Anvil (North/South)\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Anvil (East/West)\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Anvil (South/North)\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Anvil (West/East)\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Slightly Damaged Anvil (North/South)\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Slightly Damaged Anvil (East/West)\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Slightly Damaged Anvil (West/East)\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Slightly Damaged Anvil (South/North)\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Very Damaged Anvil (North/South)\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Very Damaged Anvil (East/West)\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Very Damaged Anvil (West/East)\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Very Damaged Anvil (South/North)\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 145\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 146,\n    \"displayName\": \"Trapped Chest\",\n    \"name\": \"trapped_chest\",\n    \"hardness\": 2.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 146\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 147,\n    \"displayName\": \"Weighted Pressure Plate (Light)\",\n    \"name\": \"light_weighted_pressure_plate\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 147\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 148,\n    \"displayName\": \"Weighted Pressure Plate (Heavy)\",\n    \"name\": \"heavy_weighted_pressure_plate\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 148\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 149,\n    \"displayName\": \"Redstone Comparator\",\n    \"name\": \"unpowered_comparator\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 404\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 150,\n    \"displayName\": \"Redstone Comparator (deprecated)\",\n    \"name\": \"powered_comparator\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 404\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 151,\n    \"displayName\": \"Daylight Sensor\",\n    \"name\": \"daylight_detector\",\n    \"hardness\": 0.2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 151\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 152,\n    \"displayName\": \"Block of Redstone\",\n    \"name\": \"redstone_block\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 152\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 153,\n    \"displayName\": \"Nether Quartz Ore\",\n    \"name\": \"quartz_ore\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 406\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 154,\n    \"displayName\": \"Hopper\",\n    \"name\": \"hopper\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 154\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 155,\n    \"displayName\": \"Block of Quartz\",\n    \"name\": \"quartz_block\",\n    \"hardness\": 0.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Block of Quartz\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Chiseled Quartz Block\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Pillar Quartz Block (vertical)\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Pillar Quartz Block (north-south)\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Pillar Quartz Block (east-west)\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 155\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 156,\n    \"displayName\": \"Quartz Stairs\",\n    \"name\": \"quartz_stairs\",\n    \"hardness\": 0.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 156\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 157,\n    \"displayName\": \"Activator Rail\",\n    \"name\": \"activator_rail\",\n    \"hardness\": 0.7,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"rock\",\n    \"drops\": [\n      {\n        \"drop\": 157\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 158,\n    \"displayName\": \"Dropper\",\n    \"name\": \"dropper\",\n    \"hardness\": 3.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 158\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 159,\n    \"displayName\": \"Stained Clay\",\n    \"name\": \"stained_hardened_clay\",\n    \"hardness\": 1.25,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"White hardened clay\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Orange hardened clay\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Magenta hardened clay\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Light blue hardened clay\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Yellow hardened clay\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Lime hardened clay\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Pink hardened clay\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Gray hardened clay\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Light gray hardened clay\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Cyan hardened clay\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Purple hardened clay\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Blue hardened clay\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Brown hardened clay\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Green hardened clay\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Red hardened clay\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Black hardened clay\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 159\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 160,\n    \"displayName\": \"Stained Glass Pane\",\n    \"name\": \"stained_glass_pane\",\n    \"hardness\": 0.3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"White Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Orange Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Magenta Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Light Blue Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Yellow Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Lime Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Pink Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Gray Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Light Gray Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Cyan Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Purple Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Blue Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Brown Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Green Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Red Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Black Stained Glass Pane\"\n      }\n    ],\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 161,\n    \"displayName\": \"Leaves (Acacia/Dark Oak)\",\n    \"name\": \"leaves2\",\n    \"hardness\": 0.2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Acacia Leaves\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Dark Oak Leaves\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Acacia Leaves (no decay)\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Dark Oak Leaves (no decay)\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Acacia Leaves (check decay)\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Dark Oak Leaves (check decay)\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Acacia Leaves (no decay and check decay)\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Dark Oak Leaves (no decay and check decay)\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 6,\n        \"minCount\": 0,\n        \"maxCount\": 1\n      },\n      {\n        \"drop\": 260,\n        \"minCount\": 0,\n        \"maxCount\": 1\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 162,\n    \"displayName\": \"Wood (Acacia/Dark Oak)\",\n    \"name\": \"log2\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 162\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 163,\n    \"displayName\": \"Acacia Wood Stairs\",\n    \"name\": \"acacia_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 163\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 164,\n    \"displayName\": \"Dark Oak Wood Stairs\",\n    \"name\": \"dark_oak_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 164\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 165,\n    \"displayName\": \"Slime Block\",\n    \"name\": \"slime\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 165\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 166,\n    \"displayName\": \"Barrier\",\n    \"name\": \"barrier\",\n    \"hardness\": null,\n    \"stackSize\": 64,\n    \"diggable\": false,\n    \"boundingBox\": \"block\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 167,\n    \"displayName\": \"Iron Trapdoor\",\n    \"name\": \"iron_trapdoor\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 167\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 168,\n    \"displayName\": \"Prismarine\",\n    \"name\": \"prismarine\",\n    \"hardness\": 1.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Prismarine\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Prismarine Bricks\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Dark Prismarine\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 168\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 169,\n    \"displayName\": \"Sea Lantern\",\n    \"name\": \"sea_lantern\",\n    \"hardness\": 0.3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 410,\n        \"minCount\": 2,\n        \"maxCount\": 3\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 170,\n    \"displayName\": \"Hay Bale\",\n    \"name\": \"hay_block\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 170\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 171,\n    \"displayName\": \"Carpet\",\n    \"name\": \"carpet\",\n    \"hardness\": 0.1,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"White Carpet\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Orange Carpet\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Magenta Carpet\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Light Blue Carpet\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Yellow Carpet\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Lime Carpet\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Pink Carpet\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Gray Carpet\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Light Gray Carpet\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Cyan Carpet\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Purple Carpet\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Blue Carpet\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Brown Carpet\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Green Carpet\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Red Carpet\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Black Carpet\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 171\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 172,\n    \"displayName\": \"Hardened Clay\",\n    \"name\": \"hardened_clay\",\n    \"hardness\": 1.25,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"White hardened clay\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Orange hardened clay\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Magenta hardened clay\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Light blue hardened clay\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Yellow hardened clay\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Lime hardened clay\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Pink hardened clay\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Gray hardened clay\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Light gray hardened clay\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Cyan hardened clay\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Purple hardened clay\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Blue hardened clay\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Brown hardened clay\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Green hardened clay\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Red hardened clay\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Black hardened clay\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 172\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 173,\n    \"displayName\": \"Block of Coal\",\n    \"name\": \"coal_block\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 173\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 174,\n    \"displayName\": \"Packed Ice\",\n    \"name\": \"packed_ice\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 175,\n    \"displayName\": \"Large Flowers\",\n    \"name\": \"double_plant\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Sunflower\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Lilac\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Double Tallgrass\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Large Fern\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Rose Bush\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Peony\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Top Half of any Large Plant; low three bits 0x7 are derived from the block below.\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 175\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 176,\n    \"displayName\": \"Standing Banner\",\n    \"name\": \"standing_banner\",\n    \"hardness\": 1,\n    \"stackSize\": 16,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 176\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 177,\n    \"displayName\": \"Wall Banner\",\n    \"name\": \"wall_banner\",\n    \"hardness\": 1,\n    \"stackSize\": 16,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 177\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 178,\n    \"displayName\": \"Inverted Daylight Sensor\",\n    \"name\": \"daylight_detector_inverted\",\n    \"hardness\": 0.2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 178\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 179,\n    \"displayName\": \"Red Sandstone\",\n    \"name\": \"red_sandstone\",\n    \"hardness\": 0.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Red Sandstone\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Chiseled Red Sandstone\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Smooth Red Sandstone\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 179\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 180,\n    \"displayName\": \"Red Sandstone Stairs\",\n    \"name\": \"red_sandstone_stairs\",\n    \"hardness\": 0.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 180\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 181,\n    \"displayName\": \"Double Red Sandstone Slab\",\n    \"name\": \"double_stone_slab2\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Double Red Sandstone Slab\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Smooth Double Red Sandstone Slab\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 44,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 182,\n    \"displayName\": \"Red Sandstone Slab\",\n    \"name\": \"stone_slab2\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Red Sandstone Slab\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Upper Red Sandstone Slab\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 44,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 183,\n    \"displayName\": \"Spruce Fence Gate\",\n    \"name\": \"spruce_fence_gate\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 183\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 184,\n    \"displayName\": \"Birch Fence Gate\",\n    \"name\": \"birch_fence_gate\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 184\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 185,\n    \"displayName\": \"Jungle Fence Gate\",\n    \"name\": \"jungle_fence_gate\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 185\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 186,\n    \"displayName\": \"Dark Oak Fence Gate\",\n    \"name\": \"dark_oak_fence_gate\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 186\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 187,\n    \"displayName\": \"Acacia Fence Gate\",\n    \"name\": \"acacia_fence_gate\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 187\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 188,\n    \"displayName\": \"Spruce Fence\",\n    \"name\": \"spruce_fence\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 188\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 189,\n    \"displayName\": \"Birch Fence\",\n    \"name\": \"birch_fence\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 189\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 190,\n    \"displayName\": \"Jungle Fence\",\n    \"name\": \"jungle_fence\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 190\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 191,\n    \"displayName\": \"Dark Oak Fence\",\n    \"name\": \"dark_oak_fence\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 191\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 192,\n    \"displayName\": \"Acacia Fence\",\n    \"name\": \"acacia_fence\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 192\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 193,\n    \"displayName\": \"Spruce Door\",\n    \"name\": \"spruce_door\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 193\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 194,\n    \"displayName\": \"Birch Door\",\n    \"name\": \"birch_door\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 194\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 195,\n    \"displayName\": \"Jungle Door\",\n    \"name\": \"jungle_door\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 195\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 196,\n    \"displayName\": \"Acacia Door\",\n    \"name\": \"acacia_door\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 196\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 197,\n    \"displayName\": \"Dark Oak Door\",\n    \"name\": \"dark_oak_door\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 197\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 198,\n    \"displayName\": \"End Rod\",\n    \"name\": \"end_rod\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Facing down\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Facing up\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Facing north\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Facing south\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Facing west\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Facing east\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 198\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 14,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 199,\n    \"displayName\": \"Chorus Plant\",\n    \"name\": \"chorus_plant\",\n    \"hardness\": 0.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 432,\n        \"minCount\": 0,\n        \"maxCount\": 1\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 200,\n    \"displayName\": \"Chorus Flower\",\n    \"name\": \"chorus_flower\",\n    \"hardness\": 0.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 200\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 201,\n    \"displayName\": \"Purpur Block\",\n    \"name\": \"purpur_block\",\n    \"hardness\": 1.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 201\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 202,\n    \"displayName\": \"Purpur Pillar\",\n    \"name\": \"purpur_pillar\",\n    \"hardness\": 1.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 202\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 203,\n    \"displayName\": \"Purpur Stairs\",\n    \"name\": \"purpur_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 203\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 204,\n    \"displayName\": \"Purpur Double Slab\",\n    \"name\": \"purpur_double_slab\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 44,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 205,\n    \"displayName\": \"Purpur Slab\",\n    \"name\": \"purpur_slab\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 44,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 206,\n    \"displayName\": \"End Stone Bricks\",\n    \"name\": \"end_bricks\",\n    \"hardness\": 0.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 206\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 207,\n    \"displayName\": \"Beetroot Seeds\",\n    \"name\": \"beetroots\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 208,\n    \"displayName\": \"Grass Path\",\n    \"name\": \"grass_path\",\n    \"hardness\": 0.6,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 209,\n    \"displayName\": \"End Gateway\",\n    \"name\": \"end_gateway\",\n    \"hardness\": null,\n    \"stackSize\": 0,\n    \"diggable\": false,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 15,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 210,\n    \"displayName\": \"Repeating Command Block\",\n    \"name\": \"repeating_command_block\",\n    \"hardness\": null,\n    \"stackSize\": 64,\n    \"diggable\": false,\n    \"boundingBox\": \"block\",\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 211,\n    \"displayName\": \"Chain Command Block\",\n    \"name\": \"chain_command_block\",\n    \"hardness\": null,\n    \"stackSize\": 64,\n    \"diggable\": false,\n    \"boundingBox\": \"block\",\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 212,\n    \"displayName\": \"Frosted Ice\",\n    \"name\": \"frosted_ice\",\n    \"hardness\": 0.5,\n    \"stackSize\": 0,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 2\n  },\n  {\n    \"id\": 213,\n    \"displayName\": \"Magma Block\",\n    \"name\": \"magma\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 213\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 214,\n    \"displayName\": \"Nether Wart Block\",\n    \"name\": \"nether_wart_block\",\n    \"hardness\": 1,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 214\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 215,\n    \"displayName\": \"Red Nether Brick\",\n    \"name\": \"red_nether_brick\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 215\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 216,\n    \"displayName\": \"Bone Block\",\n    \"name\": \"bone_block\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 216\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 217,\n    \"displayName\": \"Structure Void\",\n    \"name\": \"structure_void\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Save\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Load\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Corner\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Data\"\n      }\n    ],\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 218,\n    \"displayName\": \"Observer\",\n    \"name\": \"observer\",\n    \"hardness\": 3.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 218\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 219,\n    \"displayName\": \"White Shulker Box\",\n    \"name\": \"white_shulker_box\",\n    \"hardness\": 6,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 219\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 220,\n    \"displayName\": \"Orange Shulker Box\",\n    \"name\": \"orange_shulker_box\",\n    \"hardness\": 6,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 220\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 221,\n    \"displayName\": \"Magenta Shulker Box\",\n    \"name\": \"magenta_shulker_box\",\n    \"hardness\": 6,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 221\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 222,\n    \"displayName\": \"Light Blue Shulker Box\",\n    \"name\": \"light_blue_shulker_box\",\n    \"hardness\": 6,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 222\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 223,\n    \"displayName\": \"Yellow Shulker Box\",\n    \"name\": \"yellow_shulker_box\",\n    \"hardness\": 6,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 223\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 224,\n    \"displayName\": \"Lime Shulker Box\",\n    \"name\": \"lime_shulker_box\",\n    \"hardness\": 6,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 224\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 225,\n    \"displayName\": \"Pink Shulker Box\",\n    \"name\": \"pink_shulker_box\",\n    \"hardness\": 6,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 225\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 226,\n    \"displayName\": \"Gray Shulker Box\",\n    \"name\": \"gray_shulker_box\",\n    \"hardness\": 6,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 226\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 227,\n    \"displayName\": \"Light Gray Shulker Box\",\n    \"name\": \"light_gray_shulker_box\",\n    \"hardness\": 6,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 227\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 228,\n    \"displayName\": \"Cyan Shulker Box\",\n    \"name\": \"cyan_shulker_box\",\n    \"hardness\": 6,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 228\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 229,\n    \"displayName\": \"Purple Shulker Box\",\n    \"name\": \"purple_shulker_box\",\n    \"hardness\": 6,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 229\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 230,\n    \"displayName\": \"Blue Shulker Box\",\n    \"name\": \"blue_shulker_box\",\n    \"hardness\": 6,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 230\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 231,\n    \"displayName\": \"Brown Shulker Box\",\n    \"name\": \"brown_shulker_box\",\n    \"hardness\": 6,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 231\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 232,\n    \"displayName\": \"Green Shulker Box\",\n    \"name\": \"green_shulker_box\",\n    \"hardness\": 6,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 232\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 233,\n    \"displayName\": \"Red Shulker Box\",\n    \"name\": \"red_shulker_box\",\n    \"hardness\": 6,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 233\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 234,\n    \"displayName\": \"Black Shulker Box\",\n    \"name\": \"black_shulker_box\",\n    \"hardness\": 6,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 234\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 235,\n    \"displayName\": \"White Glazed Terracotta\",\n    \"name\": \"white_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 235\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 236,\n    \"displayName\": \"Orange Glazed Terracotta\",\n    \"name\": \"orange_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 236\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 237,\n    \"displayName\": \"Magenta Glazed Terracotta\",\n    \"name\": \"magenta_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 237\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 238,\n    \"displayName\": \"Light Blue Glazed Terracotta\",\n    \"name\": \"light_blue_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 238\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 239,\n    \"displayName\": \"Yellow Glazed Terracotta\",\n    \"name\": \"yellow_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 239\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 240,\n    \"displayName\": \"Lime Glazed Terracotta\",\n    \"name\": \"lime_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 240\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 241,\n    \"displayName\": \"Pink Glazed Terracotta\",\n    \"name\": \"pink_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 241\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 242,\n    \"displayName\": \"Gray Glazed Terracotta\",\n    \"name\": \"gray_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 242\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 243,\n    \"displayName\": \"Light Gray Glazed Terracotta\",\n    \"name\": \"light_gray_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 243\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 244,\n    \"displayName\": \"Cyan Glazed Terracotta\",\n    \"name\": \"cyan_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 244\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 245,\n    \"displayName\": \"Purple Glazed Terracotta\",\n    \"name\": \"purple_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 245\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 246,\n    \"displayName\": \"Blue Glazed Terracotta\",\n    \"name\": \"blue_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 246\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 247,\n    \"displayName\": \"Brown Glazed Terracotta\",\n    \"name\": \"brown_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 247\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 248,\n    \"displayName\": \"Green Glazed Terracotta\",\n    \"name\": \"green_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 248\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 249,\n    \"displayName\": \"Red Glazed Terracotta\",\n    \"name\": \"red_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 249\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 250,\n    \"displayName\": \"Black Glazed Terracotta\",\n    \"name\": \"black_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 250\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 251,\n    \"displayName\": \"Concrete\",\n    \"name\": \"concrete\",\n    \"hardness\": 1.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 251\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 252,\n    \"displayName\": \"Concrete Powder\",\n    \"name\": \"concrete_powder\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"drops\": [\n      {\n        \"drop\": 252\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 255,\n    \"displayName\": \"Structure Block\",\n    \"name\": \"structure_block\",\n    \"hardness\": null,\n    \"stackSize\": 64,\n    \"diggable\": false,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Save\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Load\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Corner\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Data\"\n      }\n    ],\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  }\n]"
+var _Assets7c11deca5d55bf71edf4e2001372e9bf41d182cf = "[\n  {\n    \"id\": 0,\n    \"displayName\": \"Air\",\n    \"name\": \"air\",\n    \"hardness\": 0,\n    \"stackSize\": 0,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"drops\": [\n      {\n        \"drop\": 0\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 1,\n    \"displayName\": \"Stone\",\n    \"name\": \"stone\",\n    \"hardness\": 1.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 4\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 2,\n    \"displayName\": \"Grass Block\",\n    \"name\": \"grass\",\n    \"hardness\": 0.6,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 3,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 3,\n    \"displayName\": \"Dirt\",\n    \"name\": \"dirt\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Dirt\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Coarse Dirt\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Podzol\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 3\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 4,\n    \"displayName\": \"Cobblestone\",\n    \"name\": \"cobblestone\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 4\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 5,\n    \"displayName\": \"Wood Planks\",\n    \"name\": \"planks\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Oak Wood Planks\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Spruce Wood Planks\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Birch Wood Planks\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Jungle Wood Planks\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Acacia Wood Planks\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Dark Oak Wood Planks\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 5\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 6,\n    \"displayName\": \"Sapling\",\n    \"name\": \"sapling\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 6\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 7,\n    \"displayName\": \"Bedrock\",\n    \"name\": \"bedrock\",\n    \"hardness\": null,\n    \"stackSize\": 64,\n    \"diggable\": false,\n    \"boundingBox\": \"block\",\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 8,\n    \"displayName\": \"Water\",\n    \"name\": \"flowing_water\",\n    \"hardness\": 100,\n    \"stackSize\": 0,\n    \"diggable\": false,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 2\n  },\n  {\n    \"id\": 9,\n    \"displayName\": \"Stationary Water\",\n    \"name\": \"water\",\n    \"hardness\": 100,\n    \"stackSize\": 0,\n    \"diggable\": false,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 2\n  },\n  {\n    \"id\": 10,\n    \"displayName\": \"Lava\",\n    \"name\": \"flowing_lava\",\n    \"hardness\": 100,\n    \"stackSize\": 0,\n    \"diggable\": false,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 15,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 11,\n    \"displayName\": \"Stationary Lava\",\n    \"name\": \"lava\",\n    \"hardness\": 100,\n    \"stackSize\": 0,\n    \"diggable\": false,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 15,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 12,\n    \"displayName\": \"Sand\",\n    \"name\": \"sand\",\n    \"hardness\": 0.5,\n    \"stackSize\": 0,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Sand\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Red sand\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 12\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 13,\n    \"displayName\": \"Gravel\",\n    \"name\": \"gravel\",\n    \"hardness\": 0.6,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"drops\": [\n      {\n        \"drop\": 13,\n        \"minCount\": 0.9\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 14,\n    \"displayName\": \"Gold Ore\",\n    \"name\": \"gold_ore\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 14\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 15,\n    \"displayName\": \"Iron Ore\",\n    \"name\": \"iron_ore\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"274\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 15\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 16,\n    \"displayName\": \"Coal Ore\",\n    \"name\": \"coal_ore\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 263,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 17,\n    \"displayName\": \"Wood\",\n    \"name\": \"log\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 17\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 18,\n    \"displayName\": \"Leaves\",\n    \"name\": \"leaves\",\n    \"hardness\": 0.2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Oak Leaves\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Spruce Leaves\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Birch Leaves\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Jungle Leaves\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Oak Leaves (no decay)\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Spruce Leaves (no decay)\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Birch Leaves (no decay)\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Jungle Leaves (no decay)\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Oak Leaves (check decay)\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Spruce Leaves (check decay)\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Birch Leaves (check decay)\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Jungle Leaves (check decay)\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Oak Leaves (no decay and check decay)\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Spruce Leaves (no decay and check decay)\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Birch Leaves (no decay and check decay)\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Jungle Leaves (no decay and check decay)\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 6,\n        \"minCount\": 0,\n        \"maxCount\": 1\n      },\n      {\n        \"drop\": 260,\n        \"minCount\": 0,\n        \"maxCount\": 1\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 19,\n    \"displayName\": \"Sponge\",\n    \"name\": \"sponge\",\n    \"hardness\": 0.6,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Sponge\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Wet Sponge\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 19\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 20,\n    \"displayName\": \"Glass\",\n    \"name\": \"glass\",\n    \"hardness\": 0.3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"White Stained Glass\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Orange Stained Glass\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Magenta Stained Glass\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Light Blue Stained Glass\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Yellow Stained Glass\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Lime Stained Glass\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Pink Stained Glass\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Gray Stained Glass\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Light Gray Stained Glass\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Cyan Stained Glass\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Purple Stained Glass\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Blue Stained Glass\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Brown Stained Glass\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Green Stained Glass\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Red Stained Glass\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Black Stained Glass\"\n      }\n    ],\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 21,\n    \"displayName\": \"Lapis Lazuli Ore\",\n    \"name\": \"lapis_ore\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"274\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 351,\n          \"metadata\": 4\n        },\n        \"minCount\": 4,\n        \"maxCount\": 8\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 22,\n    \"displayName\": \"Lapis Lazuli Block\",\n    \"name\": \"lapis_block\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"274\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 22\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 23,\n    \"displayName\": \"Dispenser\",\n    \"name\": \"dispenser\",\n    \"hardness\": 3.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 23\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 24,\n    \"displayName\": \"Sandstone\",\n    \"name\": \"sandstone\",\n    \"hardness\": 0.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Sandstone\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Chiseled sandstone\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Smooth sandstone\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 24\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 25,\n    \"displayName\": \"Note Block\",\n    \"name\": \"noteblock\",\n    \"hardness\": 0.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 25\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 26,\n    \"displayName\": \"Bed\",\n    \"name\": \"bed\",\n    \"hardness\": 0.2,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 26\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 27,\n    \"displayName\": \"Powered Rail\",\n    \"name\": \"golden_rail\",\n    \"hardness\": 0.7,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"rock\",\n    \"drops\": [\n      {\n        \"drop\": 27\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 28,\n    \"displayName\": \"Detector Rail\",\n    \"name\": \"detector_rail\",\n    \"hardness\": 0.7,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"rock\",\n    \"drops\": [\n      {\n        \"drop\": 28\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 29,\n    \"displayName\": \"Sticky Piston\",\n    \"name\": \"sticky_piston\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 29\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 30,\n    \"displayName\": \"Cobweb\",\n    \"name\": \"web\",\n    \"hardness\": 4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"web\",\n    \"harvestTools\": {\n      \"267\": true,\n      \"268\": true,\n      \"272\": true,\n      \"276\": true,\n      \"283\": true,\n      \"359\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 287\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 31,\n    \"displayName\": \"Grass\",\n    \"name\": \"tallgrass\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Shrub\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Tall Grass\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Fern\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 295,\n        \"minCount\": 0,\n        \"maxCount\": 1\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 32,\n    \"displayName\": \"Dead Bush\",\n    \"name\": \"deadbush\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 280,\n        \"minCount\": 0,\n        \"maxCount\": 2\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 33,\n    \"displayName\": \"Piston\",\n    \"name\": \"piston\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 33\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 34,\n    \"displayName\": \"Piston Head\",\n    \"name\": \"pistonArmCollision\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 34\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 35,\n    \"displayName\": \"Wool\",\n    \"name\": \"wool\",\n    \"hardness\": 0.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wool\",\n    \"drops\": [\n      {\n        \"drop\": 35\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 37,\n    \"displayName\": \"Dandelion\",\n    \"name\": \"yellow_flower\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 37\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 38,\n    \"displayName\": \"Poppy\",\n    \"name\": \"red_flower\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Poppy\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Blue Orchid\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Allium\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Azure Bluet\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Red Tulip\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Orange Tulip\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"White Tulip\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Pink Tulip\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Oxeye Daisy\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 38\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 39,\n    \"displayName\": \"Brown Mushroom\",\n    \"name\": \"brown_mushroom\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 39\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 1,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 40,\n    \"displayName\": \"Red Mushroom\",\n    \"name\": \"red_mushroom\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 40\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 1,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 41,\n    \"displayName\": \"Block of Gold\",\n    \"name\": \"gold_block\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 41\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 42,\n    \"displayName\": \"Block of Iron\",\n    \"name\": \"iron_block\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"274\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 42\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 43,\n    \"displayName\": \"Double Stone Slab\",\n    \"name\": \"double_stone_slab\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Double Stone Slab\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Double Sandstone Slab\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Double (Stone) Wooden Slab\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Double Cobblestone Slab\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Double Bricks Slab\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Double Stone Brick Slab\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Double Nether Brick Slab\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Double Quartz Slab\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Smooth Double Stone Slab\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Smooth Double Sandstone Slab\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Tile Double Quartz Slab (note the underside)\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 44,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 44,\n    \"displayName\": \"Stone Slab\",\n    \"name\": \"stone_slab\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Stone Slab\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Sandstone Slab\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"(Stone) Wooden Slab\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Cobblestone Slab\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Bricks Slab\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Stone Brick Slab\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Nether Brick Slab\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Quartz Slab\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Upper Stone Slab\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Upper Sandstone Slab\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Upper (Stone) Wooden Slab\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Upper Cobblestone Slab\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Upper Bricks Slab\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Upper Stone Brick Slab\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Upper Nether Brick Slab\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Upper Quartz Slab\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 44,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 45,\n    \"displayName\": \"Bricks\",\n    \"name\": \"brick_block\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 45\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 46,\n    \"displayName\": \"TNT\",\n    \"name\": \"tnt\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Drops a TNT item when broken\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Activates when broken\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 46\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 47,\n    \"displayName\": \"Bookshelf\",\n    \"name\": \"bookshelf\",\n    \"hardness\": 1.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 340,\n        \"minCount\": 3\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 48,\n    \"displayName\": \"Moss Stone\",\n    \"name\": \"mossy_cobblestone\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 48\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 49,\n    \"displayName\": \"Obsidian\",\n    \"name\": \"obsidian\",\n    \"hardness\": 50,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 49\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 50,\n    \"displayName\": \"Torch\",\n    \"name\": \"torch\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Facing east (attached to a block to its west)\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Facing west (attached to a block to its east)\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Facing south (attached to a block to its north)\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Facing north (attached to a block to its south)\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Facing up (attached to a block beneath it)\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 50\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 51,\n    \"displayName\": \"Fire\",\n    \"name\": \"fire\",\n    \"hardness\": 0,\n    \"stackSize\": 0,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 15,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 52,\n    \"displayName\": \"Monster Spawner\",\n    \"name\": \"mob_spawner\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 53,\n    \"displayName\": \"Oak Wood Stairs\",\n    \"name\": \"oak_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 53\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 54,\n    \"displayName\": \"Chest\",\n    \"name\": \"chest\",\n    \"hardness\": 2.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 54\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 55,\n    \"displayName\": \"Redstone Wire\",\n    \"name\": \"redstone_wire\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 331\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 56,\n    \"displayName\": \"Diamond Ore\",\n    \"name\": \"diamond_ore\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 264\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 57,\n    \"displayName\": \"Block of Diamond\",\n    \"name\": \"diamond_block\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 57\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 58,\n    \"displayName\": \"Crafting Table\",\n    \"name\": \"crafting_table\",\n    \"hardness\": 2.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 58\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 59,\n    \"displayName\": \"Wheat\",\n    \"name\": \"wheat\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 295\n      },\n      {\n        \"drop\": 295,\n        \"minCount\": 0,\n        \"maxCount\": 3\n      },\n      {\n        \"drop\": 296\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 60,\n    \"displayName\": \"Farmland\",\n    \"name\": \"farmland\",\n    \"hardness\": 0.6,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 3,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 61,\n    \"displayName\": \"Furnace\",\n    \"name\": \"furnace\",\n    \"hardness\": 3.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 61\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 13,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 62,\n    \"displayName\": \"Burning Furnace\",\n    \"name\": \"lit_furnace\",\n    \"hardness\": 3.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 61\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 13,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 63,\n    \"displayName\": \"Standing Sign\",\n    \"name\": \"standing_sign\",\n    \"hardness\": 1,\n    \"stackSize\": 16,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 323\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 64,\n    \"displayName\": \"Oak Door\",\n    \"name\": \"wooden_door\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 64\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 65,\n    \"displayName\": \"Ladder\",\n    \"name\": \"ladder\",\n    \"hardness\": 0.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 65\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 66,\n    \"displayName\": \"Rail\",\n    \"name\": \"rail\",\n    \"hardness\": 0.7,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"rock\",\n    \"drops\": [\n      {\n        \"drop\": 66\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 67,\n    \"displayName\": \"Cobblestone Stairs\",\n    \"name\": \"stone_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 67\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 68,\n    \"displayName\": \"Wall Sign\",\n    \"name\": \"wall_sign\",\n    \"hardness\": 1,\n    \"stackSize\": 16,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 323\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 69,\n    \"displayName\": \"Lever\",\n    \"name\": \"lever\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"drops\": [\n      {\n        \"drop\": 69\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 70,\n    \"displayName\": \"Stone Pressure Plate\",\n    \"name\": \"stone_pressure_plate\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 70\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 71,\n    \"displayName\": \"Iron Door\",\n    \"name\": \"iron_door\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 71\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 72,\n    \"displayName\": \"Wooden Pressure Plate\",\n    \"name\": \"wooden_pressure_plate\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 72\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 73,\n    \"displayName\": \"Redstone Ore\",\n    \"name\": \"redstone_ore\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 331,\n        \"minCount\": 4,\n        \"maxCount\": 5\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 9,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 74,\n    \"displayName\": \"Glowing Redstone Ore\",\n    \"name\": \"lit_redstone_ore\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 331,\n        \"minCount\": 4,\n        \"maxCount\": 5\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 9,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 75,\n    \"displayName\": \"Redstone Torch (inactive)\",\n    \"name\": \"unlit_redstone_torch\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Facing east (attached to a block to its west)\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Facing west (attached to a block to its east)\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Facing south (attached to a block to its north)\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Facing north (attached to a block to its south)\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Facing up (attached to a block beneath it)\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 75\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 7,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 76,\n    \"displayName\": \"Redstone Torch (active)\",\n    \"name\": \"redstone_torch\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Facing east (attached to a block to its west)\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Facing west (attached to a block to its east)\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Facing south (attached to a block to its north)\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Facing north (attached to a block to its south)\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Facing up (attached to a block beneath it)\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 76\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 7,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 77,\n    \"displayName\": \"Stone Button\",\n    \"name\": \"stone_button\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"rock\",\n    \"drops\": [\n      {\n        \"drop\": 77\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 78,\n    \"displayName\": \"Snow (layer)\",\n    \"name\": \"snow_layer\",\n    \"hardness\": 0.2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"harvestTools\": {\n      \"256\": true,\n      \"269\": true,\n      \"273\": true,\n      \"277\": true,\n      \"284\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 332,\n        \"minCount\": 2,\n        \"maxCount\": 9\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 79,\n    \"displayName\": \"Ice\",\n    \"name\": \"ice\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 80,\n    \"displayName\": \"Snow\",\n    \"name\": \"snow\",\n    \"hardness\": 0.2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"harvestTools\": {\n      \"256\": true,\n      \"269\": true,\n      \"273\": true,\n      \"277\": true,\n      \"284\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 332,\n        \"minCount\": 4\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 81,\n    \"displayName\": \"Cactus\",\n    \"name\": \"cactus\",\n    \"hardness\": 0.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 81\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 82,\n    \"displayName\": \"Clay\",\n    \"name\": \"clay\",\n    \"hardness\": 0.6,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"drops\": [\n      {\n        \"drop\": 337,\n        \"minCount\": 4\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 83,\n    \"displayName\": \"Sugar Cane\",\n    \"name\": \"reeds\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 83\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 84,\n    \"displayName\": \"Jukebox\",\n    \"name\": \"jukebox\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"No disc inserted\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Contains a disc\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 84\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 85,\n    \"displayName\": \"Oak Fence\",\n    \"name\": \"fence\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 85\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 86,\n    \"displayName\": \"Pumpkin\",\n    \"name\": \"pumpkin\",\n    \"hardness\": 1,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 86\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 87,\n    \"displayName\": \"Netherrack\",\n    \"name\": \"netherrack\",\n    \"hardness\": 0.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 87\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 88,\n    \"displayName\": \"Soul Sand\",\n    \"name\": \"soul_sand\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"drops\": [\n      {\n        \"drop\": 88\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 89,\n    \"displayName\": \"Glowstone\",\n    \"name\": \"glowstone\",\n    \"hardness\": 0.3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 348,\n        \"minCount\": 2,\n        \"maxCount\": 4\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 90,\n    \"displayName\": \"Nether Portal\",\n    \"name\": \"portal\",\n    \"hardness\": null,\n    \"stackSize\": 0,\n    \"diggable\": false,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 11,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 91,\n    \"displayName\": \"Jack o'Lantern\",\n    \"name\": \"lit_pumpkin\",\n    \"hardness\": 1,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 91\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 15,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 92,\n    \"displayName\": \"Cake\",\n    \"name\": \"cake\",\n    \"hardness\": 0.5,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 93,\n    \"displayName\": \"Redstone Repeater (inactive)\",\n    \"name\": \"unpowered_repeater\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 356\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 94,\n    \"displayName\": \"Redstone Repeater (active)\",\n    \"name\": \"powered_repeater\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 356\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 95,\n    \"displayName\": \"Invisible Bedrock\",\n    \"name\": \"invisibleBedrock\",\n    \"hardness\": null,\n    \"stackSize\": 64,\n    \"diggable\": false,\n    \"boundingBox\": \"block\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 96,\n    \"displayName\": \"Trapdoor\",\n    \"name\": \"trapdoor\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 96\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 97,\n    \"displayName\": \"Monster Egg\",\n    \"name\": \"monster_egg\",\n    \"hardness\": 0.75,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Stone Monster Egg\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Cobblestone Monster Egg\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Stone Brick Monster Egg\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Mossy Stone Brick Monster Egg\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Cracked Stone Brick Monster Egg\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Chiseled Stone Brick Monster Egg\"\n      }\n    ],\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 98,\n    \"displayName\": \"Stone Bricks\",\n    \"name\": \"stonebrick\",\n    \"hardness\": 1.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Stone brick\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Mossy stone brick\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Cracked stone brick\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Chiseled stone brick\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 98\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 99,\n    \"displayName\": \"Brown Mushroom (block)\",\n    \"name\": \"brown_mushroom_block\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Pores on all sides\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Cap texture on top, west and north\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Cap texture on top and north\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Cap texture on top, north and east\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Cap texture on top and west\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Cap texture on top\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Cap texture on top and east\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Cap texture on top, south and west\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Cap texture on top and south\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Cap texture on top, east and south\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Stem texture on all four sides, pores on top and bottom\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Cap texture on all six sides\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Stem texture on all six sides\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 40,\n        \"minCount\": 0,\n        \"maxCount\": 2\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 100,\n    \"displayName\": \"Red Mushroom (block)\",\n    \"name\": \"red_mushroom_block\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Pores on all sides\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Cap texture on top, west and north\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Cap texture on top and north\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Cap texture on top, north and east\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Cap texture on top and west\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Cap texture on top\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Cap texture on top and east\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Cap texture on top, south and west\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Cap texture on top and south\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Cap texture on top, east and south\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Stem texture on all four sides, pores on top and bottom\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Cap texture on all six sides\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Stem texture on all six sides\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 40,\n        \"minCount\": 0,\n        \"maxCount\": 2\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 101,\n    \"displayName\": \"Iron Bars\",\n    \"name\": \"iron_bars\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 101\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 102,\n    \"displayName\": \"Glass Pane\",\n    \"name\": \"glass_pane\",\n    \"hardness\": 0.3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 103,\n    \"displayName\": \"Melon\",\n    \"name\": \"melon_block\",\n    \"hardness\": 1,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 360,\n        \"minCount\": 3,\n        \"maxCount\": 7\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 104,\n    \"displayName\": \"Pumpkin Stem\",\n    \"name\": \"pumpkin_stem\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 361,\n        \"minCount\": 0,\n        \"maxCount\": 3\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 105,\n    \"displayName\": \"Melon Stem\",\n    \"name\": \"melon_stem\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 362,\n        \"minCount\": 0,\n        \"maxCount\": 3\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 106,\n    \"displayName\": \"Vines\",\n    \"name\": \"vine\",\n    \"hardness\": 0.2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 106\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 107,\n    \"displayName\": \"Fence Gate\",\n    \"name\": \"fence_gate\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 107\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 108,\n    \"displayName\": \"Brick Stairs\",\n    \"name\": \"brick_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 108\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 109,\n    \"displayName\": \"Stone Brick Stairs\",\n    \"name\": \"stone_brick_stairs\",\n    \"hardness\": 1.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 109\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 110,\n    \"displayName\": \"Mycelium\",\n    \"name\": \"mycelium\",\n    \"hardness\": 0.6,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 3,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 111,\n    \"displayName\": \"Lily Pad\",\n    \"name\": \"waterlily\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 111\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 112,\n    \"displayName\": \"Nether Brick\",\n    \"name\": \"nether_brick\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 112\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 113,\n    \"displayName\": \"Nether Brick Fence\",\n    \"name\": \"nether_brick_fence\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 113\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 114,\n    \"displayName\": \"Nether Brick Stairs\",\n    \"name\": \"nether_brick_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 114\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 115,\n    \"displayName\": \"Nether Wart\",\n    \"name\": \"nether_wart\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 372\n      },\n      {\n        \"drop\": 372,\n        \"minCount\": 2,\n        \"maxCount\": 4\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 116,\n    \"displayName\": \"Enchantment Table\",\n    \"name\": \"enchanting_table\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 116\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 117,\n    \"displayName\": \"Brewing Stand\",\n    \"name\": \"brewing_stand\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 379\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 1,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 118,\n    \"displayName\": \"Cauldron\",\n    \"name\": \"cauldron\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 380\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 119,\n    \"displayName\": \"End Portal\",\n    \"name\": \"end_portal\",\n    \"hardness\": null,\n    \"stackSize\": 0,\n    \"diggable\": false,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 15,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 120,\n    \"displayName\": \"End Portal Frame\",\n    \"name\": \"end_portal_frame\",\n    \"hardness\": null,\n    \"stackSize\": 64,\n    \"diggable\": false,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 120\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 1,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 121,\n    \"displayName\": \"End Stone\",\n    \"name\": \"end_stone\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 121\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 122,\n    \"displayName\": \"Dragon Egg\",\n    \"name\": \"dragon_egg\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 122\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 123,\n    \"displayName\": \"Redstone Lamp (inactive)\",\n    \"name\": \"redstone_lamp\",\n    \"hardness\": 0.3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 123\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 15,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 124,\n    \"displayName\": \"Redstone Lamp (active)\",\n    \"name\": \"lit_redstone_lamp\",\n    \"hardness\": 0.3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 124\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 15,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 125,\n    \"displayName\": \"Dropper\",\n    \"name\": \"dropper\",\n    \"hardness\": 3.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 158\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 126,\n    \"displayName\": \"Activator Rail\",\n    \"name\": \"activator_rail\",\n    \"hardness\": 0.7,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"rock\",\n    \"drops\": [\n      {\n        \"drop\": 157\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 127,\n    \"displayName\": \"Cocoa\",\n    \"name\": \"cocoa\",\n    \"hardness\": 0.2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 351,\n          \"metadata\": 3\n        }\n      },\n      {\n        \"drop\": {\n          \"id\": 351,\n          \"metadata\": 3\n        },\n        \"minCount\": 3\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 128,\n    \"displayName\": \"Sandstone Stairs\",\n    \"name\": \"sandstone_stairs\",\n    \"hardness\": 0.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 128\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 129,\n    \"displayName\": \"Emerald Ore\",\n    \"name\": \"emerald_ore\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 388\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 130,\n    \"displayName\": \"Ender Chest\",\n    \"name\": \"ender_chest\",\n    \"hardness\": 22.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 49,\n        \"minCount\": 8\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 131,\n    \"displayName\": \"Tripwire Hook\",\n    \"name\": \"tripwire_hook\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"drops\": [\n      {\n        \"drop\": 131\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 132,\n    \"displayName\": \"Tripwire\",\n    \"name\": \"tripWire\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"drops\": [\n      {\n        \"drop\": 287\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 133,\n    \"displayName\": \"Block of Emerald\",\n    \"name\": \"emerald_block\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 133\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 134,\n    \"displayName\": \"Spruce Wood Stairs\",\n    \"name\": \"spruce_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 134\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 135,\n    \"displayName\": \"Birch Wood Stairs\",\n    \"name\": \"birch_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 135\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 136,\n    \"displayName\": \"Jungle Wood Stairs\",\n    \"name\": \"jungle_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 136\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 137,\n    \"displayName\": \"Command Block\",\n    \"name\": \"command_block\",\n    \"hardness\": null,\n    \"stackSize\": 64,\n    \"diggable\": false,\n    \"boundingBox\": \"block\",\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 138,\n    \"displayName\": \"Beacon\",\n    \"name\": \"beacon\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 138\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 15,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 139,\n    \"displayName\": \"Cobblestone Wall\",\n    \"name\": \"cobblestone_wall\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Cobblestone Wall\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Mossy Cobblestone Wall\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 139\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 140,\n    \"displayName\": \"Flower Pot\",\n    \"name\": \"flower_pot\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 140\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 141,\n    \"displayName\": \"Carrot\",\n    \"name\": \"carrots\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 141\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 142,\n    \"displayName\": \"Potato\",\n    \"name\": \"potatoes\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"drops\": [\n      {\n        \"drop\": 142\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 143,\n    \"displayName\": \"Wooden Button\",\n    \"name\": \"wooden_button\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 143\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 144,\n    \"displayName\": \"Mob head\",\n    \"name\": \"skull\",\n    \"hardness\": 1,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Skeleton Skull\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Wither Skeleton Skull\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Zombie Head\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Head\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Creeper Head\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 144\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 145,\n    \"displayName\": \"Anvil\",\n    \"name\": \"anvil\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Anvil\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Slightly Damaged Anvil\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Very Damaged Anvil\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Anvil (North/South)\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Anvil (East/West)\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Anvil (South/North)\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Anvil (West/East)\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Slightly Damaged Anvil (North/South)\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Slightly Damaged Anvil (East/West)\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Slightly Damaged Anvil (West/East)\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Slightly Damaged Anvil (South/North)\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Very Damaged Anvil (North/South)\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Very Damaged Anvil (East/West)\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Very Damaged Anvil (West/East)\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Very Damaged Anvil (South/North)\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 145\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 146,\n    \"displayName\": \"Trapped Chest\",\n    \"name\": \"trapped_chest\",\n    \"hardness\": 2.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 146\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 147,\n    \"displayName\": \"Weighted Pressure Plate (Light)\",\n    \"name\": \"light_weighted_pressure_plate\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 147\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 148,\n    \"displayName\": \"Weighted Pressure Plate (Heavy)\",\n    \"name\": \"heavy_weighted_pressure_plate\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 148\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 149,\n    \"displayName\": \"Redstone Comparator\",\n    \"name\": \"unpowered_comparator\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 404\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 150,\n    \"displayName\": \"Redstone Comparator (deprecated)\",\n    \"name\": \"powered_comparator\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 404\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 151,\n    \"displayName\": \"Daylight Sensor\",\n    \"name\": \"daylight_detector\",\n    \"hardness\": 0.2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 151\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 152,\n    \"displayName\": \"Block of Redstone\",\n    \"name\": \"redstone_block\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 152\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 153,\n    \"displayName\": \"Nether Quartz Ore\",\n    \"name\": \"quartz_ore\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 406\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 154,\n    \"displayName\": \"Hopper\",\n    \"name\": \"hopper\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 154\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 155,\n    \"displayName\": \"Block of Quartz\",\n    \"name\": \"quartz_block\",\n    \"hardness\": 0.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Block of Quartz\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Chiseled Quartz Block\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Pillar Quartz Block (vertical)\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Pillar Quartz Block (north-south)\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Pillar Quartz Block (east-west)\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 155\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 156,\n    \"displayName\": \"Quartz Stairs\",\n    \"name\": \"quartz_stairs\",\n    \"hardness\": 0.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 156\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 157,\n    \"displayName\": \"Double Wooden Slab\",\n    \"name\": \"double_wooden_slab\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Double Oak Wood Slab\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Double Spruce Wood Slab\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Double Birch Wood Slab\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Double Jungle Wood Slab\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Double Acacia Wood Slab\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Double Dark Oak Wood Slab\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 44,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 158,\n    \"displayName\": \"Wooden Slab\",\n    \"name\": \"wooden_slab\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Oak Wood Slab\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Spruce Wood Slab\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Birch Wood Slab\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Jungle Wood Slab\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Acacia Wood Slab\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Dark Oak Wood Slab\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Upper Oak Wood Slab\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Upper Spruce Wood Slab\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Upper Birch Wood Slab\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Upper Jungle Wood Slab\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Upper Acacia Wood Slab\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Upper Dark Oak Wood Slab\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 44,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 159,\n    \"displayName\": \"Stained Clay\",\n    \"name\": \"stained_hardened_clay\",\n    \"hardness\": 1.25,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"White hardened clay\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Orange hardened clay\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Magenta hardened clay\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Light blue hardened clay\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Yellow hardened clay\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Lime hardened clay\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Pink hardened clay\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Gray hardened clay\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Light gray hardened clay\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Cyan hardened clay\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Purple hardened clay\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Blue hardened clay\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Brown hardened clay\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Green hardened clay\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Red hardened clay\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Black hardened clay\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 159\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 160,\n    \"displayName\": \"Stained Glass Pane\",\n    \"name\": \"stained_glass_pane\",\n    \"hardness\": 0.3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"White Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Orange Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Magenta Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Light Blue Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Yellow Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Lime Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Pink Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Gray Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Light Gray Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Cyan Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Purple Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Blue Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Brown Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Green Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Red Stained Glass Pane\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Black Stained Glass Pane\"\n      }\n    ],\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 161,\n    \"displayName\": \"Leaves (Acacia/Dark Oak)\",\n    \"name\": \"leaves2\",\n    \"hardness\": 0.2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Acacia Leaves\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Dark Oak Leaves\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Acacia Leaves (no decay)\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Dark Oak Leaves (no decay)\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Acacia Leaves (check decay)\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Dark Oak Leaves (check decay)\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Acacia Leaves (no decay and check decay)\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Dark Oak Leaves (no decay and check decay)\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 6,\n        \"minCount\": 0,\n        \"maxCount\": 1\n      },\n      {\n        \"drop\": 260,\n        \"minCount\": 0,\n        \"maxCount\": 1\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 162,\n    \"displayName\": \"Wood (Acacia/Dark Oak)\",\n    \"name\": \"log2\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 162\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 163,\n    \"displayName\": \"Acacia Wood Stairs\",\n    \"name\": \"acacia_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 163\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 164,\n    \"displayName\": \"Dark Oak Wood Stairs\",\n    \"name\": \"dark_oak_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 164\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 165,\n    \"displayName\": \"Slime Block\",\n    \"name\": \"slime\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 165\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 167,\n    \"displayName\": \"Iron Trapdoor\",\n    \"name\": \"iron_trapdoor\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 167\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 168,\n    \"displayName\": \"Prismarine\",\n    \"name\": \"prismarine\",\n    \"hardness\": 1.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Prismarine\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Prismarine Bricks\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Dark Prismarine\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 168\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 169,\n    \"displayName\": \"Sea Lantern\",\n    \"name\": \"seaLantern\",\n    \"hardness\": 0.3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 410,\n        \"minCount\": 2,\n        \"maxCount\": 3\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 170,\n    \"displayName\": \"Hay Bale\",\n    \"name\": \"hay_block\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 170\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 171,\n    \"displayName\": \"Carpet\",\n    \"name\": \"carpet\",\n    \"hardness\": 0.1,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"White Carpet\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Orange Carpet\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Magenta Carpet\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Light Blue Carpet\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Yellow Carpet\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Lime Carpet\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Pink Carpet\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Gray Carpet\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Light Gray Carpet\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Cyan Carpet\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Purple Carpet\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Blue Carpet\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Brown Carpet\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Green Carpet\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Red Carpet\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Black Carpet\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 171\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 172,\n    \"displayName\": \"Hardened Clay\",\n    \"name\": \"hardened_clay\",\n    \"hardness\": 1.25,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"White hardened clay\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Orange hardened clay\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Magenta hardened clay\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Light blue hardened clay\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Yellow hardened clay\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Lime hardened clay\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Pink hardened clay\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Gray hardened clay\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Light gray hardened clay\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Cyan hardened clay\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Purple hardened clay\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Blue hardened clay\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Brown hardened clay\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Green hardened clay\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Red hardened clay\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Black hardened clay\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 172\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 173,\n    \"displayName\": \"Block of Coal\",\n    \"name\": \"coal_block\",\n    \"hardness\": 5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 173\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 174,\n    \"displayName\": \"Packed Ice\",\n    \"name\": \"packed_ice\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 175,\n    \"displayName\": \"Large Flowers\",\n    \"name\": \"double_plant\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"plant\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Sunflower\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Lilac\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Double Tallgrass\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Large Fern\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Rose Bush\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Peony\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Top Half of any Large Plant; low three bits 0x7 are derived from the block below.\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 175\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 176,\n    \"displayName\": \"Standing Banner\",\n    \"name\": \"standing_banner\",\n    \"hardness\": 1,\n    \"stackSize\": 16,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 176\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 177,\n    \"displayName\": \"Wall Banner\",\n    \"name\": \"wall_banner\",\n    \"hardness\": 1,\n    \"stackSize\": 16,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 177\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 178,\n    \"displayName\": \"Inverted Daylight Sensor\",\n    \"name\": \"daylight_detector_inverted\",\n    \"hardness\": 0.2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 178\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 179,\n    \"displayName\": \"Red Sandstone\",\n    \"name\": \"red_sandstone\",\n    \"hardness\": 0.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Red Sandstone\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Chiseled Red Sandstone\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Smooth Red Sandstone\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 179\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 180,\n    \"displayName\": \"Red Sandstone Stairs\",\n    \"name\": \"red_sandstone_stairs\",\n    \"hardness\": 0.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 180\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 181,\n    \"displayName\": \"Double Red Sandstone Slab\",\n    \"name\": \"double_stone_slab2\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Double Red Sandstone Slab\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Smooth Double Red Sandstone Slab\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 44,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 182,\n    \"displayName\": \"Red Sandstone Slab\",\n    \"name\": \"stone_slab2\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Red Sandstone Slab\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Upper Red Sandstone Slab\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": {\n          \"id\": 44,\n          \"metadata\": 0\n        }\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 183,\n    \"displayName\": \"Spruce Fence Gate\",\n    \"name\": \"spruce_fence_gate\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 183\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 184,\n    \"displayName\": \"Birch Fence Gate\",\n    \"name\": \"birch_fence_gate\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 184\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 185,\n    \"displayName\": \"Jungle Fence Gate\",\n    \"name\": \"jungle_fence_gate\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 185\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 186,\n    \"displayName\": \"Dark Oak Fence Gate\",\n    \"name\": \"dark_oak_fence_gate\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 186\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 187,\n    \"displayName\": \"Acacia Fence Gate\",\n    \"name\": \"acacia_fence_gate\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 187\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 188,\n    \"displayName\": \"Repeating Command Block\",\n    \"name\": \"repeating_command_block\",\n    \"hardness\": null,\n    \"stackSize\": 64,\n    \"diggable\": false,\n    \"boundingBox\": \"block\",\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 189,\n    \"displayName\": \"Chain Command Block\",\n    \"name\": \"chain_command_block\",\n    \"hardness\": null,\n    \"stackSize\": 64,\n    \"diggable\": false,\n    \"boundingBox\": \"block\",\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 193,\n    \"displayName\": \"Spruce Door\",\n    \"name\": \"spruce_door\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 193\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 194,\n    \"displayName\": \"Birch Door\",\n    \"name\": \"birch_door\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 194\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 195,\n    \"displayName\": \"Jungle Door\",\n    \"name\": \"jungle_door\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 195\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 196,\n    \"displayName\": \"Acacia Door\",\n    \"name\": \"acacia_door\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 196\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 197,\n    \"displayName\": \"Dark Oak Door\",\n    \"name\": \"dark_oak_door\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [\n      {\n        \"drop\": 197\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 198,\n    \"displayName\": \"Grass Path\",\n    \"name\": \"grass_path\",\n    \"hardness\": 0.6,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"plant\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 199,\n    \"displayName\": \"Item Frame\",\n    \"name\": \"frame\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 200,\n    \"displayName\": \"Chorus Flower\",\n    \"name\": \"chorus_flower\",\n    \"hardness\": 0.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 200\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 201,\n    \"displayName\": \"Purpur Block\",\n    \"name\": \"purpur_block\",\n    \"hardness\": 1.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 201\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 203,\n    \"displayName\": \"Purpur Stairs\",\n    \"name\": \"purpur_stairs\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 203\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 205,\n    \"displayName\": \"Shulker Box\",\n    \"name\": \"undyed_shulker_box\",\n    \"hardness\": 6,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 229\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 206,\n    \"displayName\": \"End Stone Bricks\",\n    \"name\": \"end_bricks\",\n    \"hardness\": 0.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 206\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 207,\n    \"displayName\": \"Frosted Ice\",\n    \"name\": \"frosted_ice\",\n    \"hardness\": 0.5,\n    \"stackSize\": 0,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 2\n  },\n  {\n    \"id\": 208,\n    \"displayName\": \"End Rod\",\n    \"name\": \"end_rod\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Facing down\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Facing up\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Facing north\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Facing south\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Facing west\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Facing east\"\n      }\n    ],\n    \"drops\": [\n      {\n        \"drop\": 198\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 14,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 209,\n    \"displayName\": \"End Gateway\",\n    \"name\": \"end_gateway\",\n    \"hardness\": null,\n    \"stackSize\": 0,\n    \"diggable\": false,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 15,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 210,\n    \"displayName\": \"Allow\",\n    \"name\": \"allow\",\n    \"hardness\": null,\n    \"stackSize\": 64,\n    \"diggable\": false,\n    \"boundingBox\": \"block\",\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 211,\n    \"displayName\": \"Deny\",\n    \"name\": \"deny\",\n    \"hardness\": null,\n    \"stackSize\": 64,\n    \"diggable\": false,\n    \"boundingBox\": \"block\",\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 212,\n    \"displayName\": \"Border\",\n    \"name\": \"border_block\",\n    \"hardness\": null,\n    \"stackSize\": 64,\n    \"diggable\": false,\n    \"boundingBox\": \"block\",\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 213,\n    \"displayName\": \"Magma Block\",\n    \"name\": \"magma\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 213\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 214,\n    \"displayName\": \"Nether Wart Block\",\n    \"name\": \"nether_wart_block\",\n    \"hardness\": 1,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 214\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 215,\n    \"displayName\": \"Red Nether Brick\",\n    \"name\": \"red_nether_brick\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 215\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 216,\n    \"displayName\": \"Bone Block\",\n    \"name\": \"bone_block\",\n    \"hardness\": 2,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 216\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 217,\n    \"displayName\": \"Structure Void\",\n    \"name\": \"structure_void\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Save\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Load\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Corner\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Data\"\n      }\n    ],\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 218,\n    \"displayName\": \"Shulker Box\",\n    \"name\": \"shulker_box\",\n    \"hardness\": 6,\n    \"stackSize\": 1,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 219\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0,\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"White Shulker Box\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Orange Shulker Box\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Magenta Shulker Box\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Light Blue Shulker Box\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Yellow Shulker Box\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Lime Shulker Box\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Pink Shulker Box\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Gray Shulker Box\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Light Gray Shulker Box\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Cyan Shulker Box\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Purple Shulker Box\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Blue Shulker Box\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Brown Shulker Box\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Green Shulker Box\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Red Shulker Box\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Black Shulker Box\"\n      }\n    ]\n  },\n  {\n    \"id\": 219,\n    \"displayName\": \"Purple Glazed Terracotta\",\n    \"name\": \"purple_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 245\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 220,\n    \"displayName\": \"White Glazed Terracotta\",\n    \"name\": \"white_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 235\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 221,\n    \"displayName\": \"Orange Glazed Terracotta\",\n    \"name\": \"orange_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 236\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 222,\n    \"displayName\": \"Magenta Glazed Terracotta\",\n    \"name\": \"magenta_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 237\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 223,\n    \"displayName\": \"Light Blue Glazed Terracotta\",\n    \"name\": \"light_blue_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 238\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 224,\n    \"displayName\": \"Yellow Glazed Terracotta\",\n    \"name\": \"yellow_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 239\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 225,\n    \"displayName\": \"Lime Glazed Terracotta\",\n    \"name\": \"lime_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 240\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 226,\n    \"displayName\": \"Pink Glazed Terracotta\",\n    \"name\": \"pink_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 241\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 227,\n    \"displayName\": \"Gray Glazed Terracotta\",\n    \"name\": \"gray_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 242\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 228,\n    \"displayName\": \"Light Gray Glazed Terracotta\",\n    \"name\": \"silver_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 243\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 229,\n    \"displayName\": \"Cyan Glazed Terracotta\",\n    \"name\": \"cyan_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 244\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 231,\n    \"displayName\": \"Blue Glazed Terracotta\",\n    \"name\": \"blue_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 246\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 232,\n    \"displayName\": \"Brown Glazed Terracotta\",\n    \"name\": \"brown_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 247\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 233,\n    \"displayName\": \"Green Glazed Terracotta\",\n    \"name\": \"green_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 248\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 234,\n    \"displayName\": \"Red Glazed Terracotta\",\n    \"name\": \"red_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 249\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 235,\n    \"displayName\": \"Black Glazed Terracotta\",\n    \"name\": \"black_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 250\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 236,\n    \"displayName\": \"Concrete\",\n    \"name\": \"concrete\",\n    \"hardness\": 1.8,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 251\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 237,\n    \"displayName\": \"Concrete Powder\",\n    \"name\": \"concrete_powder\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"drops\": [\n      {\n        \"drop\": 252\n      }\n    ],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 240,\n    \"displayName\": \"Chorus Plant\",\n    \"name\": \"chorus_plant\",\n    \"hardness\": 0.4,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 432,\n        \"minCount\": 0,\n        \"maxCount\": 1\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 241,\n    \"displayName\": \"Stained Glass\",\n    \"name\": \"stained_glass\",\n    \"hardness\": 0.3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"White Stained Glass\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Orange Stained Glass\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Magenta Stained Glass\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Light Blue Stained Glass\"\n      },\n      {\n        \"metadata\": 4,\n        \"displayName\": \"Yellow Stained Glass\"\n      },\n      {\n        \"metadata\": 5,\n        \"displayName\": \"Lime Stained Glass\"\n      },\n      {\n        \"metadata\": 6,\n        \"displayName\": \"Pink Stained Glass\"\n      },\n      {\n        \"metadata\": 7,\n        \"displayName\": \"Gray Stained Glass\"\n      },\n      {\n        \"metadata\": 8,\n        \"displayName\": \"Light Gray Stained Glass\"\n      },\n      {\n        \"metadata\": 9,\n        \"displayName\": \"Cyan Stained Glass\"\n      },\n      {\n        \"metadata\": 10,\n        \"displayName\": \"Purple Stained Glass\"\n      },\n      {\n        \"metadata\": 11,\n        \"displayName\": \"Blue Stained Glass\"\n      },\n      {\n        \"metadata\": 12,\n        \"displayName\": \"Brown Stained Glass\"\n      },\n      {\n        \"metadata\": 13,\n        \"displayName\": \"Green Stained Glass\"\n      },\n      {\n        \"metadata\": 14,\n        \"displayName\": \"Red Stained Glass\"\n      },\n      {\n        \"metadata\": 15,\n        \"displayName\": \"Black Stained Glass\"\n      }\n    ],\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 243,\n    \"displayName\": \"Podzol\",\n    \"name\": \"podzol\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 244,\n    \"displayName\": \"Beetroots\",\n    \"name\": \"beetroot\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"empty\",\n    \"drops\": [],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 245,\n    \"displayName\": \"Stonecutter\",\n    \"name\": \"stonecutter\",\n    \"hardness\": 2.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"wood\",\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 246,\n    \"displayName\": \"Glowing Obsidian\",\n    \"name\": \"glowingobsidian\",\n    \"hardness\": 50,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"278\": true\n    },\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 12,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 247,\n    \"displayName\": \"Nether Reactor Core\",\n    \"name\": \"netherreactor\",\n    \"hardness\": 3,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"274\": true,\n      \"278\": true\n    },\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 248,\n    \"displayName\": \"Update Game Block\",\n    \"name\": \"info_update\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 249,\n    \"displayName\": \"Update Game Block\",\n    \"name\": \"info_update2\",\n    \"hardness\": 0.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"material\": \"dirt\",\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  },\n  {\n    \"id\": 250,\n    \"displayName\": \"Moving Block\",\n    \"name\": \"movingBlock\",\n    \"hardness\": 0,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"drops\": [\n      {\n        \"drop\": 36\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 251,\n    \"displayName\": \"Observer\",\n    \"name\": \"observer\",\n    \"hardness\": 3.5,\n    \"stackSize\": 64,\n    \"diggable\": true,\n    \"boundingBox\": \"block\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    },\n    \"drops\": [\n      {\n        \"drop\": 218\n      }\n    ],\n    \"transparent\": true,\n    \"emitLight\": 0,\n    \"filterLight\": 0\n  },\n  {\n    \"id\": 252,\n    \"displayName\": \"Structure Block\",\n    \"name\": \"structure_block\",\n    \"hardness\": null,\n    \"stackSize\": 64,\n    \"diggable\": false,\n    \"boundingBox\": \"block\",\n    \"variations\": [\n      {\n        \"metadata\": 0,\n        \"displayName\": \"Save\"\n      },\n      {\n        \"metadata\": 1,\n        \"displayName\": \"Load\"\n      },\n      {\n        \"metadata\": 2,\n        \"displayName\": \"Corner\"\n      },\n      {\n        \"metadata\": 3,\n        \"displayName\": \"Data\"\n      }\n    ],\n    \"drops\": [],\n    \"transparent\": false,\n    \"emitLight\": 0,\n    \"filterLight\": 15\n  }\n]\n"
+var _Assetse53ebccfa4f093da10828375f905c9d175d680e3 = "[\n  {\n    \"id\": 0,\n    \"displayName\": \"Air\",\n    \"name\": \"air\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      0\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 1,\n    \"displayName\": \"Stone\",\n    \"name\": \"stone\",\n    \"hardness\": 1.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      4\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 2,\n    \"displayName\": \"Grass Block\",\n    \"name\": \"grass\",\n    \"hardness\": 0.6,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      3\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 3,\n    \"displayName\": \"Dirt\",\n    \"name\": \"dirt\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      3\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 4,\n    \"displayName\": \"Cobblestone\",\n    \"name\": \"cobblestone\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      4\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 5,\n    \"displayName\": \"Wood Planks\",\n    \"name\": \"planks\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      5\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 6,\n    \"displayName\": \"Sapling\",\n    \"name\": \"sapling\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      6\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 7,\n    \"displayName\": \"Bedrock\",\n    \"name\": \"bedrock\",\n    \"hardness\": null,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 8,\n    \"displayName\": \"Water\",\n    \"name\": \"flowing_water\",\n    \"hardness\": 100,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": true,\n    \"filterLight\": 2,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 9,\n    \"displayName\": \"Stationary Water\",\n    \"name\": \"water\",\n    \"hardness\": 100,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": true,\n    \"filterLight\": 2,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 10,\n    \"displayName\": \"Lava\",\n    \"name\": \"flowing_lava\",\n    \"hardness\": 100,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 15,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 11,\n    \"displayName\": \"Stationary Lava\",\n    \"name\": \"lava\",\n    \"hardness\": 100,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 15,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 12,\n    \"displayName\": \"Sand\",\n    \"name\": \"sand\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      12\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 0,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 13,\n    \"displayName\": \"Gravel\",\n    \"name\": \"gravel\",\n    \"hardness\": 0.6,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      13\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 14,\n    \"displayName\": \"Gold Ore\",\n    \"name\": \"gold_ore\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      14\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    }\n  },\n  {\n    \"id\": 15,\n    \"displayName\": \"Iron Ore\",\n    \"name\": \"iron_ore\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      15\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"274\": true,\n      \"278\": true\n    }\n  },\n  {\n    \"id\": 16,\n    \"displayName\": \"Coal Ore\",\n    \"name\": \"coal_ore\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      263\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 17,\n    \"displayName\": \"Wood\",\n    \"name\": \"log\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      17\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 18,\n    \"displayName\": \"Leaves\",\n    \"name\": \"leaves\",\n    \"hardness\": 0.2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      6,\n      260\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 19,\n    \"displayName\": \"Sponge\",\n    \"name\": \"sponge\",\n    \"hardness\": 0.6,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      19\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 20,\n    \"displayName\": \"Glass\",\n    \"name\": \"glass\",\n    \"hardness\": 0.3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 21,\n    \"displayName\": \"Lapis Lazuli Ore\",\n    \"name\": \"lapis_ore\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      351\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"274\": true,\n      \"278\": true\n    }\n  },\n  {\n    \"id\": 22,\n    \"displayName\": \"Lapis Lazuli Block\",\n    \"name\": \"lapis_block\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      22\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"274\": true,\n      \"278\": true\n    }\n  },\n  {\n    \"id\": 23,\n    \"displayName\": \"Dispenser\",\n    \"name\": \"dispenser\",\n    \"hardness\": 3.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      23\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 24,\n    \"displayName\": \"Sandstone\",\n    \"name\": \"sandstone\",\n    \"hardness\": 0.8,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      24\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 25,\n    \"displayName\": \"Note Block\",\n    \"name\": \"noteblock\",\n    \"hardness\": 0.8,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      25\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 26,\n    \"displayName\": \"Bed\",\n    \"name\": \"bed\",\n    \"hardness\": 0.2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      26\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 27,\n    \"displayName\": \"Powered Rail\",\n    \"name\": \"golden_rail\",\n    \"hardness\": 0.7,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      27\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 28,\n    \"displayName\": \"Detector Rail\",\n    \"name\": \"detector_rail\",\n    \"hardness\": 0.7,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      28\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 29,\n    \"displayName\": \"Sticky Piston\",\n    \"name\": \"sticky_piston\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      29\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 30,\n    \"displayName\": \"Cobweb\",\n    \"name\": \"web\",\n    \"hardness\": 4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      287\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"web\",\n    \"harvestTools\": {\n      \"267\": true,\n      \"268\": true,\n      \"272\": true,\n      \"276\": true,\n      \"283\": true,\n      \"359\": true\n    }\n  },\n  {\n    \"id\": 31,\n    \"displayName\": \"Grass\",\n    \"name\": \"tallgrass\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      295\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 32,\n    \"displayName\": \"Dead Bush\",\n    \"name\": \"deadbush\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      280\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 33,\n    \"displayName\": \"Piston\",\n    \"name\": \"piston\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      33\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 34,\n    \"displayName\": \"Piston Head\",\n    \"name\": \"pistonArmCollision\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      34\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 35,\n    \"displayName\": \"Wool\",\n    \"name\": \"wool\",\n    \"hardness\": 0.8,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      35\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wool\"\n  },\n  {\n    \"id\": 37,\n    \"displayName\": \"Dandelion\",\n    \"name\": \"yellow_flower\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      37\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 38,\n    \"displayName\": \"Poppy\",\n    \"name\": \"red_flower\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      38\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 39,\n    \"displayName\": \"Brown Mushroom\",\n    \"name\": \"brown_mushroom\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      39\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 1,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 40,\n    \"displayName\": \"Red Mushroom\",\n    \"name\": \"red_mushroom\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      40\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 1,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 41,\n    \"displayName\": \"Block of Gold\",\n    \"name\": \"gold_block\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      41\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    }\n  },\n  {\n    \"id\": 42,\n    \"displayName\": \"Block of Iron\",\n    \"name\": \"iron_block\",\n    \"hardness\": 5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      42\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"274\": true,\n      \"278\": true\n    }\n  },\n  {\n    \"id\": 43,\n    \"displayName\": \"Double Stone Slab\",\n    \"name\": \"double_stone_slab\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      44\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 44,\n    \"displayName\": \"Stone Slab\",\n    \"name\": \"stone_slab\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      44\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 45,\n    \"displayName\": \"Bricks\",\n    \"name\": \"brick_block\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      45\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 46,\n    \"displayName\": \"TNT\",\n    \"name\": \"tnt\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      46\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 47,\n    \"displayName\": \"Bookshelf\",\n    \"name\": \"bookshelf\",\n    \"hardness\": 1.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      340\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 48,\n    \"displayName\": \"Moss Stone\",\n    \"name\": \"mossy_cobblestone\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      48\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 49,\n    \"displayName\": \"Obsidian\",\n    \"name\": \"obsidian\",\n    \"hardness\": 50,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      49\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"278\": true\n    }\n  },\n  {\n    \"id\": 50,\n    \"displayName\": \"Torch\",\n    \"name\": \"torch\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      50\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 51,\n    \"displayName\": \"Fire\",\n    \"name\": \"fire\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 15,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 52,\n    \"displayName\": \"Monster Spawner\",\n    \"name\": \"mob_spawner\",\n    \"hardness\": 5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 53,\n    \"displayName\": \"Oak Wood Stairs\",\n    \"name\": \"oak_stairs\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      53\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 54,\n    \"displayName\": \"Chest\",\n    \"name\": \"chest\",\n    \"hardness\": 2.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      54\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 55,\n    \"displayName\": \"Redstone Wire\",\n    \"name\": \"redstone_wire\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      331\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 56,\n    \"displayName\": \"Diamond Ore\",\n    \"name\": \"diamond_ore\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      264\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    }\n  },\n  {\n    \"id\": 57,\n    \"displayName\": \"Block of Diamond\",\n    \"name\": \"diamond_block\",\n    \"hardness\": 5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      57\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    }\n  },\n  {\n    \"id\": 58,\n    \"displayName\": \"Crafting Table\",\n    \"name\": \"crafting_table\",\n    \"hardness\": 2.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      58\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 59,\n    \"displayName\": \"Wheat\",\n    \"name\": \"wheat\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      295,\n      295,\n      296\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 60,\n    \"displayName\": \"Farmland\",\n    \"name\": \"farmland\",\n    \"hardness\": 0.6,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      3\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 61,\n    \"displayName\": \"Furnace\",\n    \"name\": \"furnace\",\n    \"hardness\": 3.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      61\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 13,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 62,\n    \"displayName\": \"Burning Furnace\",\n    \"name\": \"lit_furnace\",\n    \"hardness\": 3.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      61\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 13,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 63,\n    \"displayName\": \"Standing Sign\",\n    \"name\": \"standing_sign\",\n    \"hardness\": 1,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      323\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 64,\n    \"displayName\": \"Oak Door\",\n    \"name\": \"wooden_door\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      64\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 65,\n    \"displayName\": \"Ladder\",\n    \"name\": \"ladder\",\n    \"hardness\": 0.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      65\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 66,\n    \"displayName\": \"Rail\",\n    \"name\": \"rail\",\n    \"hardness\": 0.7,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      66\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 67,\n    \"displayName\": \"Cobblestone Stairs\",\n    \"name\": \"stone_stairs\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      67\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 68,\n    \"displayName\": \"Wall Sign\",\n    \"name\": \"wall_sign\",\n    \"hardness\": 1,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      323\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 69,\n    \"displayName\": \"Lever\",\n    \"name\": \"lever\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      69\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 70,\n    \"displayName\": \"Stone Pressure Plate\",\n    \"name\": \"stone_pressure_plate\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      70\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 71,\n    \"displayName\": \"Iron Door\",\n    \"name\": \"iron_door\",\n    \"hardness\": 5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      71\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 72,\n    \"displayName\": \"Wooden Pressure Plate\",\n    \"name\": \"wooden_pressure_plate\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      72\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 73,\n    \"displayName\": \"Redstone Ore\",\n    \"name\": \"redstone_ore\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      331\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 9,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    }\n  },\n  {\n    \"id\": 74,\n    \"displayName\": \"Glowing Redstone Ore\",\n    \"name\": \"lit_redstone_ore\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      331\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 9,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    }\n  },\n  {\n    \"id\": 75,\n    \"displayName\": \"Redstone Torch (inactive)\",\n    \"name\": \"unlit_redstone_torch\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      75\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 7,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 76,\n    \"displayName\": \"Redstone Torch (active)\",\n    \"name\": \"redstone_torch\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      76\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 7,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 77,\n    \"displayName\": \"Stone Button\",\n    \"name\": \"stone_button\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      77\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 78,\n    \"displayName\": \"Snow (layer)\",\n    \"name\": \"snow_layer\",\n    \"hardness\": 0.2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      332\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\",\n    \"harvestTools\": {\n      \"256\": true,\n      \"269\": true,\n      \"273\": true,\n      \"277\": true,\n      \"284\": true\n    }\n  },\n  {\n    \"id\": 79,\n    \"displayName\": \"Ice\",\n    \"name\": \"ice\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 80,\n    \"displayName\": \"Snow\",\n    \"name\": \"snow\",\n    \"hardness\": 0.2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      332\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\",\n    \"harvestTools\": {\n      \"256\": true,\n      \"269\": true,\n      \"273\": true,\n      \"277\": true,\n      \"284\": true\n    }\n  },\n  {\n    \"id\": 81,\n    \"displayName\": \"Cactus\",\n    \"name\": \"cactus\",\n    \"hardness\": 0.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      81\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 82,\n    \"displayName\": \"Clay\",\n    \"name\": \"clay\",\n    \"hardness\": 0.6,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      337\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 83,\n    \"displayName\": \"Sugar Cane\",\n    \"name\": \"reeds\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      83\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 84,\n    \"displayName\": \"Jukebox\",\n    \"name\": \"jukebox\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      84\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 85,\n    \"displayName\": \"Oak Fence\",\n    \"name\": \"fence\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      85\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 86,\n    \"displayName\": \"Pumpkin\",\n    \"name\": \"pumpkin\",\n    \"hardness\": 1,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      86\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 87,\n    \"displayName\": \"Netherrack\",\n    \"name\": \"netherrack\",\n    \"hardness\": 0.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      87\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 88,\n    \"displayName\": \"Soul Sand\",\n    \"name\": \"soul_sand\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      88\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 89,\n    \"displayName\": \"Glowstone\",\n    \"name\": \"glowstone\",\n    \"hardness\": 0.3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      348\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 90,\n    \"displayName\": \"Nether Portal\",\n    \"name\": \"portal\",\n    \"hardness\": null,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 11,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 91,\n    \"displayName\": \"Jack o'Lantern\",\n    \"name\": \"lit_pumpkin\",\n    \"hardness\": 1,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      91\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 15,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 92,\n    \"displayName\": \"Cake\",\n    \"name\": \"cake\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 93,\n    \"displayName\": \"Redstone Repeater (inactive)\",\n    \"name\": \"unpowered_repeater\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      356\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 94,\n    \"displayName\": \"Redstone Repeater (active)\",\n    \"name\": \"powered_repeater\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      356\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 95,\n    \"displayName\": \"Invisible Bedrock\",\n    \"name\": \"invisibleBedrock\",\n    \"hardness\": null,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 96,\n    \"displayName\": \"Trapdoor\",\n    \"name\": \"trapdoor\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      96\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 97,\n    \"displayName\": \"Monster Egg\",\n    \"name\": \"monster_egg\",\n    \"hardness\": 0.75,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 98,\n    \"displayName\": \"Stone Bricks\",\n    \"name\": \"stonebrick\",\n    \"hardness\": 1.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      98\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 99,\n    \"displayName\": \"Brown Mushroom (block)\",\n    \"name\": \"brown_mushroom_block\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      40\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 100,\n    \"displayName\": \"Red Mushroom (block)\",\n    \"name\": \"red_mushroom_block\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      40\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 101,\n    \"displayName\": \"Iron Bars\",\n    \"name\": \"iron_bars\",\n    \"hardness\": 5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      101\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 102,\n    \"displayName\": \"Glass Pane\",\n    \"name\": \"glass_pane\",\n    \"hardness\": 0.3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 103,\n    \"displayName\": \"Melon\",\n    \"name\": \"melon_block\",\n    \"hardness\": 1,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      360\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 104,\n    \"displayName\": \"Pumpkin Stem\",\n    \"name\": \"pumpkin_stem\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      361\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 105,\n    \"displayName\": \"Melon Stem\",\n    \"name\": \"melon_stem\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      362\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 106,\n    \"displayName\": \"Vines\",\n    \"name\": \"vine\",\n    \"hardness\": 0.2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      106\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 107,\n    \"displayName\": \"Fence Gate\",\n    \"name\": \"fence_gate\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      107\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 108,\n    \"displayName\": \"Brick Stairs\",\n    \"name\": \"brick_stairs\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      108\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 109,\n    \"displayName\": \"Stone Brick Stairs\",\n    \"name\": \"stone_brick_stairs\",\n    \"hardness\": 1.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      109\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 110,\n    \"displayName\": \"Mycelium\",\n    \"name\": \"mycelium\",\n    \"hardness\": 0.6,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      3\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 111,\n    \"displayName\": \"Lily Pad\",\n    \"name\": \"waterlily\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      111\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 112,\n    \"displayName\": \"Nether Brick\",\n    \"name\": \"nether_brick\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      112\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 113,\n    \"displayName\": \"Nether Brick Fence\",\n    \"name\": \"nether_brick_fence\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      113\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 114,\n    \"displayName\": \"Nether Brick Stairs\",\n    \"name\": \"nether_brick_stairs\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      114\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 115,\n    \"displayName\": \"Nether Wart\",\n    \"name\": \"nether_wart\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      372,\n      372\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 116,\n    \"displayName\": \"Enchantment Table\",\n    \"name\": \"enchanting_table\",\n    \"hardness\": 5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      116\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 117,\n    \"displayName\": \"Brewing Stand\",\n    \"name\": \"brewing_stand\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      379\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 1,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 118,\n    \"displayName\": \"Cauldron\",\n    \"name\": \"cauldron\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      380\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 119,\n    \"displayName\": \"End Portal\",\n    \"name\": \"end_portal\",\n    \"hardness\": null,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 15,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 120,\n    \"displayName\": \"End Portal Frame\",\n    \"name\": \"end_portal_frame\",\n    \"hardness\": null,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      120\n    ],\n    \"diggable\": false,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 1,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 121,\n    \"displayName\": \"End Stone\",\n    \"name\": \"end_stone\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      121\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 122,\n    \"displayName\": \"Dragon Egg\",\n    \"name\": \"dragon_egg\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      122\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 123,\n    \"displayName\": \"Redstone Lamp (inactive)\",\n    \"name\": \"redstone_lamp\",\n    \"hardness\": 0.3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      123\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 15,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 124,\n    \"displayName\": \"Redstone Lamp (active)\",\n    \"name\": \"lit_redstone_lamp\",\n    \"hardness\": 0.3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      124\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 15,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 125,\n    \"displayName\": \"Dropper\",\n    \"name\": \"dropper\",\n    \"hardness\": 3.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      158\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 126,\n    \"displayName\": \"Activator Rail\",\n    \"name\": \"activator_rail\",\n    \"hardness\": 0.7,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      157\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 127,\n    \"displayName\": \"Cocoa\",\n    \"name\": \"cocoa\",\n    \"hardness\": 0.2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      351,\n      351\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 128,\n    \"displayName\": \"Sandstone Stairs\",\n    \"name\": \"sandstone_stairs\",\n    \"hardness\": 0.8,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      128\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 129,\n    \"displayName\": \"Emerald Ore\",\n    \"name\": \"emerald_ore\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      388\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    }\n  },\n  {\n    \"id\": 130,\n    \"displayName\": \"Ender Chest\",\n    \"name\": \"ender_chest\",\n    \"hardness\": 22.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      49\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 131,\n    \"displayName\": \"Tripwire Hook\",\n    \"name\": \"tripwire_hook\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      131\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 132,\n    \"displayName\": \"Tripwire\",\n    \"name\": \"tripWire\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      287\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 133,\n    \"displayName\": \"Block of Emerald\",\n    \"name\": \"emerald_block\",\n    \"hardness\": 5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      133\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"278\": true\n    }\n  },\n  {\n    \"id\": 134,\n    \"displayName\": \"Spruce Wood Stairs\",\n    \"name\": \"spruce_stairs\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      134\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 135,\n    \"displayName\": \"Birch Wood Stairs\",\n    \"name\": \"birch_stairs\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      135\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 136,\n    \"displayName\": \"Jungle Wood Stairs\",\n    \"name\": \"jungle_stairs\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      136\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 137,\n    \"displayName\": \"Command Block\",\n    \"name\": \"command_block\",\n    \"hardness\": null,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 138,\n    \"displayName\": \"Beacon\",\n    \"name\": \"beacon\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      138\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 15,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 139,\n    \"displayName\": \"Cobblestone Wall\",\n    \"name\": \"cobblestone_wall\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      139\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 140,\n    \"displayName\": \"Flower Pot\",\n    \"name\": \"flower_pot\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      140\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 141,\n    \"displayName\": \"Carrot\",\n    \"name\": \"carrots\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      141\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 142,\n    \"displayName\": \"Potato\",\n    \"name\": \"potatoes\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      142\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 143,\n    \"displayName\": \"Wooden Button\",\n    \"name\": \"wooden_button\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      143\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 144,\n    \"displayName\": \"Mob head\",\n    \"name\": \"skull\",\n    \"hardness\": 1,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      144\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 145,\n    \"displayName\": \"Anvil\",\n    \"name\": \"anvil\",\n    \"hardness\": 5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      145\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 146,\n    \"displayName\": \"Trapped Chest\",\n    \"name\": \"trapped_chest\",\n    \"hardness\": 2.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      146\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 147,\n    \"displayName\": \"Weighted Pressure Plate (Light)\",\n    \"name\": \"light_weighted_pressure_plate\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      147\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 148,\n    \"displayName\": \"Weighted Pressure Plate (Heavy)\",\n    \"name\": \"heavy_weighted_pressure_plate\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      148\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 149,\n    \"displayName\": \"Redstone Comparator\",\n    \"name\": \"unpowered_comparator\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      404\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 150,\n    \"displayName\": \"Redstone Comparator (deprecated)\",\n    \"name\": \"powered_comparator\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      404\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 151,\n    \"displayName\": \"Daylight Sensor\",\n    \"name\": \"daylight_detector\",\n    \"hardness\": 0.2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      151\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 152,\n    \"displayName\": \"Block of Redstone\",\n    \"name\": \"redstone_block\",\n    \"hardness\": 5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      152\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 153,\n    \"displayName\": \"Nether Quartz Ore\",\n    \"name\": \"quartz_ore\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      406\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 154,\n    \"displayName\": \"Hopper\",\n    \"name\": \"hopper\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      154\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 155,\n    \"displayName\": \"Block of Quartz\",\n    \"name\": \"quartz_block\",\n    \"hardness\": 0.8,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      155\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 156,\n    \"displayName\": \"Quartz Stairs\",\n    \"name\": \"quartz_stairs\",\n    \"hardness\": 0.8,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      156\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 157,\n    \"displayName\": \"Double Wooden Slab\",\n    \"name\": \"double_wooden_slab\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      44\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 158,\n    \"displayName\": \"Wooden Slab\",\n    \"name\": \"wooden_slab\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      44\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 159,\n    \"displayName\": \"Stained Clay\",\n    \"name\": \"stained_hardened_clay\",\n    \"hardness\": 1.25,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      159\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 160,\n    \"displayName\": \"Stained Glass Pane\",\n    \"name\": \"stained_glass_pane\",\n    \"hardness\": 0.3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 161,\n    \"displayName\": \"Leaves (Acacia/Dark Oak)\",\n    \"name\": \"leaves2\",\n    \"hardness\": 0.2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      6,\n      260\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 162,\n    \"displayName\": \"Wood (Acacia/Dark Oak)\",\n    \"name\": \"log2\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      162\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 163,\n    \"displayName\": \"Acacia Wood Stairs\",\n    \"name\": \"acacia_stairs\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      163\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 164,\n    \"displayName\": \"Dark Oak Wood Stairs\",\n    \"name\": \"dark_oak_stairs\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      164\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 165,\n    \"displayName\": \"Slime Block\",\n    \"name\": \"slime\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      165\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 167,\n    \"displayName\": \"Iron Trapdoor\",\n    \"name\": \"iron_trapdoor\",\n    \"hardness\": 5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      167\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 168,\n    \"displayName\": \"Prismarine\",\n    \"name\": \"prismarine\",\n    \"hardness\": 1.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      168\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 169,\n    \"displayName\": \"Sea Lantern\",\n    \"name\": \"seaLantern\",\n    \"hardness\": 0.3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      410\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 170,\n    \"displayName\": \"Hay Bale\",\n    \"name\": \"hay_block\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      170\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 171,\n    \"displayName\": \"Carpet\",\n    \"name\": \"carpet\",\n    \"hardness\": 0.1,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      171\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 172,\n    \"displayName\": \"Hardened Clay\",\n    \"name\": \"hardened_clay\",\n    \"hardness\": 1.25,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      172\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 173,\n    \"displayName\": \"Block of Coal\",\n    \"name\": \"coal_block\",\n    \"hardness\": 5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      173\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 174,\n    \"displayName\": \"Packed Ice\",\n    \"name\": \"packed_ice\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 175,\n    \"displayName\": \"Large Flowers\",\n    \"name\": \"double_plant\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      175\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 176,\n    \"displayName\": \"Standing Banner\",\n    \"name\": \"standing_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      176\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 177,\n    \"displayName\": \"Wall Banner\",\n    \"name\": \"wall_banner\",\n    \"hardness\": 1,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      177\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 16,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 178,\n    \"displayName\": \"Inverted Daylight Sensor\",\n    \"name\": \"daylight_detector_inverted\",\n    \"hardness\": 0.2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      178\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 179,\n    \"displayName\": \"Red Sandstone\",\n    \"name\": \"red_sandstone\",\n    \"hardness\": 0.8,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      179\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 180,\n    \"displayName\": \"Red Sandstone Stairs\",\n    \"name\": \"red_sandstone_stairs\",\n    \"hardness\": 0.8,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      180\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 181,\n    \"displayName\": \"Double Red Sandstone Slab\",\n    \"name\": \"double_stone_slab2\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      44\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 182,\n    \"displayName\": \"Red Sandstone Slab\",\n    \"name\": \"stone_slab2\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      44\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 183,\n    \"displayName\": \"Spruce Fence Gate\",\n    \"name\": \"spruce_fence_gate\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      183\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 184,\n    \"displayName\": \"Birch Fence Gate\",\n    \"name\": \"birch_fence_gate\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      184\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 185,\n    \"displayName\": \"Jungle Fence Gate\",\n    \"name\": \"jungle_fence_gate\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      185\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 186,\n    \"displayName\": \"Dark Oak Fence Gate\",\n    \"name\": \"dark_oak_fence_gate\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      186\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 187,\n    \"displayName\": \"Acacia Fence Gate\",\n    \"name\": \"acacia_fence_gate\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      187\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 188,\n    \"displayName\": \"Repeating Command Block\",\n    \"name\": \"repeating_command_block\",\n    \"hardness\": null,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 189,\n    \"displayName\": \"Chain Command Block\",\n    \"name\": \"chain_command_block\",\n    \"hardness\": null,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 193,\n    \"displayName\": \"Spruce Door\",\n    \"name\": \"spruce_door\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      193\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 194,\n    \"displayName\": \"Birch Door\",\n    \"name\": \"birch_door\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      194\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 195,\n    \"displayName\": \"Jungle Door\",\n    \"name\": \"jungle_door\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      195\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 196,\n    \"displayName\": \"Acacia Door\",\n    \"name\": \"acacia_door\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      196\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 197,\n    \"displayName\": \"Dark Oak Door\",\n    \"name\": \"dark_oak_door\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      197\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 198,\n    \"displayName\": \"Grass Path\",\n    \"name\": \"grass_path\",\n    \"hardness\": 0.6,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 199,\n    \"displayName\": \"Item Frame\",\n    \"name\": \"frame\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 200,\n    \"displayName\": \"Chorus Flower\",\n    \"name\": \"chorus_flower\",\n    \"hardness\": 0.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      200\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 201,\n    \"displayName\": \"Purpur Block\",\n    \"name\": \"purpur_block\",\n    \"hardness\": 1.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      201\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 203,\n    \"displayName\": \"Purpur Stairs\",\n    \"name\": \"purpur_stairs\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      203\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 205,\n    \"displayName\": \"Shulker Box\",\n    \"name\": \"undyed_shulker_box\",\n    \"hardness\": 6,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      229\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 206,\n    \"displayName\": \"End Stone Bricks\",\n    \"name\": \"end_bricks\",\n    \"hardness\": 0.8,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      206\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 207,\n    \"displayName\": \"Frosted Ice\",\n    \"name\": \"frosted_ice\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 2,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 208,\n    \"displayName\": \"End Rod\",\n    \"name\": \"end_rod\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      198\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 14,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 209,\n    \"displayName\": \"End Gateway\",\n    \"name\": \"end_gateway\",\n    \"hardness\": null,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 15,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 0\n  },\n  {\n    \"id\": 210,\n    \"displayName\": \"Allow\",\n    \"name\": \"allow\",\n    \"hardness\": null,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 211,\n    \"displayName\": \"Deny\",\n    \"name\": \"deny\",\n    \"hardness\": null,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 212,\n    \"displayName\": \"Border\",\n    \"name\": \"border_block\",\n    \"hardness\": null,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 213,\n    \"displayName\": \"Magma Block\",\n    \"name\": \"magma\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      213\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 214,\n    \"displayName\": \"Nether Wart Block\",\n    \"name\": \"nether_wart_block\",\n    \"hardness\": 1,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      214\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 215,\n    \"displayName\": \"Red Nether Brick\",\n    \"name\": \"red_nether_brick\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      215\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 216,\n    \"displayName\": \"Bone Block\",\n    \"name\": \"bone_block\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      216\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 217,\n    \"displayName\": \"Structure Void\",\n    \"name\": \"structure_void\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 218,\n    \"displayName\": \"Shulker Box\",\n    \"name\": \"shulker_box\",\n    \"hardness\": 6,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      219\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 1\n  },\n  {\n    \"id\": 219,\n    \"displayName\": \"Purple Glazed Terracotta\",\n    \"name\": \"purple_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      245\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 220,\n    \"displayName\": \"White Glazed Terracotta\",\n    \"name\": \"white_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      235\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 221,\n    \"displayName\": \"Orange Glazed Terracotta\",\n    \"name\": \"orange_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      236\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 222,\n    \"displayName\": \"Magenta Glazed Terracotta\",\n    \"name\": \"magenta_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      237\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 223,\n    \"displayName\": \"Light Blue Glazed Terracotta\",\n    \"name\": \"light_blue_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      238\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 224,\n    \"displayName\": \"Yellow Glazed Terracotta\",\n    \"name\": \"yellow_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      239\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 225,\n    \"displayName\": \"Lime Glazed Terracotta\",\n    \"name\": \"lime_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      240\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 226,\n    \"displayName\": \"Pink Glazed Terracotta\",\n    \"name\": \"pink_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      241\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 227,\n    \"displayName\": \"Gray Glazed Terracotta\",\n    \"name\": \"gray_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      242\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 228,\n    \"displayName\": \"Light Gray Glazed Terracotta\",\n    \"name\": \"silver_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      243\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 229,\n    \"displayName\": \"Cyan Glazed Terracotta\",\n    \"name\": \"cyan_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      244\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 231,\n    \"displayName\": \"Blue Glazed Terracotta\",\n    \"name\": \"blue_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      246\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 232,\n    \"displayName\": \"Brown Glazed Terracotta\",\n    \"name\": \"brown_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      247\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 233,\n    \"displayName\": \"Green Glazed Terracotta\",\n    \"name\": \"green_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      248\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 234,\n    \"displayName\": \"Red Glazed Terracotta\",\n    \"name\": \"red_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      249\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 235,\n    \"displayName\": \"Black Glazed Terracotta\",\n    \"name\": \"black_glazed_terracotta\",\n    \"hardness\": 1.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      250\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 236,\n    \"displayName\": \"Concrete\",\n    \"name\": \"concrete\",\n    \"hardness\": 1.8,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      251\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 237,\n    \"displayName\": \"Concrete Powder\",\n    \"name\": \"concrete_powder\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      252\n    ],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 240,\n    \"displayName\": \"Chorus Plant\",\n    \"name\": \"chorus_plant\",\n    \"hardness\": 0.4,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      432\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 241,\n    \"displayName\": \"Stained Glass\",\n    \"name\": \"stained_glass\",\n    \"hardness\": 0.3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 243,\n    \"displayName\": \"Podzol\",\n    \"name\": \"podzol\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 244,\n    \"displayName\": \"Beetroots\",\n    \"name\": \"beetroot\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 245,\n    \"displayName\": \"Stonecutter\",\n    \"name\": \"stonecutter\",\n    \"hardness\": 2.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 246,\n    \"displayName\": \"Glowing Obsidian\",\n    \"name\": \"glowingobsidian\",\n    \"hardness\": 50,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 12,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"278\": true\n    }\n  },\n  {\n    \"id\": 247,\n    \"displayName\": \"Nether Reactor Core\",\n    \"name\": \"netherreactor\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\",\n    \"harvestTools\": {\n      \"257\": true,\n      \"274\": true,\n      \"278\": true\n    }\n  },\n  {\n    \"id\": 248,\n    \"displayName\": \"Update Game Block\",\n    \"name\": \"info_update\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 249,\n    \"displayName\": \"Update Game Block\",\n    \"name\": \"info_update2\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"dirt\"\n  },\n  {\n    \"id\": 250,\n    \"displayName\": \"Moving Block\",\n    \"name\": \"movingBlock\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      36\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 251,\n    \"displayName\": \"Observer\",\n    \"name\": \"observer\",\n    \"hardness\": 3.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [\n      218\n    ],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"harvestTools\": {\n      \"257\": true,\n      \"270\": true,\n      \"274\": true,\n      \"278\": true,\n      \"285\": true\n    }\n  },\n  {\n    \"id\": 252,\n    \"displayName\": \"Structure Block\",\n    \"name\": \"structure_block\",\n    \"hardness\": null,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": false,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 257,\n    \"displayName\": \"Prismarine Stairs\",\n    \"name\": \"prismarine_stairs\",\n    \"hardness\": 1.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 258,\n    \"displayName\": \"Dark Prismarine Stairs\",\n    \"name\": \"dark_prismarine_stairs\",\n    \"hardness\": 1.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 259,\n    \"displayName\": \"Prismarine Brick Stairs\",\n    \"name\": \"prismarine_bricks_stairs\",\n    \"hardness\": 1.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 260,\n    \"displayName\": \"Stripped Spruce Log\",\n    \"name\": \"stripped_spruce_log\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 261,\n    \"displayName\": \"Stripped Birch Log\",\n    \"name\": \"stripped_birch_log\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 262,\n    \"displayName\": \"Stripped Jungle Log\",\n    \"name\": \"stripped_jungle_log\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 263,\n    \"displayName\": \"Stripped Acacia Log\",\n    \"name\": \"stripped_acacia_log\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 264,\n    \"displayName\": \"Stripped Dark Oak Log\",\n    \"name\": \"stripped_dark_oak_log\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 265,\n    \"displayName\": \"Stripped Oak Log\",\n    \"name\": \"stripped_oak_log\",\n    \"hardness\": 2,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 266,\n    \"displayName\": \"Blue Ice\",\n    \"name\": \"blue_ice\",\n    \"hardness\": 2.8,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 385,\n    \"displayName\": \"Seagrass\",\n    \"name\": \"seagrass\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 386,\n    \"displayName\": \"Tube Coral\",\n    \"name\": \"coral\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 387,\n    \"displayName\": \"Tube Coral Block\",\n    \"name\": \"coral_block\",\n    \"hardness\": 1.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 388,\n    \"displayName\": \"Tube Coral Fan\",\n    \"name\": \"coral_fan\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 389,\n    \"displayName\": \"Dead Tube Coral Fan\",\n    \"name\": \"coral_fan_dead\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 390,\n    \"displayName\": \"Tube Coral Wall Fan\",\n    \"name\": \"coral_fan_hang\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 391,\n    \"displayName\": \"Brain Coral Wall Fan\",\n    \"name\": \"coral_fan_hang2\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 392,\n    \"displayName\": \"Bubble Coral Wall Fan\",\n    \"name\": \"coral_fan_hang3\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 393,\n    \"displayName\": \"Kelp\",\n    \"name\": \"kelp\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 394,\n    \"displayName\": \"Dried Kelp Block\",\n    \"name\": \"dried_kelp_block\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 395,\n    \"displayName\": \"Acacia Button\",\n    \"name\": \"acacia_button\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 396,\n    \"displayName\": \"Birch Button\",\n    \"name\": \"birch_button\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 397,\n    \"displayName\": \"Dark Oak Button\",\n    \"name\": \"dark_oak_button\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 398,\n    \"displayName\": \"Jungle Button\",\n    \"name\": \"jungle_button\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 399,\n    \"displayName\": \"Spruce Button\",\n    \"name\": \"spruce_button\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 400,\n    \"displayName\": \"Acacia Trapdoor\",\n    \"name\": \"acacia_trapdoor\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 401,\n    \"displayName\": \"Birch Trapdoor\",\n    \"name\": \"birch_trapdoor\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 402,\n    \"displayName\": \"Dark Oak Trapdoor\",\n    \"name\": \"dark_oak_trapdoor\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 403,\n    \"displayName\": \"Jungle Trapdoor\",\n    \"name\": \"jungle_trapdoor\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 404,\n    \"displayName\": \"Spruce Trapdoor\",\n    \"name\": \"spruce_trapdoor\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 405,\n    \"displayName\": \"Acacia Pressure Plate\",\n    \"name\": \"acacia_pressure_plate\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 406,\n    \"displayName\": \"Birch Pressure Plate\",\n    \"name\": \"birch_pressure_plate\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 407,\n    \"displayName\": \"Dark Oak Pressure Plate\",\n    \"name\": \"dark_oak_pressure_plate\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 408,\n    \"displayName\": \"Jungle Pressure Plate\",\n    \"name\": \"jungle_pressure_plate\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 409,\n    \"displayName\": \"Spruce Pressure Plate\",\n    \"name\": \"spruce_pressure_plate\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 64,\n    \"material\": \"wood\"\n  },\n  {\n    \"id\": 410,\n    \"displayName\": \"Carved Pumpkin\",\n    \"name\": \"carved_pumpkin\",\n    \"hardness\": 1,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": false,\n    \"filterLight\": 15,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"plant\"\n  },\n  {\n    \"id\": 411,\n    \"displayName\": \"Sea Pickle\",\n    \"name\": \"sea_pickle\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 412,\n    \"displayName\": \"Conduit\",\n    \"name\": \"conduit\",\n    \"hardness\": 3,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 15,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64,\n    \"material\": \"rock\"\n  },\n  {\n    \"id\": 414,\n    \"displayName\": \"Turtle Egg\",\n    \"name\": \"turtle_egg\",\n    \"hardness\": 0.5,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"block\",\n    \"stackSize\": 64\n  },\n  {\n    \"id\": 415,\n    \"displayName\": \"Bubble Column\",\n    \"name\": \"bubble_column\",\n    \"hardness\": 0,\n    \"minStateId\": 0,\n    \"maxStateId\": 0,\n    \"states\": [],\n    \"drops\": [],\n    \"diggable\": true,\n    \"transparent\": true,\n    \"filterLight\": 0,\n    \"emitLight\": 0,\n    \"boundingBox\": \"empty\",\n    \"stackSize\": 0\n  }\n]\n"
 
 // Assets returns go-assets FileSystem
-var Assets = assets.NewFileSystem(map[string][]string{"/": []string{"static"}, "/static": []string{"LICENSE.md"}, "/static/v112": []string{"blocks.json"}, "/static/v113": []string{"blocks.json"}}, map[string]*assets.File{
+var Assets = assets.NewFileSystem(map[string][]string{"/": []string{"static"}, "/static": []string{"LICENSE.md", "bedrock", "v112", "v113"}, "/static/bedrock": []string{"v12", "v14"}, "/static/bedrock/v12": []string{"blocks.json"}, "/static/bedrock/v14": []string{"blocks.json"}, "/static/v112": []string{"blocks.json"}, "/static/v113": []string{"blocks.json"}}, map[string]*assets.File{
 	"/static/LICENSE.md": &assets.File{
 		Path:     "/static/LICENSE.md",
 		FileMode: 0x1b6,
@@ -47,4 +49,29 @@ var Assets = assets.NewFileSystem(map[string][]string{"/": []string{"static"}, "
 		FileMode: 0x800001ff,
 		Mtime:    time.Unix(1553697865, 1553697865543243500),
 		Data:     nil,
+	}, "/static/bedrock": &assets.File{
+		Path:     "/static/bedrock",
+		FileMode: 0x800001ff,
+		Mtime:    time.Unix(1792395945, 1792395945983918057),
+		Data:     nil,
+	}, "/static/bedrock/v12": &assets.File{
+		Path:     "/static/bedrock/v12",
+		FileMode: 0x800001ff,
+		Mtime:    time.Unix(1792395945, 1792395945983918057),
+		Data:     nil,
+	}, "/static/bedrock/v12/blocks.json": &assets.File{
+		Path:     "/static/bedrock/v12/blocks.json",
+		FileMode: 0x1b6,
+		Mtime:    time.Unix(1792395945, 1792395945983918057),
+		Data:     []byte(_Assets7c11deca5d55bf71edf4e2001372e9bf41d182cf),
+	}, "/static/bedrock/v14": &assets.File{
+		Path:     "/static/bedrock/v14",
+		FileMode: 0x800001ff,
+		Mtime:    time.Unix(1792395945, 1792395945983918057),
+		Data:     nil,
+	}, "/static/bedrock/v14/blocks.json": &assets.File{
+		Path:     "/static/bedrock/v14/blocks.json",
+		FileMode: 0x1b6,
+		Mtime:    time.Unix(1792395945, 1792395945983918057),
+		Data:     []byte(_Assetse53ebccfa4f093da10828375f905c9d175d680e3),
 	}}, "")
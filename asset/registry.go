@@ -0,0 +1,298 @@
+package asset
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Edition is a edition of minecraft
+type Edition int
+
+const (
+	// JavaEdition is minecraft java edition (mcje)
+	JavaEdition Edition = iota
+
+	// BedrockEdition is minecraft bedrock edition (mcbe)
+	BedrockEdition
+)
+
+// Name returns the name of edition
+func (e Edition) Name() string {
+	switch e {
+	case JavaEdition:
+		return "java"
+	case BedrockEdition:
+		return "bedrock"
+	}
+
+	return "unknown"
+}
+
+const (
+	// DataVersionV112 is DataVersion of mcje v1.12
+	DataVersionV112 = 1139
+
+	// DataVersionV113 is DataVersion of mcje v1.13
+	// Blocks are flattened after the version
+	DataVersionV113 = 1519
+)
+
+// StaticPath is a root path of bundled assets
+const StaticPath = "/static"
+
+// DataSet is a set of data files for a game version
+type DataSet struct {
+	// Edition is a edition of the data set
+	Edition Edition
+
+	// Version is a game version such as "1.13"
+	Version string
+
+	// DataVersion is DataVersion in mcje chunks and level.dat
+	// It's 0 if the edition doesn't have DataVersion
+	DataVersion int
+
+	// Path is a directory of the data set
+	// It's relative path from StaticPath and override directories
+	Path string
+
+	// Legacy is whether blocks are stored as numeric id and meta (before flattening)
+	Legacy bool
+}
+
+// NewRegistry returns new Registry
+func NewRegistry() *Registry {
+	return &Registry{
+		mutex: new(sync.RWMutex),
+	}
+}
+
+// DefaultRegistry is a registry with bundled data sets
+var DefaultRegistry = newDefaultRegistry()
+
+func newDefaultRegistry() *Registry {
+	reg := NewRegistry()
+
+	reg.Register(&DataSet{
+		Edition:     JavaEdition,
+		Version:     "1.12",
+		DataVersion: DataVersionV112,
+		Path:        "v112",
+		Legacy:      true,
+	})
+
+	reg.Register(&DataSet{
+		Edition:     JavaEdition,
+		Version:     "1.13",
+		DataVersion: DataVersionV113,
+		Path:        "v113",
+	})
+
+	reg.Register(&DataSet{
+		Edition: BedrockEdition,
+		Version: "1.2",
+		Path:    "bedrock/v12",
+		Legacy:  true,
+	})
+
+	reg.Register(&DataSet{
+		Edition: BedrockEdition,
+		Version: "1.4",
+		Path:    "bedrock/v14",
+	})
+
+	return reg
+}
+
+// Registry manages data sets for several versions
+// Files are searched in override directories at first, and then bundled assets
+type Registry struct {
+	sets      []*DataSet
+	overrides []string
+
+	mutex *sync.RWMutex
+}
+
+// Register registers a data set
+// If the same edition and version is already registered, it's replaced
+func (reg *Registry) Register(set *DataSet) {
+	reg.mutex.Lock()
+	defer reg.mutex.Unlock()
+
+	for i, s := range reg.sets {
+		if s.Edition == set.Edition && s.Version == set.Version {
+			reg.sets[i] = set
+			return
+		}
+	}
+
+	reg.sets = append(reg.sets, set)
+}
+
+// DataSets returns all registered data sets
+func (reg *Registry) DataSets() []*DataSet {
+	reg.mutex.RLock()
+	defer reg.mutex.RUnlock()
+
+	result := make([]*DataSet, len(reg.sets))
+	copy(result, reg.sets)
+
+	return result
+}
+
+// ByVersion returns a data set by edition and game version
+// If there is no data set for the version, returns the newest one which isn't newer than the version
+func (reg *Registry) ByVersion(edition Edition, version string) (*DataSet, bool) {
+	reg.mutex.RLock()
+	defer reg.mutex.RUnlock()
+
+	var result *DataSet
+	for _, set := range reg.sets {
+		if set.Edition != edition {
+			continue
+		}
+
+		cmp := CompareVersion(set.Version, version)
+		if cmp == 0 {
+			return set, true
+		}
+
+		if cmp < 0 && (result == nil || CompareVersion(result.Version, set.Version) < 0) {
+			result = set
+		}
+	}
+
+	return result, result != nil
+}
+
+// ByDataVersion returns the newest java edition data set which isn't newer than DataVersion ver
+func (reg *Registry) ByDataVersion(ver int) (*DataSet, bool) {
+	reg.mutex.RLock()
+	defer reg.mutex.RUnlock()
+
+	var result *DataSet
+	for _, set := range reg.sets {
+		if set.Edition != JavaEdition || set.DataVersion == 0 || set.DataVersion > ver {
+			continue
+		}
+
+		if result == nil || result.DataVersion < set.DataVersion {
+			result = set
+		}
+	}
+
+	return result, result != nil
+}
+
+// AddOverride adds a directory to override bundled data files
+// A file is searched at dir/<DataSet.Path>/<name>
+// The directory added later has priority
+func (reg *Registry) AddOverride(dir string) error {
+	dir = filepath.Clean(dir)
+
+	f, err := os.Stat(dir)
+	if err != nil {
+		return err
+	}
+
+	if !f.IsDir() {
+		return fmt.Errorf("level.asset: %s isn't a directory", dir)
+	}
+
+	reg.mutex.Lock()
+	reg.overrides = append(reg.overrides, dir)
+	reg.mutex.Unlock()
+
+	return nil
+}
+
+// Overrides returns override directories
+func (reg *Registry) Overrides() []string {
+	reg.mutex.RLock()
+	defer reg.mutex.RUnlock()
+
+	result := make([]string, len(reg.overrides))
+	copy(result, reg.overrides)
+
+	return result
+}
+
+// Open opens a data file of the data set
+func (reg *Registry) Open(set *DataSet, name string) (io.ReadCloser, error) {
+	reg.mutex.RLock()
+	overrides := reg.overrides
+	reg.mutex.RUnlock()
+
+	for i := len(overrides) - 1; i >= 0; i-- {
+		p := filepath.Join(overrides[i], filepath.FromSlash(set.Path), filepath.FromSlash(name))
+
+		f, err := os.Stat(p)
+		if err != nil || f.IsDir() {
+			continue
+		}
+
+		return os.Open(p)
+	}
+
+	file, err := OpenResource(path.Join(StaticPath, set.Path, name))
+	if err != nil {
+		return nil, fmt.Errorf("level.asset: couldn't find %s for %s %s", name, set.Edition.Name(), set.Version)
+	}
+
+	return file, nil
+}
+
+// ReadFile reads a data file of the data set
+func (reg *Registry) ReadFile(set *DataSet, name string) ([]byte, error) {
+	file, err := reg.Open(set, name)
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	return ioutil.ReadAll(file)
+}
+
+// CompareVersion compares game versions such as "1.13.2"
+// It returns -1 if a < b, 1 if a > b and 0 if they're the same version
+func CompareVersion(a, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+
+	return 0
+}
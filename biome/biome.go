@@ -0,0 +1,323 @@
+package biome
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+	"image/color"
+	"sort"
+	"strings"
+
+	"github.com/beito123/level/asset"
+)
+
+// Biome is a biome of a edition
+type Biome struct {
+	// ID is the biome id stored in chunks
+	ID byte
+
+	// Name is the name of the biome such as minecraft:plains
+	Name string
+
+	// Color is a color for biome maps
+	Color color.RGBA
+}
+
+// NewRegistry returns new Registry with biomes
+func NewRegistry(edition asset.Edition, biomes []*Biome) *Registry {
+	reg := &Registry{
+		Edition: edition,
+		byID:    make(map[byte]*Biome, len(biomes)),
+		byName:  make(map[string]*Biome, len(biomes)),
+	}
+
+	for _, b := range biomes {
+		reg.Add(b)
+	}
+
+	return reg
+}
+
+// Registry is a table of biomes of a edition
+type Registry struct {
+	Edition asset.Edition
+
+	byID   map[byte]*Biome
+	byName map[string]*Biome
+}
+
+// Add adds the biome to the registry
+// It overwrites a biome which has the same id
+func (reg *Registry) Add(b *Biome) {
+	old, ok := reg.byID[b.ID]
+	if ok {
+		delete(reg.byName, old.Name)
+	}
+
+	reg.byID[b.ID] = b
+	reg.byName[b.Name] = b
+}
+
+// ByID returns a biome by the id
+func (reg *Registry) ByID(id byte) (*Biome, bool) {
+	b, ok := reg.byID[id]
+
+	return b, ok
+}
+
+// ByName returns a biome by the name
+// The namespace can be omitted
+func (reg *Registry) ByName(name string) (*Biome, bool) {
+	if !strings.Contains(name, ":") {
+		name = "minecraft:" + name
+	}
+
+	b, ok := reg.byName[name]
+
+	return b, ok
+}
+
+// Name returns the name of the biome id
+// It returns "unknown(id)" for unknown biomes
+func (reg *Registry) Name(id byte) string {
+	b, ok := reg.byID[id]
+	if !ok {
+		return fmt.Sprintf("unknown(%d)", id)
+	}
+
+	return b.Name
+}
+
+// Color returns a color of the biome id for biome maps
+// Mutated biomes (128 and after) which aren't registered use the color of the base biome
+// Other unknown biomes are magenta
+func (reg *Registry) Color(id byte) color.RGBA {
+	b, ok := reg.byID[id]
+	if ok {
+		return b.Color
+	}
+
+	if id >= 128 {
+		b, ok = reg.byID[id-128]
+		if ok {
+			return b.Color
+		}
+	}
+
+	return color.RGBA{255, 0, 255, 255}
+}
+
+// Biomes returns all biomes sorted by id
+func (reg *Registry) Biomes() []*Biome {
+	list := make([]*Biome, 0, len(reg.byID))
+	for _, b := range reg.byID {
+		list = append(list, b)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID < list[j].ID
+	})
+
+	return list
+}
+
+// Of returns the registry of the edition
+func Of(edition asset.Edition) *Registry {
+	if edition == asset.BedrockEdition {
+		return Bedrock
+	}
+
+	return Java
+}
+
+func hex(v uint32) color.RGBA {
+	return color.RGBA{
+		R: uint8(v >> 16),
+		G: uint8(v >> 8),
+		B: uint8(v),
+		A: 255,
+	}
+}
+
+func biome(id byte, name string, c uint32) *Biome {
+	return &Biome{
+		ID:    id,
+		Name:  "minecraft:" + name,
+		Color: hex(c),
+	}
+}
+
+// Java is biomes of mcje (v1.13 and v1.14)
+var Java = NewRegistry(asset.JavaEdition, []*Biome{
+	biome(0, "ocean", 0x000070),
+	biome(1, "plains", 0x8DB360),
+	biome(2, "desert", 0xFA9418),
+	biome(3, "mountains", 0x606060),
+	biome(4, "forest", 0x056621),
+	biome(5, "taiga", 0x0B6659),
+	biome(6, "swamp", 0x07F9B2),
+	biome(7, "river", 0x0000FF),
+	biome(8, "nether", 0xBF3B3B),
+	biome(9, "the_end", 0x8080FF),
+	biome(10, "frozen_ocean", 0x7070D6),
+	biome(11, "frozen_river", 0xA0A0FF),
+	biome(12, "snowy_tundra", 0xFFFFFF),
+	biome(13, "snowy_mountains", 0xA0A0A0),
+	biome(14, "mushroom_fields", 0xFF00FF),
+	biome(15, "mushroom_field_shore", 0xA000FF),
+	biome(16, "beach", 0xFADE55),
+	biome(17, "desert_hills", 0xD25F12),
+	biome(18, "wooded_hills", 0x22551C),
+	biome(19, "taiga_hills", 0x163933),
+	biome(20, "mountain_edge", 0x72789A),
+	biome(21, "jungle", 0x537B09),
+	biome(22, "jungle_hills", 0x2C4205),
+	biome(23, "jungle_edge", 0x628B17),
+	biome(24, "deep_ocean", 0x000030),
+	biome(25, "stone_shore", 0xA2A284),
+	biome(26, "snowy_beach", 0xFAF0C0),
+	biome(27, "birch_forest", 0x307444),
+	biome(28, "birch_forest_hills", 0x1F5F32),
+	biome(29, "dark_forest", 0x40511A),
+	biome(30, "snowy_taiga", 0x31554A),
+	biome(31, "snowy_taiga_hills", 0x243F36),
+	biome(32, "giant_tree_taiga", 0x596651),
+	biome(33, "giant_tree_taiga_hills", 0x454F3E),
+	biome(34, "wooded_mountains", 0x507050),
+	biome(35, "savanna", 0xBDB25F),
+	biome(36, "savanna_plateau", 0xA79D64),
+	biome(37, "badlands", 0xD94515),
+	biome(38, "wooded_badlands_plateau", 0xB09765),
+	biome(39, "badlands_plateau", 0xCA8C65),
+	biome(40, "small_end_islands", 0x8080FF),
+	biome(41, "end_midlands", 0x8080FF),
+	biome(42, "end_highlands", 0x8080FF),
+	biome(43, "end_barrens", 0x8080FF),
+	biome(44, "warm_ocean", 0x0000AC),
+	biome(45, "lukewarm_ocean", 0x000090),
+	biome(46, "cold_ocean", 0x202070),
+	biome(47, "deep_warm_ocean", 0x000050),
+	biome(48, "deep_lukewarm_ocean", 0x000040),
+	biome(49, "deep_cold_ocean", 0x202038),
+	biome(50, "deep_frozen_ocean", 0x404090),
+	biome(127, "the_void", 0x000000),
+	biome(129, "sunflower_plains", 0xB5DB88),
+	biome(130, "desert_lakes", 0xFFBC40),
+	biome(131, "gravelly_mountains", 0x888888),
+	biome(132, "flower_forest", 0x2D8E49),
+	biome(133, "taiga_mountains", 0x338E81),
+	biome(134, "swamp_hills", 0x2FFFDA),
+	biome(140, "ice_spikes", 0xB4DCDC),
+	biome(149, "modified_jungle", 0x7BA331),
+	biome(151, "modified_jungle_edge", 0x8AB33F),
+	biome(155, "tall_birch_forest", 0x589C6C),
+	biome(156, "tall_birch_hills", 0x47875A),
+	biome(157, "dark_forest_hills", 0x687942),
+	biome(158, "snowy_taiga_mountains", 0x597D72),
+	biome(160, "giant_spruce_taiga", 0x818E79),
+	biome(161, "giant_spruce_taiga_hills", 0x6D7766),
+	biome(162, "modified_gravelly_mountains", 0x789878),
+	biome(163, "shattered_savanna", 0xE5DA87),
+	biome(164, "shattered_savanna_plateau", 0xCFC58C),
+	biome(165, "eroded_badlands", 0xFF6D3D),
+	biome(166, "modified_wooded_badlands_plateau", 0xD8BF8D),
+	biome(167, "modified_badlands_plateau", 0xF2B48D),
+	biome(168, "bamboo_jungle", 0x768E14),
+	biome(169, "bamboo_jungle_hills", 0x3B470A),
+})
+
+// bedrock returns a biome of mcbe which has the color of the mcje biome
+func bedrock(id byte, name string, java string) *Biome {
+	b, ok := Java.ByName(java)
+	if !ok {
+		panic("level.biome: unknown biome " + java)
+	}
+
+	return &Biome{
+		ID:    id,
+		Name:  "minecraft:" + name,
+		Color: b.Color,
+	}
+}
+
+// Bedrock is biomes of mcbe (v1.14)
+var Bedrock = NewRegistry(asset.BedrockEdition, []*Biome{
+	bedrock(0, "ocean", "ocean"),
+	bedrock(1, "plains", "plains"),
+	bedrock(2, "desert", "desert"),
+	bedrock(3, "extreme_hills", "mountains"),
+	bedrock(4, "forest", "forest"),
+	bedrock(5, "taiga", "taiga"),
+	bedrock(6, "swampland", "swamp"),
+	bedrock(7, "river", "river"),
+	bedrock(8, "hell", "nether"),
+	bedrock(9, "the_end", "the_end"),
+	bedrock(10, "legacy_frozen_ocean", "frozen_ocean"),
+	bedrock(11, "frozen_river", "frozen_river"),
+	bedrock(12, "ice_plains", "snowy_tundra"),
+	bedrock(13, "ice_mountains", "snowy_mountains"),
+	bedrock(14, "mushroom_island", "mushroom_fields"),
+	bedrock(15, "mushroom_island_shore", "mushroom_field_shore"),
+	bedrock(16, "beach", "beach"),
+	bedrock(17, "desert_hills", "desert_hills"),
+	bedrock(18, "forest_hills", "wooded_hills"),
+	bedrock(19, "taiga_hills", "taiga_hills"),
+	bedrock(20, "extreme_hills_edge", "mountain_edge"),
+	bedrock(21, "jungle", "jungle"),
+	bedrock(22, "jungle_hills", "jungle_hills"),
+	bedrock(23, "jungle_edge", "jungle_edge"),
+	bedrock(24, "deep_ocean", "deep_ocean"),
+	bedrock(25, "stone_beach", "stone_shore"),
+	bedrock(26, "cold_beach", "snowy_beach"),
+	bedrock(27, "birch_forest", "birch_forest"),
+	bedrock(28, "birch_forest_hills", "birch_forest_hills"),
+	bedrock(29, "roofed_forest", "dark_forest"),
+	bedrock(30, "cold_taiga", "snowy_taiga"),
+	bedrock(31, "cold_taiga_hills", "snowy_taiga_hills"),
+	bedrock(32, "mega_taiga", "giant_tree_taiga"),
+	bedrock(33, "mega_taiga_hills", "giant_tree_taiga_hills"),
+	bedrock(34, "extreme_hills_plus_trees", "wooded_mountains"),
+	bedrock(35, "savanna", "savanna"),
+	bedrock(36, "savanna_plateau", "savanna_plateau"),
+	bedrock(37, "mesa", "badlands"),
+	bedrock(38, "mesa_plateau_stone", "wooded_badlands_plateau"),
+	bedrock(39, "mesa_plateau", "badlands_plateau"),
+	bedrock(40, "warm_ocean", "warm_ocean"),
+	bedrock(41, "deep_warm_ocean", "deep_warm_ocean"),
+	bedrock(42, "lukewarm_ocean", "lukewarm_ocean"),
+	bedrock(43, "deep_lukewarm_ocean", "deep_lukewarm_ocean"),
+	bedrock(44, "cold_ocean", "cold_ocean"),
+	bedrock(45, "deep_cold_ocean", "deep_cold_ocean"),
+	bedrock(46, "frozen_ocean", "frozen_ocean"),
+	bedrock(47, "deep_frozen_ocean", "deep_frozen_ocean"),
+	bedrock(48, "bamboo_jungle", "bamboo_jungle"),
+	bedrock(49, "bamboo_jungle_hills", "bamboo_jungle_hills"),
+	bedrock(129, "sunflower_plains", "sunflower_plains"),
+	bedrock(130, "desert_mutated", "desert_lakes"),
+	bedrock(131, "extreme_hills_mutated", "gravelly_mountains"),
+	bedrock(132, "flower_forest", "flower_forest"),
+	bedrock(133, "taiga_mutated", "taiga_mountains"),
+	bedrock(134, "swampland_mutated", "swamp_hills"),
+	bedrock(140, "ice_plains_spikes", "ice_spikes"),
+	bedrock(149, "jungle_mutated", "modified_jungle"),
+	bedrock(151, "jungle_edge_mutated", "modified_jungle_edge"),
+	bedrock(155, "birch_forest_mutated", "tall_birch_forest"),
+	bedrock(156, "birch_forest_hills_mutated", "tall_birch_hills"),
+	bedrock(157, "roofed_forest_mutated", "dark_forest_hills"),
+	bedrock(158, "cold_taiga_mutated", "snowy_taiga_mountains"),
+	bedrock(160, "redwood_taiga_mutated", "giant_spruce_taiga"),
+	bedrock(161, "redwood_taiga_hills_mutated", "giant_spruce_taiga_hills"),
+	bedrock(162, "extreme_hills_plus_trees_mutated", "modified_gravelly_mountains"),
+	bedrock(163, "savanna_mutated", "shattered_savanna"),
+	bedrock(164, "savanna_plateau_mutated", "shattered_savanna_plateau"),
+	bedrock(165, "mesa_bryce", "eroded_badlands"),
+	bedrock(166, "mesa_plateau_stone_mutated", "modified_wooded_badlands_plateau"),
+	bedrock(167, "mesa_plateau_mutated", "modified_badlands_plateau"),
+})
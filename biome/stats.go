@@ -0,0 +1,130 @@
+package biome
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"sort"
+
+	"github.com/beito123/level"
+)
+
+// ChunkSize is the width of a chunk in blocks
+const ChunkSize = 16
+
+// NewStats returns new Stats
+func NewStats() *Stats {
+	return &Stats{
+		Counts: make(map[byte]int),
+	}
+}
+
+// Stats is the number of columns by biome id
+type Stats struct {
+	Counts map[byte]int
+
+	// Total is the number of all counted columns
+	Total int
+
+	// Chunks is the number of counted chunks
+	Chunks int
+}
+
+// Entry is the area of a biome
+type Entry struct {
+	ID byte
+
+	// Count is the number of columns
+	Count int
+
+	// Percent is the percentage of the area in all counted columns
+	Percent float64
+}
+
+// AddChunk counts columns of the chunk
+func (stats *Stats) AddChunk(chunk level.Chunk) {
+	for z := 0; z < ChunkSize; z++ {
+		for x := 0; x < ChunkSize; x++ {
+			stats.Counts[chunk.Biome(x, z)]++
+		}
+	}
+
+	stats.Total += ChunkSize * ChunkSize
+	stats.Chunks++
+}
+
+// Percent returns the percentage of the area of the biome id
+func (stats *Stats) Percent(id byte) float64 {
+	if stats.Total == 0 {
+		return 0
+	}
+
+	return float64(stats.Counts[id]) * 100 / float64(stats.Total)
+}
+
+// Entries returns areas of biomes sorted by the count in descending order
+func (stats *Stats) Entries() []Entry {
+	entries := make([]Entry, 0, len(stats.Counts))
+	for id, count := range stats.Counts {
+		entries = append(entries, Entry{
+			ID:      id,
+			Count:   count,
+			Percent: stats.Percent(id),
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Count != entries[j].Count {
+			return entries[i].Count > entries[j].Count
+		}
+
+		return entries[i].ID < entries[j].ID
+	})
+
+	return entries
+}
+
+// Count returns biome stats of chunks from x0, y0 to x1, y1 (exclusive) at chunk coordinate
+// Chunks which aren't generated are skipped
+// Chunks loaded by it are unloaded after counting
+func Count(format level.Format, x0, y0, x1, y1 int) (*Stats, error) {
+	stats := NewStats()
+
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			loaded := format.IsLoadedChunk(x, y)
+			if !loaded {
+				exist, err := format.HasGeneratedChunk(x, y)
+				if err != nil {
+					return nil, err
+				}
+
+				if !exist {
+					continue
+				}
+			}
+
+			chunk, err := format.Chunk(x, y)
+			if err != nil {
+				return nil, err
+			}
+
+			stats.AddChunk(chunk)
+
+			if !loaded { // keeps chunks which were loaded by others
+				err = format.UnloadChunk(x, y)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return stats, nil
+}
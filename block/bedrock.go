@@ -0,0 +1,680 @@
+package block
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// BedrockState is a block state for mcbe v1.13 or after
+// Values of States are bool (byte tag), int (int tag) or string (string tag)
+type BedrockState struct {
+	Name   string
+	States map[string]interface{}
+}
+
+// ToBedrockState converts a block state for mcje v1.13 to a block state for mcbe
+// waterlogged property needs to be split with SplitWaterlogged before
+// If the block or the properties can't be converted, returns an error
+func ToBedrockState(name string, properties map[string]string) (*BedrockState, error) {
+	short := strings.TrimPrefix(name, MinecraftPrefix)
+
+	for _, m := range bedrockIndex.java[short] {
+		states, ok := m.toBedrock(properties)
+		if ok {
+			return &BedrockState{Name: MinecraftPrefix + m.bedrock, States: states}, nil
+		}
+	}
+
+	if _, ok := bedrockIndex.java[short]; !ok && len(properties) == 0 {
+		if list, err := ListBedrock(); err == nil {
+			if _, ok := list.Get(short); ok {
+				return &BedrockState{Name: MinecraftPrefix + short, States: make(map[string]interface{})}, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("level.block: unable to convert %s to a block state for mcbe", FormatState(name, properties))
+}
+
+// FromBedrockState converts a block state for mcbe to a block state for mcje v1.13
+// If the block or the states can't be converted, returns an error
+func FromBedrockState(state *BedrockState) (name string, properties map[string]string, err error) {
+	short := strings.TrimPrefix(state.Name, MinecraftPrefix)
+
+	for _, m := range bedrockIndex.bedrock[short] {
+		properties, ok := m.toJava(state.States)
+		if ok {
+			return MinecraftPrefix + m.java, properties, nil
+		}
+	}
+
+	if _, ok := bedrockIndex.bedrock[short]; !ok && len(state.States) == 0 {
+		if list, err := ListV113(); err == nil {
+			if _, ok := list.Get(short); ok {
+				return MinecraftPrefix + short, make(map[string]string), nil
+			}
+		}
+	}
+
+	return "", nil, fmt.Errorf("level.block: unable to convert %s to a block state for mcje", state)
+}
+
+// String returns a string of the block state such as "minecraft:stone[stone_type=granite]"
+func (state *BedrockState) String() string {
+	properties := make(map[string]string, len(state.States))
+	for key, val := range state.States {
+		properties[key] = fmt.Sprint(val)
+	}
+
+	return FormatState(state.Name, properties)
+}
+
+// stateMapping maps a property of mcje to a state of mcbe
+type stateMapping struct {
+	java    string
+	bedrock string
+
+	toBedrock func(val string) (interface{}, bool)
+	toJava    func(val interface{}) (string, bool)
+}
+
+// boolState returns stateMapping between a bool property and a byte state
+func boolState(java, bedrock string) stateMapping {
+	return enumState(java, bedrock, map[string]interface{}{"true": true, "false": false})
+}
+
+// intState returns stateMapping between an int property and an int state
+func intState(java, bedrock string) stateMapping {
+	return stateMapping{
+		java:    java,
+		bedrock: bedrock,
+		toBedrock: func(val string) (interface{}, bool) {
+			n, err := strconv.Atoi(val)
+
+			return n, err == nil
+		},
+		toJava: func(val interface{}) (string, bool) {
+			n, ok := val.(int)
+
+			return strconv.Itoa(n), ok
+		},
+	}
+}
+
+// stringState returns stateMapping between properties which have the same values
+func stringState(java, bedrock string) stateMapping {
+	return stateMapping{
+		java:    java,
+		bedrock: bedrock,
+		toBedrock: func(val string) (interface{}, bool) {
+			return val, true
+		},
+		toJava: func(val interface{}) (string, bool) {
+			s, ok := val.(string)
+
+			return s, ok
+		},
+	}
+}
+
+// enumState returns stateMapping with values of mcje to values of mcbe
+func enumState(java, bedrock string, values map[string]interface{}) stateMapping {
+	return stateMapping{
+		java:    java,
+		bedrock: bedrock,
+		toBedrock: func(val string) (interface{}, bool) {
+			v, ok := values[val]
+
+			return v, ok
+		},
+		toJava: func(val interface{}) (string, bool) {
+			for key, v := range values {
+				if v == val {
+					return key, true
+				}
+			}
+
+			return "", false
+		},
+	}
+}
+
+// bedrockMapping maps a block of mcje to a block of mcbe
+type bedrockMapping struct {
+	java    string
+	bedrock string
+
+	// when is properties of mcje which select the mapping
+	when map[string]string
+
+	// fixed is states of mcbe which select the mapping
+	fixed map[string]interface{}
+
+	// defaults is states of mcbe which mcje doesn't have
+	defaults map[string]interface{}
+
+	states []stateMapping
+
+	// ignore is properties of mcje which mcbe doesn't have
+	ignore []string
+}
+
+func (m *bedrockMapping) toBedrock(properties map[string]string) (map[string]interface{}, bool) {
+	for key, val := range m.when {
+		if properties[key] != val {
+			return nil, false
+		}
+	}
+
+	states := make(map[string]interface{}, len(m.fixed)+len(m.defaults)+len(m.states))
+	for key, val := range m.defaults {
+		states[key] = val
+	}
+
+	for key, val := range m.fixed {
+		states[key] = val
+	}
+
+	used := len(m.when)
+	for _, s := range m.states {
+		val, ok := properties[s.java]
+		if !ok {
+			continue
+		}
+
+		v, ok := s.toBedrock(val)
+		if !ok {
+			return nil, false
+		}
+
+		states[s.bedrock] = v
+		used++
+	}
+
+	for _, key := range m.ignore {
+		if _, ok := properties[key]; ok {
+			used++
+		}
+	}
+
+	return states, used == len(properties)
+}
+
+func (m *bedrockMapping) toJava(states map[string]interface{}) (map[string]string, bool) {
+	for key, val := range m.fixed {
+		if states[key] != val {
+			return nil, false
+		}
+	}
+
+	properties := make(map[string]string, len(m.when)+len(m.states))
+	for key, val := range m.when {
+		properties[key] = val
+	}
+
+	used := len(m.fixed)
+	for _, s := range m.states {
+		val, ok := states[s.bedrock]
+		if !ok {
+			continue
+		}
+
+		v, ok := s.toJava(val)
+		if !ok {
+			return nil, false
+		}
+
+		properties[s.java] = v
+		used++
+	}
+
+	for key := range m.defaults {
+		_, fixed := m.fixed[key]
+		if _, ok := states[key]; ok && !fixed && !m.hasState(key) {
+			used++
+		}
+	}
+
+	return properties, used == len(states)
+}
+
+func (m *bedrockMapping) hasState(key string) bool {
+	for _, s := range m.states {
+		if s.bedrock == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// bedrockIndex is mappings indexed by block names without the prefix
+var bedrockIndex = newBedrockIndex(bedrockMappings())
+
+type mappingIndex struct {
+	java    map[string][]*bedrockMapping
+	bedrock map[string][]*bedrockMapping
+}
+
+func newBedrockIndex(mappings []*bedrockMapping) *mappingIndex {
+	index := &mappingIndex{
+		java:    make(map[string][]*bedrockMapping),
+		bedrock: make(map[string][]*bedrockMapping),
+	}
+
+	for _, m := range mappings {
+		index.java[m.java] = append(index.java[m.java], m)
+		index.bedrock[m.bedrock] = append(index.bedrock[m.bedrock], m)
+	}
+
+	return index
+}
+
+// Values of states for mcbe
+
+var (
+	// facingDirection is facing_direction
+	facingDirection = map[string]interface{}{"down": 0, "up": 1, "north": 2, "south": 3, "west": 4, "east": 5}
+
+	// direction is direction of fence gates and pumpkins
+	direction = map[string]interface{}{"south": 0, "west": 1, "north": 2, "east": 3}
+
+	// weirdoDirection is weirdo_direction of stairs
+	weirdoDirection = map[string]interface{}{"east": 0, "west": 1, "south": 2, "north": 3}
+
+	// torchFacing is torch_facing_direction of wall torches, the values are opposite
+	torchFacing = map[string]interface{}{"east": "west", "west": "east", "south": "north", "north": "south"}
+
+	// halfTop is upside_down_bit and top_slot_bit
+	halfTop = map[string]interface{}{"top": true, "bottom": false}
+
+	// halfUpper is upper_block_bit
+	halfUpper = map[string]interface{}{"upper": true, "lower": false}
+
+	// railDirection is rail_direction
+	railDirection = map[string]interface{}{
+		"north_south": 0, "east_west": 1,
+		"ascending_east": 2, "ascending_west": 3, "ascending_north": 4, "ascending_south": 5,
+		"south_east": 6, "south_west": 7, "north_west": 8, "north_east": 9,
+	}
+
+	// sides is connections to sides of fences, panes and walls
+	sides = []string{"north", "east", "south", "west"}
+)
+
+// colors is colors of mcje to colors of mcbe
+var colors = [][2]string{
+	{"white", "white"}, {"orange", "orange"}, {"magenta", "magenta"}, {"light_blue", "light_blue"},
+	{"yellow", "yellow"}, {"lime", "lime"}, {"pink", "pink"}, {"gray", "gray"},
+	{"light_gray", "silver"}, {"cyan", "cyan"}, {"purple", "purple"}, {"blue", "blue"},
+	{"brown", "brown"}, {"green", "green"}, {"red", "red"}, {"black", "black"},
+}
+
+// woods is wood types
+var woods = []string{"oak", "spruce", "birch", "jungle", "acacia", "dark_oak"}
+
+// variant returns bedrockMapping for a block which is a variant of a block for mcbe
+func variant(java, bedrock, key string, val interface{}, states ...stateMapping) *bedrockMapping {
+	return &bedrockMapping{
+		java:    java,
+		bedrock: bedrock,
+		fixed:   map[string]interface{}{key: val},
+		states:  states,
+	}
+}
+
+// rename returns bedrockMapping for a block which has a different name in mcbe
+func rename(java, bedrock string, states ...stateMapping) *bedrockMapping {
+	return &bedrockMapping{
+		java:    java,
+		bedrock: bedrock,
+		states:  states,
+	}
+}
+
+// bedrockMappings returns mappings between blocks for mcje v1.13 and blocks for mcbe v1.14
+// Blocks which aren't listed are converted if they have the same name and don't have properties
+func bedrockMappings() []*bedrockMapping {
+	axis := stringState("axis", "pillar_axis")
+	stairs := []stateMapping{enumState("facing", "weirdo_direction", weirdoDirection), enumState("half", "upside_down_bit", halfTop)}
+
+	mappings := []*bedrockMapping{
+		// Stones
+
+		rename("air", "air"),
+		rename("cave_air", "air"),
+		rename("void_air", "air"),
+		variant("stone", "stone", "stone_type", "stone"),
+		variant("granite", "stone", "stone_type", "granite"),
+		variant("polished_granite", "stone", "stone_type", "granite_smooth"),
+		variant("diorite", "stone", "stone_type", "diorite"),
+		variant("polished_diorite", "stone", "stone_type", "diorite_smooth"),
+		variant("andesite", "stone", "stone_type", "andesite"),
+		variant("polished_andesite", "stone", "stone_type", "andesite_smooth"),
+		variant("dirt", "dirt", "dirt_type", "normal"),
+		variant("coarse_dirt", "dirt", "dirt_type", "coarse"),
+		variant("sand", "sand", "sand_type", "normal"),
+		variant("red_sand", "sand", "sand_type", "red"),
+		variant("sandstone", "sandstone", "sand_stone_type", "default"),
+		variant("chiseled_sandstone", "sandstone", "sand_stone_type", "heiroglyphs"),
+		variant("cut_sandstone", "sandstone", "sand_stone_type", "cut"),
+		variant("smooth_sandstone", "sandstone", "sand_stone_type", "smooth"),
+		variant("red_sandstone", "red_sandstone", "sand_stone_type", "default"),
+		variant("chiseled_red_sandstone", "red_sandstone", "sand_stone_type", "heiroglyphs"),
+		variant("cut_red_sandstone", "red_sandstone", "sand_stone_type", "cut"),
+		variant("smooth_red_sandstone", "red_sandstone", "sand_stone_type", "smooth"),
+		variant("stone_bricks", "stonebrick", "stone_brick_type", "default"),
+		variant("mossy_stone_bricks", "stonebrick", "stone_brick_type", "mossy"),
+		variant("cracked_stone_bricks", "stonebrick", "stone_brick_type", "cracked"),
+		variant("chiseled_stone_bricks", "stonebrick", "stone_brick_type", "chiseled"),
+		variant("prismarine", "prismarine", "prismarine_block_type", "default"),
+		variant("prismarine_bricks", "prismarine", "prismarine_block_type", "bricks"),
+		variant("dark_prismarine", "prismarine", "prismarine_block_type", "dark"),
+		variant("sponge", "sponge", "sponge_type", "dry"),
+		variant("wet_sponge", "sponge", "sponge_type", "wet"),
+		variant("infested_stone", "monster_egg", "monster_egg_stone_type", "stone"),
+		variant("infested_cobblestone", "monster_egg", "monster_egg_stone_type", "cobblestone"),
+		variant("infested_stone_bricks", "monster_egg", "monster_egg_stone_type", "stone_brick"),
+		variant("infested_mossy_stone_bricks", "monster_egg", "monster_egg_stone_type", "mossy_stone_brick"),
+		variant("infested_cracked_stone_bricks", "monster_egg", "monster_egg_stone_type", "cracked_stone_brick"),
+		variant("infested_chiseled_stone_bricks", "monster_egg", "monster_egg_stone_type", "chiseled_stone_brick"),
+		variant("cobblestone_wall", "cobblestone_wall", "wall_block_type", "cobblestone"),
+		variant("mossy_cobblestone_wall", "cobblestone_wall", "wall_block_type", "mossy_cobblestone"),
+		{java: "bedrock", bedrock: "bedrock", defaults: map[string]interface{}{"infiniburn_bit": false}},
+		{java: "quartz_block", bedrock: "quartz_block", fixed: map[string]interface{}{"chisel_type": "default"}, defaults: map[string]interface{}{"pillar_axis": "y"}},
+		{java: "chiseled_quartz_block", bedrock: "quartz_block", fixed: map[string]interface{}{"chisel_type": "chiseled"}, defaults: map[string]interface{}{"pillar_axis": "y"}},
+		{java: "smooth_quartz", bedrock: "quartz_block", fixed: map[string]interface{}{"chisel_type": "smooth"}, defaults: map[string]interface{}{"pillar_axis": "y"}},
+		{java: "quartz_pillar", bedrock: "quartz_block", fixed: map[string]interface{}{"chisel_type": "lines"}, states: []stateMapping{axis}},
+		{java: "purpur_block", bedrock: "purpur_block", fixed: map[string]interface{}{"chisel_type": "default"}, defaults: map[string]interface{}{"pillar_axis": "y"}},
+		{java: "purpur_pillar", bedrock: "purpur_block", fixed: map[string]interface{}{"chisel_type": "lines"}, states: []stateMapping{axis}},
+		{java: "bone_block", bedrock: "bone_block", defaults: map[string]interface{}{"deprecated": 0}, states: []stateMapping{axis}},
+		rename("hay_block", "hay_block", axis),
+		rename("bricks", "brick_block"),
+		rename("nether_bricks", "nether_brick"),
+		rename("red_nether_bricks", "red_nether_brick"),
+		rename("end_stone_bricks", "end_bricks"),
+		rename("terracotta", "hardened_clay"),
+		rename("magma_block", "magma"),
+		rename("snow_block", "snow"),
+		rename("slime_block", "slime"),
+		rename("melon", "melon_block"),
+		rename("sea_lantern", "seaLantern"),
+		rename("nether_quartz_ore", "quartz_ore"),
+		rename("barrier", "barrier"),
+		rename("cobweb", "web"),
+		rename("spawner", "mob_spawner"),
+		rename("lily_pad", "waterlily"),
+		rename("dead_bush", "deadbush"),
+		rename("nether_brick_fence", "nether_brick_fence"),
+		rename("glass_pane", "glass_pane"),
+		rename("iron_bars", "iron_bars"),
+		rename("note_block", "noteblock"),
+		rename("jukebox", "jukebox"),
+		rename("grass_block", "grass"),
+		rename("podzol", "podzol"),
+		rename("mycelium", "mycelium"),
+		rename("tnt", "tnt", boolState("unstable", "explode_bit")),
+		rename("shulker_box", "undyed_shulker_box"),
+		rename("structure_block", "structure_block", stringState("mode", "structure_block_type")),
+
+		// Liquids
+
+		rename("water", "water", intState("level", "liquid_depth")),
+		rename("lava", "lava", intState("level", "liquid_depth")),
+
+		// Plants
+
+		variant("grass", "tallgrass", "tall_grass_type", "tall"),
+		variant("fern", "tallgrass", "tall_grass_type", "fern"),
+		rename("dandelion", "yellow_flower"),
+		variant("poppy", "red_flower", "flower_type", "poppy"),
+		variant("blue_orchid", "red_flower", "flower_type", "orchid"),
+		variant("allium", "red_flower", "flower_type", "allium"),
+		variant("azure_bluet", "red_flower", "flower_type", "houstonia"),
+		variant("red_tulip", "red_flower", "flower_type", "tulip_red"),
+		variant("orange_tulip", "red_flower", "flower_type", "tulip_orange"),
+		variant("white_tulip", "red_flower", "flower_type", "tulip_white"),
+		variant("pink_tulip", "red_flower", "flower_type", "tulip_pink"),
+		variant("oxeye_daisy", "red_flower", "flower_type", "oxeye"),
+		variant("sunflower", "double_plant", "double_plant_type", "sunflower", enumState("half", "upper_block_bit", halfUpper)),
+		variant("lilac", "double_plant", "double_plant_type", "syringa", enumState("half", "upper_block_bit", halfUpper)),
+		variant("tall_grass", "double_plant", "double_plant_type", "grass", enumState("half", "upper_block_bit", halfUpper)),
+		variant("large_fern", "double_plant", "double_plant_type", "fern", enumState("half", "upper_block_bit", halfUpper)),
+		variant("rose_bush", "double_plant", "double_plant_type", "rose", enumState("half", "upper_block_bit", halfUpper)),
+		variant("peony", "double_plant", "double_plant_type", "paeonia", enumState("half", "upper_block_bit", halfUpper)),
+		variant("seagrass", "seagrass", "sea_grass_type", "default"),
+		rename("tall_seagrass", "seagrass", enumState("half", "sea_grass_type", map[string]interface{}{"upper": "double_top", "lower": "double_bot"})),
+		rename("kelp", "kelp", intState("age", "kelp_age")),
+		{java: "kelp_plant", bedrock: "kelp", defaults: map[string]interface{}{"kelp_age": 0}},
+		rename("wheat", "wheat", intState("age", "growth")),
+		rename("carrots", "carrots", intState("age", "growth")),
+		rename("potatoes", "potatoes", intState("age", "growth")),
+		rename("nether_wart", "nether_wart", intState("age", "age")),
+		rename("cactus", "cactus", intState("age", "age")),
+		rename("sugar_cane", "reeds", intState("age", "age")),
+		rename("chorus_flower", "chorus_flower", intState("age", "age")),
+		rename("chorus_plant", "chorus_plant"),
+
+		// Facing blocks
+
+		rename("chest", "chest", enumState("facing", "facing_direction", facingDirection)),
+		rename("trapped_chest", "trapped_chest", enumState("facing", "facing_direction", facingDirection)),
+		rename("ender_chest", "ender_chest", enumState("facing", "facing_direction", facingDirection)),
+		rename("ladder", "ladder", enumState("facing", "facing_direction", facingDirection)),
+		rename("wall_sign", "wall_sign", enumState("facing", "facing_direction", facingDirection)),
+		rename("sign", "standing_sign", intState("rotation", "ground_sign_direction")),
+		{java: "furnace", bedrock: "furnace", when: map[string]string{"lit": "false"}, states: []stateMapping{enumState("facing", "facing_direction", facingDirection)}},
+		{java: "furnace", bedrock: "lit_furnace", when: map[string]string{"lit": "true"}, states: []stateMapping{enumState("facing", "facing_direction", facingDirection)}},
+		rename("dispenser", "dispenser", enumState("facing", "facing_direction", facingDirection), boolState("triggered", "triggered_bit")),
+		rename("dropper", "dropper", enumState("facing", "facing_direction", facingDirection), boolState("triggered", "triggered_bit")),
+		rename("hopper", "hopper", enumState("facing", "facing_direction", facingDirection),
+			enumState("enabled", "toggle_bit", map[string]interface{}{"true": false, "false": true})),
+		{java: "pumpkin", bedrock: "pumpkin", defaults: map[string]interface{}{"direction": 0}},
+		rename("carved_pumpkin", "carved_pumpkin", enumState("facing", "direction", direction)),
+		rename("jack_o_lantern", "lit_pumpkin", enumState("facing", "direction", direction)),
+		rename("end_portal_frame", "end_portal_frame", enumState("facing", "direction", direction), boolState("eye", "end_portal_eye_bit")),
+		variant("torch", "torch", "torch_facing_direction", "top"),
+		rename("wall_torch", "torch", enumState("facing", "torch_facing_direction", torchFacing)),
+		{java: "redstone_torch", bedrock: "redstone_torch", when: map[string]string{"lit": "true"}, fixed: map[string]interface{}{"torch_facing_direction": "top"}},
+		{java: "redstone_torch", bedrock: "unlit_redstone_torch", when: map[string]string{"lit": "false"}, fixed: map[string]interface{}{"torch_facing_direction": "top"}},
+		{java: "redstone_wall_torch", bedrock: "redstone_torch", when: map[string]string{"lit": "true"},
+			states: []stateMapping{enumState("facing", "torch_facing_direction", torchFacing)}},
+		{java: "redstone_wall_torch", bedrock: "unlit_redstone_torch", when: map[string]string{"lit": "false"},
+			states: []stateMapping{enumState("facing", "torch_facing_direction", torchFacing)}},
+
+		// Stairs
+
+		rename("cobblestone_stairs", "stone_stairs", stairs...),
+		rename("stone_brick_stairs", "stone_brick_stairs", stairs...),
+		rename("brick_stairs", "brick_stairs", stairs...),
+		rename("nether_brick_stairs", "nether_brick_stairs", stairs...),
+		rename("sandstone_stairs", "sandstone_stairs", stairs...),
+		rename("red_sandstone_stairs", "red_sandstone_stairs", stairs...),
+		rename("quartz_stairs", "quartz_stairs", stairs...),
+		rename("purpur_stairs", "purpur_stairs", stairs...),
+		rename("prismarine_stairs", "prismarine_stairs", stairs...),
+		rename("prismarine_brick_stairs", "prismarine_bricks_stairs", stairs...),
+		rename("dark_prismarine_stairs", "dark_prismarine_stairs", stairs...),
+
+		// Rails
+
+		rename("rail", "rail", enumState("shape", "rail_direction", railDirection)),
+		rename("powered_rail", "golden_rail", enumState("shape", "rail_direction", railDirection), boolState("powered", "rail_data_bit")),
+		rename("detector_rail", "detector_rail", enumState("shape", "rail_direction", railDirection), boolState("powered", "rail_data_bit")),
+		rename("activator_rail", "activator_rail", enumState("shape", "rail_direction", railDirection), boolState("powered", "rail_data_bit")),
+
+		// Redstone
+
+		rename("redstone_wire", "redstone_wire", intState("power", "redstone_signal")),
+		{java: "redstone_lamp", bedrock: "redstone_lamp", when: map[string]string{"lit": "false"}},
+		{java: "redstone_lamp", bedrock: "lit_redstone_lamp", when: map[string]string{"lit": "true"}},
+		{java: "redstone_ore", bedrock: "redstone_ore", when: map[string]string{"lit": "false"}},
+		{java: "redstone_ore", bedrock: "lit_redstone_ore", when: map[string]string{"lit": "true"}},
+		{java: "daylight_detector", bedrock: "daylight_detector", when: map[string]string{"inverted": "false"}, states: []stateMapping{intState("power", "redstone_signal")}},
+		{java: "daylight_detector", bedrock: "daylight_detector_inverted", when: map[string]string{"inverted": "true"}, states: []stateMapping{intState("power", "redstone_signal")}},
+
+		// Others
+
+		{java: "snow", bedrock: "snow_layer", defaults: map[string]interface{}{"covered_bit": false}, states: []stateMapping{enumState("layers", "height", map[string]interface{}{
+			"1": 0, "2": 1, "3": 2, "4": 3, "5": 4, "6": 5, "7": 6, "8": 7,
+		})}},
+		{java: "cauldron", bedrock: "cauldron", defaults: map[string]interface{}{"cauldron_liquid": "water"}, states: []stateMapping{enumState("level", "fill_level", map[string]interface{}{
+			"0": 0, "1": 2, "2": 4, "3": 6,
+		})}},
+		rename("farmland", "farmland", intState("moisture", "moisturized_amount")),
+		rename("fire", "fire", intState("age", "age")),
+		rename("frosted_ice", "frosted_ice", intState("age", "age")),
+		rename("cake", "cake", intState("bites", "bite_counter")),
+	}
+
+	// Ignored properties
+
+	for _, m := range mappings {
+		switch m.java {
+		case "grass_block", "podzol", "mycelium":
+			m.ignore = []string{"snowy"}
+		case "chest", "trapped_chest":
+			m.ignore = []string{"type"}
+		case "note_block":
+			m.ignore = []string{"instrument", "note", "powered"}
+		case "jukebox":
+			m.ignore = []string{"has_record"}
+		case "nether_brick_fence", "glass_pane", "iron_bars", "redstone_wire":
+			m.ignore = sides
+		case "cobblestone_wall", "mossy_cobblestone_wall":
+			m.ignore = append([]string{"up"}, sides...)
+		case "chorus_plant":
+			m.ignore = append([]string{"up", "down"}, sides...)
+		case "fire":
+			m.ignore = append([]string{"up"}, sides...)
+		}
+
+		if strings.HasSuffix(m.java, "_stairs") {
+			m.ignore = []string{"shape"}
+		}
+	}
+
+	// Colored blocks
+
+	for _, color := range colors {
+		java, bedrock := color[0], color[1]
+
+		mappings = append(mappings,
+			variant(java+"_wool", "wool", "color", bedrock),
+			variant(java+"_carpet", "carpet", "color", bedrock),
+			variant(java+"_concrete", "concrete", "color", bedrock),
+			variant(java+"_concrete_powder", "concrete_powder", "color", bedrock),
+			variant(java+"_stained_glass", "stained_glass", "color", bedrock),
+			variant(java+"_terracotta", "stained_hardened_clay", "color", bedrock),
+			rename(java+"_glazed_terracotta", bedrock+"_glazed_terracotta", enumState("facing", "facing_direction", facingDirection)),
+			&bedrockMapping{java: java + "_stained_glass_pane", bedrock: "stained_glass_pane", fixed: map[string]interface{}{"color": bedrock}, ignore: sides},
+			&bedrockMapping{java: java + "_shulker_box", bedrock: "shulker_box", fixed: map[string]interface{}{"color": bedrock}, ignore: []string{"facing"}},
+		)
+	}
+
+	// Coral blocks
+
+	for _, coral := range [][2]string{{"tube", "blue"}, {"brain", "pink"}, {"bubble", "purple"}, {"fire", "red"}, {"horn", "yellow"}} {
+		mappings = append(mappings,
+			&bedrockMapping{java: coral[0] + "_coral_block", bedrock: "coral_block", fixed: map[string]interface{}{"coral_color": coral[1], "dead_bit": false}},
+			&bedrockMapping{java: "dead_" + coral[0] + "_coral_block", bedrock: "coral_block", fixed: map[string]interface{}{"coral_color": coral[1], "dead_bit": true}},
+		)
+	}
+
+	// Wooden blocks
+
+	for i, wood := range woods {
+		logType, logName, leavesType, leavesName := "old_log_type", "log", "old_leaf_type", "leaves"
+		if i >= 4 {
+			logType, logName, leavesType, leavesName = "new_log_type", "log2", "new_leaf_type", "leaves2"
+		}
+
+		sapling := wood
+		if wood == "dark_oak" {
+			sapling = "roofed_oak"
+		}
+
+		gate := wood + "_fence_gate"
+		if wood == "oak" {
+			gate = "fence_gate"
+		}
+
+		mappings = append(mappings,
+			variant(wood+"_planks", "planks", "wood_type", wood),
+			variant(wood+"_log", logName, logType, wood, axis),
+			&bedrockMapping{
+				java:     wood + "_leaves",
+				bedrock:  leavesName,
+				fixed:    map[string]interface{}{leavesType: wood},
+				defaults: map[string]interface{}{"update_bit": false},
+				states:   []stateMapping{boolState("persistent", "persistent_bit")},
+				ignore:   []string{"distance"},
+			},
+			variant(wood+"_sapling", "sapling", "sapling_type", sapling, enumState("stage", "age_bit", map[string]interface{}{"0": false, "1": true})),
+			&bedrockMapping{java: wood + "_fence", bedrock: "fence", fixed: map[string]interface{}{"wood_type": wood}, ignore: sides},
+			&bedrockMapping{
+				java:    wood + "_fence_gate",
+				bedrock: gate,
+				states:  []stateMapping{enumState("facing", "direction", direction), boolState("open", "open_bit"), boolState("in_wall", "in_wall_bit")},
+				ignore:  []string{"powered"},
+			},
+			&bedrockMapping{java: wood + "_stairs", bedrock: wood + "_stairs", states: stairs, ignore: []string{"shape"}},
+			&bedrockMapping{
+				java:     wood + "_slab",
+				bedrock:  "double_wooden_slab",
+				when:     map[string]string{"type": "double"},
+				fixed:    map[string]interface{}{"wood_type": wood},
+				defaults: map[string]interface{}{"top_slot_bit": false},
+			},
+			variant(wood+"_slab", "wooden_slab", "wood_type", wood, enumState("type", "top_slot_bit", halfTop)),
+		)
+	}
+
+	// Stone slabs
+
+	slabs := [][3]string{
+		{"stone_slab", "stone_slab", "smooth_stone"},
+		{"sandstone_slab", "stone_slab", "sandstone"},
+		{"petrified_oak_slab", "stone_slab", "wood"},
+		{"cobblestone_slab", "stone_slab", "cobblestone"},
+		{"brick_slab", "stone_slab", "brick"},
+		{"stone_brick_slab", "stone_slab", "stone_brick"},
+		{"quartz_slab", "stone_slab", "quartz"},
+		{"nether_brick_slab", "stone_slab", "nether_brick"},
+		{"red_sandstone_slab", "stone_slab2", "red_sandstone"},
+		{"purpur_slab", "stone_slab2", "purpur"},
+		{"prismarine_slab", "stone_slab2", "prismarine_rough"},
+		{"dark_prismarine_slab", "stone_slab2", "prismarine_dark"},
+		{"prismarine_brick_slab", "stone_slab2", "prismarine_brick"},
+	}
+
+	for _, slab := range slabs {
+		key := "stone_slab_type"
+		if slab[1] == "stone_slab2" {
+			key = "stone_slab_type_2"
+		}
+
+		mappings = append(mappings,
+			&bedrockMapping{
+				java:     slab[0],
+				bedrock:  "double_" + slab[1],
+				when:     map[string]string{"type": "double"},
+				fixed:    map[string]interface{}{key: slab[2]},
+				defaults: map[string]interface{}{"top_slot_bit": false},
+			},
+			variant(slab[0], slab[1], key, slab[2], enumState("type", "top_slot_bit", halfTop)),
+		)
+	}
+
+	return mappings
+}
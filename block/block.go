@@ -10,17 +10,69 @@ package block
 */
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/beito123/level/asset"
 )
 
 // List is a compatibility data for block
 type List map[string]*Block
 
-// BlockListV112 is a block data for v1.12
-var BlockListV112 = LoadV112()
+// Get returns a block by name
+// If name doesn't have a prefix, minecraft prefix is added
+func (list List) Get(name string) (*Block, bool) {
+	if !strings.Contains(name, ":") {
+		name = MinecraftPrefix + name
+	}
+
+	bl, ok := list[name]
+
+	return bl, ok
+}
+
+// Lookup returns block metadata by name from the newest block list
+// If the block isn't found, it looks up v1.12 block list
+func Lookup(name string) (*Block, bool) {
+	list, err := ListV113()
+	if err == nil {
+		bl, ok := list.Get(name)
+		if ok {
+			return bl, true
+		}
+	}
+
+	list, err = ListV112()
+	if err != nil {
+		return nil, false
+	}
+
+	return list.Get(name)
+}
+
+// BoundingBox is a type of collision box for a block
+type BoundingBox string
+
+const (
+	// BoundingBoxBlock is a full block collision box
+	BoundingBoxBlock BoundingBox = "block"
+
+	// BoundingBoxEmpty is no collision box
+	BoundingBoxEmpty BoundingBox = "empty"
+)
 
-// BlockListV113 is a block data for v1.13
-var BlockListV113 = LoadV113()
+// UnbreakableHardness is the hardness of unbreakable blocks such as bedrock
+const UnbreakableHardness = -1
+
+// State is a property definition for block states
+type State struct {
+	Name      string
+	Type      string // bool, int, enum or direction
+	NumValues int
+	Values    []string
+}
 
 // Block is a common block data
 // TODO: support level.BlockState
@@ -30,13 +82,80 @@ type Block struct {
 
 	ID   int
 	Meta int
+
+	DisplayName string
+	Hardness    float64 // UnbreakableHardness if it's unbreakable
+	Diggable    bool
+	Material    string
+	StackSize   int
+	BoundingBox BoundingBox
+
+	// Transparent is whether light passes through the block
+	Transparent bool
+
+	// FilterLight is how much light level is reduced by the block (0-15)
+	FilterLight int
+
+	// EmitLight is a light level emitted by the block (0-15)
+	EmitLight int
+
+	States []*State
+	Drops  []int
+}
+
+// IsSolid returns whether the block has a full collision box
+func (bl *Block) IsSolid() bool {
+	return bl.BoundingBox == BoundingBoxBlock
+}
+
+// IsUnbreakable returns whether the block is unbreakable
+func (bl *Block) IsUnbreakable() bool {
+	return bl.Hardness == UnbreakableHardness
+}
+
+// State returns a state definition by name
+func (bl *Block) State(name string) (*State, bool) {
+	for _, st := range bl.States {
+		if st.Name == name {
+			return st, true
+		}
+	}
+
+	return nil, false
+}
+
+// LookupEdition returns block metadata by name of the edition
+// Names of mcbe are looked up from mcbe block lists such as minecraft:grass (a grass block)
+func LookupEdition(edition asset.Edition, name string) (*Block, bool) {
+	if edition != asset.BedrockEdition {
+		return Lookup(name)
+	}
+
+	for _, load := range []func() (List, error){ListBedrock, ListBedrockLegacy} {
+		list, err := load()
+		if err != nil {
+			continue
+		}
+
+		bl, ok := list.Get(name)
+		if ok {
+			return bl, true
+		}
+	}
+
+	return nil, false
 }
 
-// FromBlockID returns Block from old block id and meta
-func FromBlockID(id int, meta int) *Block {
-	data, ok := BlockListV112[ToNumberID(id)]
+// FromBlockID returns Block from old block id and meta of mcje
+func FromBlockID(id int, meta int) (*Block, error) {
+	list, err := ListV112()
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := list[ToNumberID(id)]
 	if !ok {
-		return nil
+		return nil, fmt.Errorf("level.block: unknown block id %d", id)
 	}
 
 	name := GetV112ToV113(data.Name, meta)
@@ -44,7 +163,25 @@ func FromBlockID(id int, meta int) *Block {
 	return &Block{
 		Name:       name,
 		Properties: make(map[string]string), // TODO: support
+	}, nil
+}
+
+// FromBedrockID returns Block from block id and meta of mcbe
+func FromBedrockID(id int, meta int) (*Block, error) {
+	list, err := ListBedrockLegacy()
+	if err != nil {
+		return nil, err
 	}
+
+	data, ok := list[ToNumberID(id)]
+	if !ok {
+		return nil, fmt.Errorf("level.block: unknown bedrock block id %d", id)
+	}
+
+	bl := *data
+	bl.Meta = meta
+
+	return &bl, nil
 }
 
 func ToNumberID(id int) string {
@@ -72,3 +209,39 @@ func GetV112ToV113(name string, meta int) string {
 
 	return data
 }
+
+var (
+	v113ToV112     map[string]string
+	v113ToV112Once sync.Once
+)
+
+// GetV113ToV112 gets a block name and meta which is converted from v1.13 to v1.12
+// If the block isn't found, returns false for ok
+func GetV113ToV112(name string) (oldName string, meta int, ok bool) {
+	v113ToV112Once.Do(func() {
+		v113ToV112 = make(map[string]string, len(V112ToV113))
+		for old, name := range V112ToV113 {
+			prev, ok := v113ToV112[name]
+			if ok && (len(prev) < len(old) || (len(prev) == len(old) && prev < old)) { // prefer names without meta
+				continue
+			}
+
+			v113ToV112[name] = old
+		}
+	})
+
+	old, ok := v113ToV112[name]
+	if !ok {
+		return name, 0, false
+	}
+
+	i := strings.LastIndex(old, ":")
+	if i > len(MinecraftPrefix)-1 {
+		meta, err := strconv.Atoi(old[i+1:])
+		if err == nil {
+			return old[:i], meta, true
+		}
+	}
+
+	return old, 0, true
+}
@@ -11,145 +11,156 @@ package block
 
 import (
 	"fmt"
-	"io/ioutil"
 	"strconv"
 
-	"github.com/beito123/level/asset"
 	jsoniter "github.com/json-iterator/go"
 )
 
 var json = jsoniter.ConfigCompatibleWithStandardLibrary
 
-func handleError(err error) {
-	panic(fmt.Errorf("level.block: happened errors while it's loading block data Error: %s", err.Error()))
+func loadError(err error) error {
+	return fmt.Errorf("level.block: happened errors while it's loading block data Error: %s", err.Error())
 }
 
-// LoadV112 loads block data for v112
-func LoadV112() List {
-	file, err := asset.OpenResource("/static/v112/blocks.json")
-	if err != nil {
-		handleError(err)
+// metaFormat is common fields of blocks.json
+type metaFormat struct {
+	DisplayName string   `json:"displayName"`
+	Hardness    *float64 `json:"hardness"` // null if it's unbreakable
+	Diggable    bool     `json:"diggable"`
+	Material    string   `json:"material"`
+	StackSize   int      `json:"stackSize"`
+	BoundingBox string   `json:"boundingBox"`
+	Transparent bool     `json:"transparent"`
+	FilterLight int      `json:"filterLight"`
+	EmitLight   int      `json:"emitLight"`
+}
+
+// newBlock returns new Block with metadata
+func (f *metaFormat) newBlock(name string, id int, meta int) *Block {
+	hardness := float64(UnbreakableHardness)
+	if f.Hardness != nil {
+		hardness = *f.Hardness
 	}
 
-	defer file.Close()
+	return &Block{
+		Name:        name,
+		ID:          id,
+		Meta:        meta,
+		DisplayName: f.DisplayName,
+		Hardness:    hardness,
+		Diggable:    f.Diggable,
+		Material:    f.Material,
+		StackSize:   f.StackSize,
+		BoundingBox: BoundingBox(f.BoundingBox),
+		Transparent: f.Transparent,
+		FilterLight: f.FilterLight,
+		EmitLight:   f.EmitLight,
+	}
+}
 
+// LoadV112 loads block data for v112 from blocks.json bytes
+func LoadV112(b []byte) (List, error) {
 	type Format struct {
-		ID   int    `json:"id"`
-		Name string `json:"name"`
-		//DisplayName string `json:"displayName"`
+		metaFormat
+
+		ID         int    `json:"id"`
+		Name       string `json:"name"`
 		Variations []struct {
-			Meta int `json:"metadata"`
-			//DisplayName string `json:"displayName"`
+			Meta        int    `json:"metadata"`
+			DisplayName string `json:"displayName"`
 		} `json:"variations"`
-	}
-
-	b, err := ioutil.ReadAll(file)
-	if err != nil {
-		handleError(err)
+		Drops []struct {
+			Drop jsoniter.RawMessage `json:"drop"` // id or {id, metadata}
+		} `json:"drops"`
 	}
 
 	var data []Format
-	err = json.Unmarshal(b, &data)
+	err := json.Unmarshal(b, &data)
 	if err != nil {
-		handleError(err)
+		return nil, loadError(err)
 	}
 
 	list := make(List)
 	for _, value := range data {
 		name := MinecraftPrefix + value.Name
 
-		list[name] = &Block{
-			Name: name,
-			//DisplayName: value.DisplayName,
-
-			ID:   value.ID,
-			Meta: 0,
-		}
-
-		list[ToNumberID(value.ID)] = list[name]
+		var drops []int
+		for _, d := range value.Drops {
+			var id int
+			if json.Unmarshal(d.Drop, &id) != nil {
+				var item struct {
+					ID int `json:"id"`
+				}
 
-		for _, val := range value.Variations {
-			n := name + ":" + strconv.Itoa(val.Meta)
+				err := json.Unmarshal(d.Drop, &item)
+				if err != nil {
+					return nil, loadError(err)
+				}
 
-			list[n] = &Block{
-				Name: name,
-				//DisplayName: val.DisplayName,
-				ID:   value.ID,
-				Meta: val.Meta,
+				id = item.ID
 			}
 
-			nid := ToNumberIDMeta(value.ID, val.Meta)
-			list[nid] = &Block{
-				Name: name,
-				//DisplayName: val.DisplayName,
-				ID:   value.ID,
-				Meta: val.Meta,
-			}
+			drops = append(drops, id)
 		}
-	}
 
-	//
-	/*
+		list[name] = value.newBlock(name, value.ID, 0)
+		list[name].Drops = drops
 
-		for key, to := range cdata {
-			val, ok := list[MinecraftPrefix+to]
-			if !ok {
-				continue
-			}
-
-			list[MinecraftPrefix+key] = val
-		}*/
+		list[ToNumberID(value.ID)] = list[name]
 
-	return list
-}
+		for _, val := range value.Variations {
+			bl := value.newBlock(name, value.ID, val.Meta)
+			bl.DisplayName = val.DisplayName
+			bl.Drops = drops
 
-// LoadV113 loads block data for v113
-func LoadV113() List {
-	file, err := asset.OpenResource("/static/v113/blocks.json")
-	if err != nil {
-		handleError(err)
+			list[name+":"+strconv.Itoa(val.Meta)] = bl
+			list[ToNumberIDMeta(value.ID, val.Meta)] = bl
+		}
 	}
 
-	defer file.Close()
+	return list, nil
+}
 
+// LoadV113 loads block data for v113 from blocks.json bytes
+func LoadV113(b []byte) (List, error) {
 	type Format struct {
-		ID          int    `json:"id"`
-		Name        string `json:"name"`
-		DisplayName string `json:"displayName"`
-	}
-
-	b, err := ioutil.ReadAll(file)
-	if err != nil {
-		handleError(err)
+		metaFormat
+
+		ID     int    `json:"id"`
+		Name   string `json:"name"`
+		States []struct {
+			Name      string   `json:"name"`
+			Type      string   `json:"type"`
+			NumValues int      `json:"num_values"`
+			Values    []string `json:"values"`
+		} `json:"states"`
+		Drops []int `json:"drops"`
 	}
 
 	var data []Format
-	err = json.Unmarshal(b, &data)
+	err := json.Unmarshal(b, &data)
 	if err != nil {
-		handleError(err)
+		return nil, loadError(err)
 	}
 
 	list := make(List)
 	for _, value := range data {
 		name := MinecraftPrefix + value.Name
 
-		list[name] = &Block{
-			Name: name,
-			//DisplayName: value.DisplayName,
+		bl := value.newBlock(name, value.ID, 0)
+		bl.Drops = value.Drops
 
-			ID:   value.ID,
-			Meta: 0,
+		for _, st := range value.States {
+			bl.States = append(bl.States, &State{
+				Name:      st.Name,
+				Type:      st.Type,
+				NumValues: st.NumValues,
+				Values:    st.Values,
+			})
 		}
-	}
 
-	/*
-		// Debug
-		debug, err := json.MarshalIndent(list, "", "  ")
-		if err != nil {
-			panic(err)
-		}
-
-		fmt.Printf("test:\n%s", string(debug)):*/
+		list[name] = bl
+	}
 
-	return list
+	return list, nil
 }
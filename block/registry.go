@@ -0,0 +1,122 @@
+package block
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/beito123/level/asset"
+)
+
+// BlocksFile is a file name of block data in a data set
+const BlocksFile = "blocks.json"
+
+// DefaultRegistry is a registry with asset.DefaultRegistry
+var DefaultRegistry = NewRegistry(asset.DefaultRegistry)
+
+// NewRegistry returns new Registry
+func NewRegistry(assets *asset.Registry) *Registry {
+	return &Registry{
+		Assets: assets,
+		lists:  make(map[*asset.DataSet]List),
+		mutex:  new(sync.RWMutex),
+	}
+}
+
+// Registry loads block lists from data sets lazily
+type Registry struct {
+	Assets *asset.Registry
+
+	lists map[*asset.DataSet]List
+	mutex *sync.RWMutex
+}
+
+// List returns a block list of the data set
+// It's loaded at first call and cached
+func (reg *Registry) List(set *asset.DataSet) (List, error) {
+	reg.mutex.RLock()
+	list, ok := reg.lists[set]
+	reg.mutex.RUnlock()
+
+	if ok {
+		return list, nil
+	}
+
+	b, err := reg.Assets.ReadFile(set, BlocksFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if set.Legacy {
+		list, err = LoadV112(b)
+	} else {
+		list, err = LoadV113(b)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	reg.mutex.Lock()
+	reg.lists[set] = list
+	reg.mutex.Unlock()
+
+	return list, nil
+}
+
+// ByVersion returns a block list by edition and game version
+func (reg *Registry) ByVersion(edition asset.Edition, version string) (List, error) {
+	set, ok := reg.Assets.ByVersion(edition, version)
+	if !ok {
+		return nil, fmt.Errorf("level.block: couldn't find block data for %s %s", edition.Name(), version)
+	}
+
+	return reg.List(set)
+}
+
+// ByDataVersion returns a block list by DataVersion of mcje
+func (reg *Registry) ByDataVersion(ver int) (List, error) {
+	set, ok := reg.Assets.ByDataVersion(ver)
+	if !ok {
+		return nil, fmt.Errorf("level.block: couldn't find block data for DataVersion %d", ver)
+	}
+
+	return reg.List(set)
+}
+
+// Clear clears cached block lists
+// You should call it after you add override directories to the asset registry
+func (reg *Registry) Clear() {
+	reg.mutex.Lock()
+	reg.lists = make(map[*asset.DataSet]List)
+	reg.mutex.Unlock()
+}
+
+// ListV112 returns a block list for v1.12
+func ListV112() (List, error) {
+	return DefaultRegistry.ByVersion(asset.JavaEdition, "1.12")
+}
+
+// ListV113 returns a block list for v1.13
+func ListV113() (List, error) {
+	return DefaultRegistry.ByVersion(asset.JavaEdition, "1.13")
+}
+
+// ListBedrock returns a block list for mcbe v1.4
+// Names are same as block palettes of mcbe
+func ListBedrock() (List, error) {
+	return DefaultRegistry.ByVersion(asset.BedrockEdition, "1.4")
+}
+
+// ListBedrockLegacy returns a block list for mcbe v1.2 with numeric block ids
+func ListBedrockLegacy() (List, error) {
+	return DefaultRegistry.ByVersion(asset.BedrockEdition, "1.2")
+}
@@ -0,0 +1,118 @@
+package block
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ParseState parses a block state string such as "minecraft:chest[facing=north,type=single]"
+func ParseState(s string) (name string, properties map[string]string, err error) {
+	properties = make(map[string]string)
+
+	i := strings.Index(s, "[")
+	if i == -1 {
+		return s, properties, nil
+	}
+
+	if !strings.HasSuffix(s, "]") {
+		return "", nil, fmt.Errorf("level.block: invaild block state %s", s)
+	}
+
+	name = s[:i]
+
+	body := s[i+1 : len(s)-1]
+	if body == "" {
+		return name, properties, nil
+	}
+
+	for _, pair := range strings.Split(body, ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			return "", nil, fmt.Errorf("level.block: invaild block state %s", s)
+		}
+
+		properties[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+
+	return name, properties, nil
+}
+
+// FormatState returns a block state string such as "minecraft:chest[facing=north,type=single]"
+// Properties are sorted by key
+func FormatState(name string, properties map[string]string) string {
+	if len(properties) == 0 {
+		return name
+	}
+
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = key + "=" + properties[key]
+	}
+
+	return name + "[" + strings.Join(pairs, ",") + "]"
+}
+
+// Waterlogged is a property name for blocks which have water in mcje
+const Waterlogged = "waterlogged"
+
+// IsWater returns whether the block name is water
+func IsWater(name string) bool {
+	return name == "minecraft:water" || name == "minecraft:flowing_water"
+}
+
+// IsAir returns whether the block name is air
+func IsAir(name string) bool {
+	return name == "minecraft:air" || name == "minecraft:cave_air" || name == "minecraft:void_air"
+}
+
+// SplitWaterlogged returns properties without waterlogged property and whether the block is waterlogged
+// If the block doesn't have waterlogged property, returns false for ok
+func SplitWaterlogged(properties map[string]string) (result map[string]string, waterlogged bool, ok bool) {
+	val, ok := properties[Waterlogged]
+	if !ok {
+		return properties, false, false
+	}
+
+	result = make(map[string]string, len(properties))
+	for key, v := range properties {
+		if key != Waterlogged {
+			result[key] = v
+		}
+	}
+
+	return result, val == "true", true
+}
+
+// CanWaterlog returns whether the block has waterlogged property in mcje
+func CanWaterlog(name string) bool {
+	bl, ok := Lookup(name)
+	if !ok {
+		return false
+	}
+
+	_, ok = bl.State(Waterlogged)
+
+	return ok
+}
+
+// IsLiquid returns whether the block name is water or lava
+func IsLiquid(name string) bool {
+	return IsWater(name) || name == "minecraft:lava" || name == "minecraft:flowing_lava"
+}
@@ -0,0 +1,253 @@
+package blockentity
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/beito123/level/asset"
+	"github.com/beito123/nbt"
+)
+
+// Pos is a coordinate of a block entity
+type Pos struct {
+	X int
+	Y int
+	Z int
+}
+
+// PosOf returns the coordinate of a block entity
+// If it doesn't have x, y and z, returns false for ok
+func PosOf(com *nbt.Compound) (pos Pos, ok bool) {
+	x, err := com.GetInt("x")
+	if err != nil {
+		return pos, false
+	}
+
+	y, err := com.GetInt("y")
+	if err != nil {
+		return pos, false
+	}
+
+	z, err := com.GetInt("z")
+	if err != nil {
+		return pos, false
+	}
+
+	return Pos{X: int(x), Y: int(y), Z: int(z)}, true
+}
+
+// SetPos sets the coordinate of a block entity
+func SetPos(com *nbt.Compound, pos Pos) {
+	com.Set(nbt.NewIntTag("x", int32(pos.X)))
+	com.Set(nbt.NewIntTag("y", int32(pos.Y)))
+	com.Set(nbt.NewIntTag("z", int32(pos.Z)))
+}
+
+// NewIndex returns new Index with block entities
+// Block entities without a coordinate are kept, but can't be got by coordinate
+func NewIndex(entities []*nbt.Compound) *Index {
+	idx := &Index{
+		entities: make(map[Pos]*nbt.Compound, len(entities)),
+	}
+
+	for _, com := range entities {
+		pos, ok := PosOf(com)
+		if !ok {
+			idx.unknown = append(idx.unknown, com)
+
+			continue
+		}
+
+		idx.entities[pos] = com
+	}
+
+	return idx
+}
+
+// Index is block entities indexed by coordinate
+type Index struct {
+	entities map[Pos]*nbt.Compound
+	unknown  []*nbt.Compound
+}
+
+// Len returns the number of block entities
+func (idx *Index) Len() int {
+	return len(idx.entities) + len(idx.unknown)
+}
+
+// Get returns a block entity at the coordinate
+func (idx *Index) Get(x, y, z int) (*nbt.Compound, bool) {
+	com, ok := idx.entities[Pos{X: x, Y: y, Z: z}]
+
+	return com, ok
+}
+
+// Has returns whether a block entity exists at the coordinate
+func (idx *Index) Has(x, y, z int) bool {
+	_, ok := idx.entities[Pos{X: x, Y: y, Z: z}]
+
+	return ok
+}
+
+// Set sets a block entity at the coordinate of com
+// A block entity at the same coordinate is replaced
+func (idx *Index) Set(com *nbt.Compound) error {
+	pos, ok := PosOf(com)
+	if !ok {
+		return fmt.Errorf("level.blockentity: the block entity doesn't have a coordinate")
+	}
+
+	idx.entities[pos] = com
+
+	return nil
+}
+
+// Remove removes a block entity at the coordinate
+// If the block entity isn't found, returns false
+func (idx *Index) Remove(x, y, z int) bool {
+	pos := Pos{X: x, Y: y, Z: z}
+
+	_, ok := idx.entities[pos]
+	if ok {
+		delete(idx.entities, pos)
+	}
+
+	return ok
+}
+
+// All returns all block entities sorted by coordinate (y, z, x)
+func (idx *Index) All() []*nbt.Compound {
+	if idx.Len() == 0 {
+		return nil
+	}
+
+	positions := make([]Pos, 0, len(idx.entities))
+	for pos := range idx.entities {
+		positions = append(positions, pos)
+	}
+
+	sort.Slice(positions, func(i, j int) bool {
+		a, b := positions[i], positions[j]
+		if a.Y != b.Y {
+			return a.Y < b.Y
+		}
+
+		if a.Z != b.Z {
+			return a.Z < b.Z
+		}
+
+		return a.X < b.X
+	})
+
+	result := make([]*nbt.Compound, 0, idx.Len())
+	for _, pos := range positions {
+		result = append(result, idx.entities[pos])
+	}
+
+	return append(result, idx.unknown...)
+}
+
+// ID returns the id of a block entity
+func ID(com *nbt.Compound) string {
+	id, err := com.GetString("id")
+	if err != nil {
+		return ""
+	}
+
+	return id
+}
+
+// Kind is a common kind of block entities between editions
+type Kind int
+
+const (
+	// KindUnknown is a block entity which isn't supported
+	KindUnknown Kind = iota
+
+	// KindChest is chests and trapped chests
+	KindChest
+
+	// KindSign is signs
+	KindSign
+
+	// KindBanner is banners
+	KindBanner
+
+	// KindSpawner is mob spawners
+	KindSpawner
+
+	// KindCommandBlock is command blocks
+	KindCommandBlock
+
+	// KindBeacon is beacons
+	KindBeacon
+)
+
+// ids is block entity ids for mcje and mcbe by kind
+var ids = map[Kind][2]string{
+	KindChest:        {"minecraft:chest", "Chest"},
+	KindSign:         {"minecraft:sign", "Sign"},
+	KindBanner:       {"minecraft:banner", "Banner"},
+	KindSpawner:      {"minecraft:mob_spawner", "MobSpawner"},
+	KindCommandBlock: {"minecraft:command_block", "CommandBlock"},
+	KindBeacon:       {"minecraft:beacon", "Beacon"},
+}
+
+// kinds is kinds by block entity id
+// Ids before mcje v1.11 are same as ids for mcbe mostly
+var kinds = map[string]Kind{
+	"minecraft:chest":         KindChest,
+	"minecraft:trapped_chest": KindChest,
+	"minecraft:sign":          KindSign,
+	"minecraft:banner":        KindBanner,
+	"minecraft:mob_spawner":   KindSpawner,
+	"minecraft:command_block": KindCommandBlock,
+	"minecraft:beacon":        KindBeacon,
+
+	"Chest":        KindChest,
+	"Sign":         KindSign,
+	"Banner":       KindBanner,
+	"MobSpawner":   KindSpawner,
+	"CommandBlock": KindCommandBlock,
+	"Beacon":       KindBeacon,
+	"Control":      KindCommandBlock, // mcje v1.10 and before
+}
+
+// KindOf returns the kind of a block entity
+func KindOf(com *nbt.Compound) Kind {
+	return kinds[ID(com)]
+}
+
+// IDOf returns the id of the kind for the edition
+func IDOf(kind Kind, edition asset.Edition) string {
+	pair, ok := ids[kind]
+	if !ok {
+		return ""
+	}
+
+	if edition == asset.BedrockEdition {
+		return pair[1]
+	}
+
+	return pair[0]
+}
+
+// New returns new block entity of the kind at the coordinate
+func New(kind Kind, edition asset.Edition, x, y, z int) *nbt.Compound {
+	com := nbt.NewCompoundTag("", map[string]nbt.Tag{
+		"id": nbt.NewStringTag("id", IDOf(kind, edition)),
+	})
+
+	SetPos(com, Pos{X: x, Y: y, Z: z})
+
+	return com
+}
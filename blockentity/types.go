@@ -0,0 +1,557 @@
+package blockentity
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/beito123/level/asset"
+	"github.com/beito123/nbt"
+)
+
+// BlockEntity is a view of block entity nbt data
+// Values are read and written to Compound directly
+type BlockEntity struct {
+	*nbt.Compound
+
+	Edition asset.Edition
+}
+
+func wrap(com *nbt.Compound, edition asset.Edition) BlockEntity {
+	return BlockEntity{
+		Compound: com,
+		Edition:  edition,
+	}
+}
+
+// ID returns the id of the block entity
+func (be BlockEntity) ID() string {
+	return ID(be.Compound)
+}
+
+// Pos returns the coordinate of the block entity
+func (be BlockEntity) Pos() (Pos, bool) {
+	return PosOf(be.Compound)
+}
+
+// CustomName returns the custom name
+// It's a json text for mcje v1.13 and after
+func (be BlockEntity) CustomName() string {
+	return be.getString("CustomName")
+}
+
+// SetCustomName sets the custom name
+func (be BlockEntity) SetCustomName(name string) {
+	be.Set(nbt.NewStringTag("CustomName", name))
+}
+
+func (be BlockEntity) getString(name string) string {
+	val, err := be.GetString(name)
+	if err != nil {
+		return ""
+	}
+
+	return val
+}
+
+func (be BlockEntity) getInt(name string) int {
+	tag, ok := be.Get(name)
+	if !ok {
+		return 0
+	}
+
+	val, err := tag.ToInt()
+	if err != nil {
+		return 0
+	}
+
+	return val
+}
+
+func (be BlockEntity) setBool(name string, val bool) {
+	var b int8
+	if val {
+		b = 1
+	}
+
+	be.Set(nbt.NewByteTag(name, b))
+}
+
+// Item is a item in containers
+type Item struct {
+	Slot  int
+	Name  string // such as minecraft:diamond
+	Count int
+
+	// Damage is a meta of the item for mcbe and mcje v1.12 and before
+	Damage int
+
+	Tag *nbt.Compound
+}
+
+// readItem reads a item
+// Items for mcje have id, but items for mcbe have Name
+func readItem(com *nbt.Compound, edition asset.Edition) Item {
+	be := BlockEntity{Compound: com}
+
+	item := Item{
+		Slot:   be.getInt("Slot"),
+		Count:  be.getInt("Count"),
+		Damage: be.getInt("Damage"),
+	}
+
+	if edition == asset.BedrockEdition {
+		item.Name = be.getString("Name")
+	} else {
+		item.Name = be.getString("id")
+	}
+
+	if com.Has("tag") {
+		item.Tag, _ = com.GetCompound("tag")
+	}
+
+	return item
+}
+
+// writeItem returns a compound of the item
+func writeItem(item Item, edition asset.Edition) *nbt.Compound {
+	com := nbt.NewCompoundTag("", map[string]nbt.Tag{
+		"Slot":  nbt.NewByteTag("Slot", int8(item.Slot)),
+		"Count": nbt.NewByteTag("Count", int8(item.Count)),
+	})
+
+	if edition == asset.BedrockEdition {
+		com.Set(nbt.NewStringTag("Name", item.Name))
+		com.Set(nbt.NewShortTag("Damage", int16(item.Damage)))
+	} else {
+		com.Set(nbt.NewStringTag("id", item.Name))
+
+		if item.Damage != 0 {
+			com.Set(nbt.NewShortTag("Damage", int16(item.Damage)))
+		}
+	}
+
+	if item.Tag != nil {
+		tag := *item.Tag
+		tag.SetName("tag")
+
+		com.Set(&tag)
+	}
+
+	return com
+}
+
+// Chest is a view of chests
+type Chest struct {
+	BlockEntity
+}
+
+// AsChest returns a view of the block entity as a chest
+// edition is the edition of the chunk which has it
+// If it's not a chest, returns false for ok
+func AsChest(com *nbt.Compound, edition asset.Edition) (*Chest, bool) {
+	if KindOf(com) != KindChest {
+		return nil, false
+	}
+
+	return &Chest{wrap(com, edition)}, true
+}
+
+// NewChest returns new empty chest at the coordinate
+func NewChest(edition asset.Edition, x, y, z int) *Chest {
+	chest := &Chest{wrap(New(KindChest, edition, x, y, z), edition)}
+	chest.SetItems(nil)
+
+	return chest
+}
+
+// Items returns items in the chest
+func (chest *Chest) Items() []Item {
+	list, err := chest.GetList("Items")
+	if err != nil {
+		return nil
+	}
+
+	items := make([]Item, 0, len(list))
+	for _, tag := range list {
+		com, ok := tag.(*nbt.Compound)
+		if !ok {
+			continue
+		}
+
+		items = append(items, readItem(com, chest.Edition))
+	}
+
+	return items
+}
+
+// Item returns a item in the slot
+func (chest *Chest) Item(slot int) (Item, bool) {
+	for _, item := range chest.Items() {
+		if item.Slot == slot {
+			return item, true
+		}
+	}
+
+	return Item{}, false
+}
+
+// SetItems sets items in the chest
+func (chest *Chest) SetItems(items []Item) {
+	list := make([]nbt.Tag, len(items))
+	for i, item := range items {
+		list[i] = writeItem(item, chest.Edition)
+	}
+
+	chest.Set(nbt.NewListTag("Items", list, nbt.IDTagCompound))
+}
+
+// SetItem sets a item to the slot of the item
+// If Count of the item is 0, the item in the slot is removed
+func (chest *Chest) SetItem(item Item) {
+	var items []Item
+	for _, it := range chest.Items() {
+		if it.Slot != item.Slot {
+			items = append(items, it)
+		}
+	}
+
+	if item.Count > 0 {
+		items = append(items, item)
+	}
+
+	chest.SetItems(items)
+}
+
+// SignLines is the number of lines of signs
+const SignLines = 4
+
+// Sign is a view of signs
+type Sign struct {
+	BlockEntity
+}
+
+// AsSign returns a view of the block entity as a sign
+// edition is the edition of the chunk which has it
+// If it's not a sign, returns false for ok
+func AsSign(com *nbt.Compound, edition asset.Edition) (*Sign, bool) {
+	if KindOf(com) != KindSign {
+		return nil, false
+	}
+
+	return &Sign{wrap(com, edition)}, true
+}
+
+// NewSign returns new sign with empty lines at the coordinate
+func NewSign(edition asset.Edition, x, y, z int) *Sign {
+	sign := &Sign{wrap(New(KindSign, edition, x, y, z), edition)}
+	sign.SetLines([SignLines]string{})
+
+	return sign
+}
+
+// Lines returns texts of the sign as plain texts
+// Json texts for mcje are converted to plain texts
+func (sign *Sign) Lines() [SignLines]string {
+	var lines [SignLines]string
+
+	if sign.Edition == asset.BedrockEdition {
+		copy(lines[:], strings.Split(sign.getString("Text"), "\n"))
+
+		return lines
+	}
+
+	for i := range lines {
+		lines[i] = PlainText(sign.getString("Text" + strconv.Itoa(i+1)))
+	}
+
+	return lines
+}
+
+// SetLines sets texts of the sign
+// Texts are saved as json texts for mcje
+func (sign *Sign) SetLines(lines [SignLines]string) {
+	if sign.Edition == asset.BedrockEdition {
+		sign.Set(nbt.NewStringTag("Text", strings.Join(lines[:], "\n")))
+
+		return
+	}
+
+	for i, line := range lines {
+		sign.Set(nbt.NewStringTag("Text"+strconv.Itoa(i+1), JSONText(line)))
+	}
+}
+
+// textComponent is a json text component for mcje
+type textComponent struct {
+	Text  string          `json:"text"`
+	Extra json.RawMessage `json:"extra"`
+}
+
+// PlainText returns a plain text from a json text for mcje
+// If it isn't a json text, returns it as it is
+func PlainText(s string) string {
+	var str string
+	if json.Unmarshal([]byte(s), &str) == nil {
+		return str
+	}
+
+	var com textComponent
+	if json.Unmarshal([]byte(s), &com) == nil {
+		text := com.Text
+
+		var extra []json.RawMessage
+		if json.Unmarshal(com.Extra, &extra) == nil {
+			for _, e := range extra {
+				text += PlainText(string(e))
+			}
+		}
+
+		return text
+	}
+
+	var list []json.RawMessage
+	if json.Unmarshal([]byte(s), &list) == nil {
+		var text string
+		for _, e := range list {
+			text += PlainText(string(e))
+		}
+
+		return text
+	}
+
+	return s
+}
+
+// JSONText returns a json text for mcje from a plain text
+func JSONText(s string) string {
+	b, err := json.Marshal(map[string]string{"text": s})
+	if err != nil {
+		return s
+	}
+
+	return string(b)
+}
+
+// Pattern is a pattern of banners
+type Pattern struct {
+	Pattern string // such as "bts"
+	Color   int
+}
+
+// Banner is a view of banners
+type Banner struct {
+	BlockEntity
+}
+
+// AsBanner returns a view of the block entity as a banner
+// edition is the edition of the chunk which has it
+// If it's not a banner, returns false for ok
+func AsBanner(com *nbt.Compound, edition asset.Edition) (*Banner, bool) {
+	if KindOf(com) != KindBanner {
+		return nil, false
+	}
+
+	return &Banner{wrap(com, edition)}, true
+}
+
+// NewBanner returns new banner without patterns at the coordinate
+func NewBanner(edition asset.Edition, x, y, z int) *Banner {
+	banner := &Banner{wrap(New(KindBanner, edition, x, y, z), edition)}
+	banner.SetPatterns(nil)
+
+	return banner
+}
+
+// Base returns the base color of the banner
+// The color is in block name for mcje v1.13 and after, returns false for ok
+func (banner *Banner) Base() (color int, ok bool) {
+	if !banner.Has("Base") {
+		return 0, false
+	}
+
+	return banner.getInt("Base"), true
+}
+
+// SetBase sets the base color of the banner
+func (banner *Banner) SetBase(color int) {
+	banner.Set(nbt.NewIntTag("Base", int32(color)))
+}
+
+// Patterns returns patterns of the banner
+func (banner *Banner) Patterns() []Pattern {
+	list, err := banner.GetList("Patterns")
+	if err != nil {
+		return nil
+	}
+
+	patterns := make([]Pattern, 0, len(list))
+	for _, tag := range list {
+		com, ok := tag.(*nbt.Compound)
+		if !ok {
+			continue
+		}
+
+		be := BlockEntity{Compound: com}
+
+		patterns = append(patterns, Pattern{
+			Pattern: be.getString("Pattern"),
+			Color:   be.getInt("Color"),
+		})
+	}
+
+	return patterns
+}
+
+// SetPatterns sets patterns of the banner
+func (banner *Banner) SetPatterns(patterns []Pattern) {
+	list := make([]nbt.Tag, len(patterns))
+	for i, pattern := range patterns {
+		list[i] = nbt.NewCompoundTag("", map[string]nbt.Tag{
+			"Pattern": nbt.NewStringTag("Pattern", pattern.Pattern),
+			"Color":   nbt.NewIntTag("Color", int32(pattern.Color)),
+		})
+	}
+
+	banner.Set(nbt.NewListTag("Patterns", list, nbt.IDTagCompound))
+}
+
+// Spawner is a view of mob spawners
+type Spawner struct {
+	BlockEntity
+}
+
+// AsSpawner returns a view of the block entity as a mob spawner
+// edition is the edition of the chunk which has it
+// If it's not a mob spawner, returns false for ok
+func AsSpawner(com *nbt.Compound, edition asset.Edition) (*Spawner, bool) {
+	if KindOf(com) != KindSpawner {
+		return nil, false
+	}
+
+	return &Spawner{wrap(com, edition)}, true
+}
+
+// NewSpawner returns new mob spawner of the entity at the coordinate
+func NewSpawner(edition asset.Edition, x, y, z int, entity string) *Spawner {
+	spawner := &Spawner{wrap(New(KindSpawner, edition, x, y, z), edition)}
+	spawner.SetEntityID(entity)
+	spawner.SetDelay(20)
+
+	return spawner
+}
+
+// EntityID returns the id of spawned entities
+func (spawner *Spawner) EntityID() string {
+	if spawner.Edition == asset.BedrockEdition {
+		return spawner.getString("EntityIdentifier")
+	}
+
+	if spawner.Has("SpawnData") {
+		data, err := spawner.GetCompound("SpawnData")
+		if err == nil {
+			return BlockEntity{Compound: data}.getString("id")
+		}
+	}
+
+	return spawner.getString("EntityId") // mcje v1.8 and before
+}
+
+// SetEntityID sets the id of spawned entities
+func (spawner *Spawner) SetEntityID(id string) {
+	if spawner.Edition == asset.BedrockEdition {
+		spawner.Set(nbt.NewStringTag("EntityIdentifier", id))
+
+		return
+	}
+
+	spawner.Set(nbt.NewCompoundTag("SpawnData", map[string]nbt.Tag{
+		"id": nbt.NewStringTag("id", id),
+	}))
+}
+
+// Delay returns ticks until next spawn
+func (spawner *Spawner) Delay() int {
+	return spawner.getInt("Delay")
+}
+
+// SetDelay sets ticks until next spawn
+func (spawner *Spawner) SetDelay(delay int) {
+	spawner.Set(nbt.NewShortTag("Delay", int16(delay)))
+}
+
+// CommandBlock is a view of command blocks
+type CommandBlock struct {
+	BlockEntity
+}
+
+// AsCommandBlock returns a view of the block entity as a command block
+// edition is the edition of the chunk which has it
+// If it's not a command block, returns false for ok
+func AsCommandBlock(com *nbt.Compound, edition asset.Edition) (*CommandBlock, bool) {
+	if KindOf(com) != KindCommandBlock {
+		return nil, false
+	}
+
+	return &CommandBlock{wrap(com, edition)}, true
+}
+
+// NewCommandBlock returns new command block with the command at the coordinate
+func NewCommandBlock(edition asset.Edition, x, y, z int, command string) *CommandBlock {
+	cb := &CommandBlock{wrap(New(KindCommandBlock, edition, x, y, z), edition)}
+	cb.SetCommand(command)
+	cb.SetAuto(false)
+	cb.SetPowered(false)
+	cb.SetTrackOutput(true)
+
+	return cb
+}
+
+// Command returns the command
+func (cb *CommandBlock) Command() string {
+	return cb.getString("Command")
+}
+
+// SetCommand sets the command
+func (cb *CommandBlock) SetCommand(command string) {
+	cb.Set(nbt.NewStringTag("Command", command))
+}
+
+// Auto returns whether the command block doesn't need redstone
+func (cb *CommandBlock) Auto() bool {
+	return cb.getInt("auto") != 0
+}
+
+// SetAuto sets whether the command block doesn't need redstone
+func (cb *CommandBlock) SetAuto(auto bool) {
+	cb.setBool("auto", auto)
+}
+
+// Powered returns whether the command block is powered by redstone
+func (cb *CommandBlock) Powered() bool {
+	return cb.getInt("powered") != 0
+}
+
+// SetPowered sets whether the command block is powered by redstone
+func (cb *CommandBlock) SetPowered(powered bool) {
+	cb.setBool("powered", powered)
+}
+
+// TrackOutput returns whether the command block saves the last output
+func (cb *CommandBlock) TrackOutput() bool {
+	return cb.getInt("TrackOutput") != 0
+}
+
+// SetTrackOutput sets whether the command block saves the last output
+func (cb *CommandBlock) SetTrackOutput(track bool) {
+	cb.setBool("TrackOutput", track)
+}
@@ -0,0 +1,218 @@
+package main
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/anvil"
+	"github.com/beito123/level/asset"
+	"github.com/beito123/level/block"
+	"github.com/beito123/level/leveldb"
+	"github.com/beito123/nbt"
+)
+
+func runGetBlock(cmd *command, args []string) error {
+	fs := cmd.flags()
+	dim := dimensionFlag(fs)
+	layer := fs.Int("layer", 0, "block layer (1 is water in waterlogged blocks of mcbe)")
+
+	err := cmd.parse(fs, args, 4, 4)
+	if err != nil {
+		return err
+	}
+
+	pos, err := parseInts(fs.Args()[1:]...)
+	if err != nil {
+		return err
+	}
+
+	format, err := openWorld(fs.Arg(0), *dim)
+	if err != nil {
+		return err
+	}
+
+	chunk, err := blockChunk(format, pos[0], pos[2], false)
+	if err != nil {
+		return closeWorld(format, err)
+	}
+
+	bs, err := chunk.GetBlockAtLayer(pos[0]&15, pos[1], pos[2]&15, *layer)
+	if err != nil {
+		return closeWorld(format, err)
+	}
+
+	fmt.Println(formatBlockState(bs))
+
+	return closeWorld(format, nil)
+}
+
+func runSetBlock(cmd *command, args []string) error {
+	fs := cmd.flags()
+	dim := dimensionFlag(fs)
+	layer := fs.Int("layer", 0, "block layer (1 is water in waterlogged blocks of mcbe)")
+
+	err := cmd.parse(fs, args, 5, 5)
+	if err != nil {
+		return err
+	}
+
+	pos, err := parseInts(fs.Args()[1:4]...)
+	if err != nil {
+		return err
+	}
+
+	format, err := openWorld(fs.Arg(0), *dim)
+	if err != nil {
+		return err
+	}
+
+	bs, err := parseBlockState(fs.Arg(4), editionOf(format))
+	if err != nil {
+		return closeWorld(format, err)
+	}
+
+	chunk, err := blockChunk(format, pos[0], pos[2], true)
+	if err != nil {
+		return closeWorld(format, err)
+	}
+
+	err = chunk.SetBlockAtLayer(pos[0]&15, pos[1], pos[2]&15, *layer, bs)
+	if err != nil {
+		return closeWorld(format, err)
+	}
+
+	err = format.SaveChunk(pos[0]>>4, pos[2]>>4)
+	if err != nil {
+		return closeWorld(format, err)
+	}
+
+	fmt.Println(formatBlockState(bs))
+
+	return closeWorld(format, nil)
+}
+
+// blockChunk returns the chunk containing the block at world coordinate
+func blockChunk(format level.Format, x, z int, create bool) (level.Chunk, error) {
+	cx, cz := x>>4, z>>4
+
+	if !create {
+		ok, err := format.HasGeneratedChunk(cx, cz)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			return nil, fmt.Errorf("the chunk %d, %d isn't generated", cx, cz)
+		}
+	}
+
+	err := format.LoadChunk(cx, cz, create)
+	if err != nil {
+		return nil, err
+	}
+
+	return format.Chunk(cx, cz)
+}
+
+// formatBlockState returns a string of the block state such as minecraft:chest[facing=north] or minecraft:wool:14
+func formatBlockState(bs level.BlockState) string {
+	if name, meta, ok := bs.ToBlockNameMeta(); ok {
+		if meta == 0 {
+			return name
+		}
+
+		return name + ":" + strconv.Itoa(meta)
+	}
+
+	if name, properties, ok := bs.ToBlockNameProperties(); ok {
+		return block.FormatState(name, properties)
+	}
+
+	return bs.Name()
+}
+
+// parseBlockState parses a block state such as minecraft:chest[facing=north] or minecraft:wool:14 for the edition
+func parseBlockState(s string, edition asset.Edition) (level.BlockState, error) {
+	name, meta, isMeta := splitMeta(s)
+
+	if edition == asset.BedrockEdition {
+		if isMeta {
+			return leveldb.NewRawBlockState(name, meta), nil
+		}
+
+		name, properties, err := block.ParseState(s)
+		if err != nil {
+			return nil, err
+		}
+
+		states := nbt.NewCompoundTag("states", make(map[string]nbt.Tag))
+		for key, val := range properties {
+			states.Set(stateTag(key, val))
+		}
+
+		return leveldb.NewRawBlockStateWithStates(name, states, leveldb.BlockStateVersionV114), nil
+	}
+
+	if isMeta {
+		s = block.GetV112ToV113(name, meta)
+	}
+
+	name, properties, err := block.ParseState(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return anvil.NewBlockState(name, properties), nil
+}
+
+// splitMeta splits a block name with meta such as minecraft:wool:14
+func splitMeta(s string) (name string, meta int, ok bool) {
+	if strings.Contains(s, "[") {
+		return s, 0, false
+	}
+
+	i := strings.LastIndex(s, ":")
+	if i == -1 {
+		return s, 0, false
+	}
+
+	meta, err := strconv.Atoi(s[i+1:])
+	if err != nil {
+		return s, 0, false
+	}
+
+	name = s[:i]
+	if !strings.Contains(name, ":") {
+		name = block.MinecraftPrefix + name
+	}
+
+	return name, meta, true
+}
+
+// stateTag returns a tag of a block state for mcbe
+// true and false are byte, numbers are int and others are string
+func stateTag(key, val string) nbt.Tag {
+	switch val {
+	case "true":
+		return nbt.NewByteTag(key, 1)
+	case "false":
+		return nbt.NewByteTag(key, 0)
+	}
+
+	if n, err := strconv.Atoi(val); err == nil {
+		return nbt.NewIntTag(key, int32(n))
+	}
+
+	return nbt.NewStringTag(key, val)
+}
@@ -0,0 +1,204 @@
+package main
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/blockentity"
+	"github.com/beito123/level/entity"
+)
+
+func runListChunks(cmd *command, args []string) error {
+	fs := cmd.flags()
+	dim := dimensionFlag(fs)
+	count := fs.Bool("count", false, "print only the number of chunks")
+
+	err := cmd.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	format, err := openWorld(fs.Arg(0), *dim)
+	if err != nil {
+		return err
+	}
+
+	coords, err := generatedChunks(format)
+	if err != nil {
+		return closeWorld(format, err)
+	}
+
+	if *count {
+		fmt.Println(len(coords))
+	} else {
+		for _, c := range coords {
+			fmt.Printf("%d %d\n", c.X, c.Y)
+		}
+	}
+
+	return closeWorld(format, nil)
+}
+
+func runPrune(cmd *command, args []string) error {
+	fs := cmd.flags()
+	dim := dimensionFlag(fs)
+	keep := fs.String("keep", "", "range of chunks to keep as x0,z0,x1,z1 (x1 and z1 are exclusive)")
+	dryRun := fs.Bool("n", false, "print chunks to delete without deleting")
+
+	err := cmd.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	if *keep == "" {
+		fs.Usage()
+
+		return errUsage
+	}
+
+	r, err := parseRange(*keep)
+	if err != nil {
+		return err
+	}
+
+	format, err := openWorld(fs.Arg(0), *dim)
+	if err != nil {
+		return err
+	}
+
+	deleter, ok := format.(level.ChunkDeleter)
+	if !ok {
+		return closeWorld(format, errors.New("the format can't delete chunks"))
+	}
+
+	coords, err := generatedChunks(format)
+	if err != nil {
+		return closeWorld(format, err)
+	}
+
+	deleted := 0
+	for _, c := range coords {
+		if r.Contains(c.X, c.Y) {
+			continue
+		}
+
+		if *dryRun {
+			fmt.Printf("%d %d\n", c.X, c.Y)
+		} else {
+			err = deleter.DeleteChunk(c.X, c.Y)
+			if err != nil {
+				return closeWorld(format, err)
+			}
+		}
+
+		deleted++
+	}
+
+	if *dryRun {
+		fmt.Printf("%d of %d chunks will be deleted\n", deleted, len(coords))
+	} else {
+		fmt.Printf("deleted %d of %d chunks\n", deleted, len(coords))
+	}
+
+	return closeWorld(format, nil)
+}
+
+func runValidate(cmd *command, args []string) error {
+	fs := cmd.flags()
+	dim := dimensionFlag(fs)
+
+	err := cmd.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	format, err := openWorld(fs.Arg(0), *dim)
+	if err != nil {
+		return err
+	}
+
+	coords, err := generatedChunks(format)
+	if err != nil {
+		return closeWorld(format, err)
+	}
+
+	problems := 0
+	for _, c := range coords {
+		for _, problem := range validateChunk(format, c.X, c.Y) {
+			fmt.Printf("chunk %d, %d: %s\n", c.X, c.Y, problem)
+
+			problems++
+		}
+	}
+
+	fmt.Printf("checked %d chunks, %d problems\n", len(coords), problems)
+
+	if problems > 0 {
+		err = fmt.Errorf("found %d problems", problems)
+	}
+
+	return closeWorld(format, err)
+}
+
+// validateChunk returns problems of the chunk
+func validateChunk(format level.Format, x, y int) []string {
+	chunk, err := format.Chunk(x, y)
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	defer format.UnloadChunk(x, y)
+
+	var problems []string
+
+	for by := 0; by < 256; by++ {
+		for bz := 0; bz < 16; bz++ {
+			for bx := 0; bx < 16; bx++ {
+				_, err := chunk.GetBlock(bx, by, bz)
+				if err != nil {
+					return append(problems, fmt.Sprintf("block at %d, %d, %d: %s", bx, by, bz, err))
+				}
+			}
+		}
+	}
+
+	for _, com := range chunk.BlockEntities() {
+		pos, ok := blockentity.PosOf(com)
+		if !ok {
+			problems = append(problems, fmt.Sprintf("block entity %s doesn't have a position", blockentity.ID(com)))
+
+			continue
+		}
+
+		if pos.X>>4 != x || pos.Z>>4 != y {
+			problems = append(problems, fmt.Sprintf("block entity %s at %d, %d, %d is out of the chunk", blockentity.ID(com), pos.X, pos.Y, pos.Z))
+		}
+	}
+
+	for _, com := range chunk.Entities() {
+		en := entity.Wrap(com)
+
+		cx, cy, ok := en.ChunkPos()
+		if !ok {
+			problems = append(problems, fmt.Sprintf("entity %s doesn't have a position", en.Identifier()))
+
+			continue
+		}
+
+		if cx != x || cy != y {
+			problems = append(problems, fmt.Sprintf("entity %s is in the chunk %d, %d", en.Identifier(), cx, cy))
+		}
+	}
+
+	return problems
+}
@@ -0,0 +1,336 @@
+package main
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/anvil"
+	"github.com/beito123/level/asset"
+	"github.com/beito123/level/block"
+	"github.com/beito123/level/blockentity"
+	"github.com/beito123/level/entity"
+	"github.com/beito123/level/leveldb"
+	"github.com/beito123/level/util"
+	"github.com/beito123/nbt"
+)
+
+func runConvert(cmd *command, args []string) error {
+	fs := cmd.flags()
+	dim := dimensionFlag(fs)
+	to := fs.String("to", "", "edition of the output world (java or bedrock, default the other edition)")
+	rangeFlag := fs.String("range", "", "range of chunks as x0,z0,x1,z1 (default all generated chunks)")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: level %s [flags] %s\n", cmd.Name, cmd.Args)
+		fmt.Fprintf(fs.Output(), "%s\n", cmd.Short)
+		fmt.Fprintln(fs.Output(), "Legacy ids and metas are converted to block states of v1.13,")
+		fmt.Fprintln(fs.Output(), "but names and properties of blocks aren't translated between editions.")
+		fmt.Fprintln(fs.Output(), "Entities and block entities between editions keep only common data.")
+
+		fs.PrintDefaults()
+	}
+
+	err := cmd.parse(fs, args, 2, 2)
+	if err != nil {
+		return err
+	}
+
+	out := fs.Arg(1)
+	if util.ExistFile(out) || util.ExistDir(out) {
+		return fmt.Errorf("%s already exists", out)
+	}
+
+	src, err := openWorld(fs.Arg(0), *dim)
+	if err != nil {
+		return err
+	}
+
+	edition := asset.JavaEdition
+	if editionOf(src) == asset.JavaEdition {
+		edition = asset.BedrockEdition
+	}
+
+	if *to != "" {
+		edition, err = editionByName(*to)
+		if err != nil {
+			return closeWorld(src, err)
+		}
+	}
+
+	cr, err := renderRange(src, *rangeFlag)
+	if err != nil {
+		return closeWorld(src, err)
+	}
+
+	coords, err := generatedChunks(src)
+	if err != nil {
+		return closeWorld(src, err)
+	}
+
+	var dst level.Format
+	if edition == asset.BedrockEdition {
+		dst, err = leveldb.New(out)
+	} else {
+		dst, err = anvil.New(out)
+	}
+
+	if err != nil {
+		return closeWorld(src, err)
+	}
+
+	dst.SetDimension(src.Dimension())
+	dst.SetName(src.Name())
+	dst.SetGameType(src.GameType())
+	dst.SetSpawn(src.Spawn())
+
+	if lvl, ok := dst.(*leveldb.LevelDB); ok { // leveldb doesn't save level.dat when it's closed
+		err = leveldb.SaveLevelData(filepath.Clean(out), &leveldb.Properties{
+			Data:    lvl.AllProperties(),
+			Version: lvl.PropertiesVersion(),
+		})
+
+		if err != nil {
+			dst.Close()
+
+			return closeWorld(src, err)
+		}
+	}
+
+	converted := 0
+	for _, c := range coords {
+		if !cr.Contains(c.X, c.Y) {
+			continue
+		}
+
+		err = convertChunk(src, dst, edition, c.X, c.Y)
+		if err != nil {
+			dst.Close()
+
+			return closeWorld(src, fmt.Errorf("chunk %d, %d: %s", c.X, c.Y, err))
+		}
+
+		converted++
+	}
+
+	fmt.Printf("converted %d chunks to %s (%s)\n", converted, out, edition.Name())
+
+	return closeWorld(src, dst.Close())
+}
+
+// convertChunk copies the chunk from src to dst for the edition
+func convertChunk(src, dst level.Format, edition asset.Edition, x, y int) error {
+	from, err := src.Chunk(x, y)
+	if err != nil {
+		return err
+	}
+
+	defer src.UnloadChunk(x, y)
+
+	err = dst.LoadChunk(x, y, true)
+	if err != nil {
+		return err
+	}
+
+	defer dst.UnloadChunk(x, y)
+
+	to, err := dst.Chunk(x, y)
+	if err != nil {
+		return err
+	}
+
+	srcEdition := editionOf(src)
+	same := srcEdition == edition
+
+	for by := 0; by < 256; by++ {
+		for bz := 0; bz < 16; bz++ {
+			for bx := 0; bx < 16; bx++ {
+				err = convertBlock(from, to, edition, bx, by, bz)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for bz := 0; bz < 16; bz++ {
+		for bx := 0; bx < 16; bx++ {
+			to.SetBiome(bx, bz, from.Biome(bx, bz))
+		}
+	}
+
+	if same {
+		to.SetEntities(from.Entities())
+
+		for _, com := range from.BlockEntities() {
+			err = to.SetBlockEntity(com)
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		var entities []*nbt.Compound
+		for _, com := range from.Entities() {
+			if en, ok := convertEntity(com, edition); ok {
+				entities = append(entities, en)
+			}
+		}
+
+		to.SetEntities(entities)
+
+		for _, com := range from.BlockEntities() {
+			be, ok := convertBlockEntity(com, srcEdition, edition)
+			if !ok {
+				continue
+			}
+
+			err = to.SetBlockEntity(be)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	var ticks []level.ScheduledTick
+	for _, tick := range from.ScheduledTicks() {
+		bs, err := convertBlockState(tick.Block, edition)
+		if err != nil {
+			continue
+		}
+
+		tick.Block = bs
+		ticks = append(ticks, tick)
+	}
+
+	to.SetScheduledTicks(ticks)
+
+	return dst.SaveChunk(x, y)
+}
+
+// convertBlock copies the block and the water of waterlogged blocks at the xyz (chunk coordinate)
+func convertBlock(from, to level.Chunk, edition asset.Edition, x, y, z int) error {
+	bs, err := from.GetBlockAtLayer(x, y, z, level.LayerBlock)
+	if err != nil {
+		return err
+	}
+
+	if !block.IsAir(bs.Name()) {
+		state, err := convertBlockState(bs, edition)
+		if err != nil {
+			return err
+		}
+
+		err = to.SetBlockAtLayer(x, y, z, level.LayerBlock, state)
+		if err != nil {
+			return err
+		}
+	}
+
+	liquid, err := from.GetBlockAtLayer(x, y, z, level.LayerLiquid)
+	if err != nil || !block.IsWater(liquid.Name()) {
+		return nil // some blocks don't have the liquid layer
+	}
+
+	var water level.BlockState = anvil.NewWaterBlockState()
+	if edition == asset.BedrockEdition {
+		water = leveldb.NewWaterBlockState(leveldb.BlockStateVersionV114)
+	}
+
+	return to.SetBlockAtLayer(x, y, z, level.LayerLiquid, water)
+}
+
+// convertBlockState converts the block state for the edition
+// Legacy ids and metas are converted to block states of v1.13 for mcje
+func convertBlockState(bs level.BlockState, edition asset.Edition) (level.BlockState, error) {
+	if edition == asset.BedrockEdition {
+		rbs, err := leveldb.FromRawBlockState(bs)
+		if err != nil {
+			return nil, err
+		}
+
+		return rbs, nil
+	}
+
+	if _, ok := bs.(*anvil.BlockState); ok {
+		return bs, nil
+	}
+
+	if name, meta, ok := bs.ToBlockNameMeta(); ok {
+		name, properties, err := block.ParseState(block.GetV112ToV113(name, meta))
+		if err != nil {
+			return nil, err
+		}
+
+		return anvil.NewBlockState(name, properties), nil
+	}
+
+	return anvil.FromBlockState(bs)
+}
+
+// convertEntity returns new entity for the edition with the identifier, the position, the rotation, the motion and the custom name
+func convertEntity(com *nbt.Compound, edition asset.Edition) (*nbt.Compound, bool) {
+	src := entity.Wrap(com)
+
+	x, y, z, ok := src.Pos()
+	if !ok {
+		return nil, false
+	}
+
+	en := entity.New(edition, src.Identifier(), x, y, z)
+
+	if yaw, pitch, ok := src.Rotation(); ok {
+		en.SetRotation(yaw, pitch)
+	}
+
+	if mx, my, mz, ok := src.Motion(); ok {
+		en.SetMotion(mx, my, mz)
+	}
+
+	if name := src.CustomName(); name != "" {
+		en.SetCustomName(name)
+	}
+
+	return en.Compound, true
+}
+
+// convertBlockEntity returns new block entity for the edition from a block entity of srcEdition
+// Texts of signs and items of chests are copied, other data are dropped
+// If the kind isn't supported, returns false for ok
+func convertBlockEntity(com *nbt.Compound, srcEdition, edition asset.Edition) (*nbt.Compound, bool) {
+	kind := blockentity.KindOf(com)
+	if kind == blockentity.KindUnknown {
+		return nil, false
+	}
+
+	pos, ok := blockentity.PosOf(com)
+	if !ok {
+		return nil, false
+	}
+
+	switch kind {
+	case blockentity.KindSign:
+		src, _ := blockentity.AsSign(com, srcEdition)
+
+		sign := blockentity.NewSign(edition, pos.X, pos.Y, pos.Z)
+		sign.SetLines(src.Lines())
+
+		return sign.Compound, true
+	case blockentity.KindChest:
+		src, _ := blockentity.AsChest(com, srcEdition)
+
+		chest := blockentity.NewChest(edition, pos.X, pos.Y, pos.Z)
+		chest.SetItems(src.Items())
+
+		return chest.Compound, true
+	}
+
+	return blockentity.New(kind, edition, pos.X, pos.Y, pos.Z), true
+}
@@ -0,0 +1,96 @@
+package main
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/beito123/level"
+)
+
+// infoProperties is properties of level.dat printed by info if they exist
+var infoProperties = []string{
+	"RandomSeed",
+	"Time",
+	"DataVersion",
+	"StorageVersion",
+	"NetworkVersion",
+	"Difficulty",
+	"hardcore",
+}
+
+func runInfo(cmd *command, args []string) error {
+	fs := cmd.flags()
+	dim := dimensionFlag(fs)
+
+	err := cmd.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	format, err := openWorld(fs.Arg(0), *dim)
+	if err != nil {
+		return err
+	}
+
+	return closeWorld(format, printInfo(format))
+}
+
+func printInfo(format level.Format) error {
+	x, y, z := format.Spawn()
+
+	fmt.Printf("%-16s %s\n", "Name:", format.Name())
+	fmt.Printf("%-16s %s\n", "Edition:", editionOf(format).Name())
+	fmt.Printf("%-16s %s\n", "Dimension:", format.Dimension().Name())
+	fmt.Printf("%-16s %s\n", "Game type:", format.GameType().Name())
+	fmt.Printf("%-16s %d, %d, %d\n", "Spawn:", x, y, z)
+
+	if tag, ok := format.Property("LastPlayed"); ok {
+		last, err := tag.ToInt64()
+		if err == nil {
+			t := time.Unix(last, 0)
+			if last > 1e11 { // milliseconds in mcje
+				t = time.Unix(0, last*int64(time.Millisecond))
+			}
+
+			fmt.Printf("%-16s %s\n", "Last played:", t.Format(time.RFC3339))
+		}
+	}
+
+	for _, name := range infoProperties {
+		tag, ok := format.Property(name)
+		if !ok {
+			continue
+		}
+
+		val, err := tag.ToString()
+		if err != nil {
+			continue
+		}
+
+		fmt.Printf("%-16s %s\n", name+":", val)
+	}
+
+	if _, ok := format.(level.ChunkLister); ok {
+		coords, err := generatedChunks(format)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%-16s %d\n", "Chunks:", len(coords))
+
+		if r, ok := boundsOf(coords); ok {
+			fmt.Printf("%-16s %d,%d,%d,%d\n", "Chunk range:", r.X0, r.Y0, r.X1, r.Y1)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,292 @@
+package main
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/asset"
+)
+
+// command is a subcommand
+type command struct {
+	Name  string
+	Args  string
+	Short string
+	Run   func(cmd *command, args []string) error
+}
+
+// errUsage is returned for invaild arguments after usage is printed
+var errUsage = errors.New("invaild arguments")
+
+var commands []*command
+
+func init() {
+	commands = []*command{
+		{"info", "<world>", "print a summary of level.dat", runInfo},
+		{"ls-chunks", "<world>", "list generated chunks", runListChunks},
+		{"get-block", "<world> <x> <y> <z>", "print a block state", runGetBlock},
+		{"set-block", "<world> <x> <y> <z> <state>", "set a block state such as minecraft:stone or minecraft:wool:14", runSetBlock},
+		{"dump-nbt", "<world> <level|players|entities|block-entities|record> [args]", "print nbt data as a tree, snbt or json", runDumpNBT},
+		{"load-nbt", "<world> <level|players|entities|block-entities|record> [args] <file|->", "write nbt data from snbt or json", runLoadNBT},
+		{"render", "<world>", "render a map image or web map tiles", runRender},
+		{"convert", "<world> <output>", "copy chunks to a new world of an edition", runConvert},
+		{"prune", "<world>", "delete chunks out of a range", runPrune},
+		{"validate", "<world>", "check all chunks can be read", runValidate},
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: level <command> [flags] [args]")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "commands:")
+
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-15s %s\n", cmd.Name, cmd.Short)
+	}
+
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "Run 'level <command> -h' for flags of the command.")
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	name := os.Args[1]
+	if name == "help" || name == "-h" || name == "--help" {
+		usage()
+
+		return
+	}
+
+	for _, cmd := range commands {
+		if cmd.Name != name {
+			continue
+		}
+
+		err := cmd.Run(cmd, os.Args[2:])
+		if err == errUsage {
+			os.Exit(2)
+		}
+
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "level %s: %s\n", name, err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "level: unknown command %s\n", name)
+	usage()
+	os.Exit(2)
+}
+
+// flags returns a flag set for the command
+func (cmd *command) flags() *flag.FlagSet {
+	fs := flag.NewFlagSet(cmd.Name, flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: level %s [flags] %s\n", cmd.Name, cmd.Args)
+		fmt.Fprintf(os.Stderr, "%s\n", cmd.Short)
+
+		fs.PrintDefaults()
+	}
+
+	return fs
+}
+
+// parse parses flags and checks the number of arguments
+func (cmd *command) parse(fs *flag.FlagSet, args []string, min, max int) error {
+	err := fs.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if fs.NArg() < min || (max >= 0 && fs.NArg() > max) {
+		fs.Usage()
+
+		return errUsage
+	}
+
+	return nil
+}
+
+// dimensionFlag adds -dim flag
+func dimensionFlag(fs *flag.FlagSet) *string {
+	return fs.String("dim", "overworld", "dimension (overworld, nether or the_end)")
+}
+
+// dimensionByName returns a dimension by the name
+func dimensionByName(name string) (level.Dimension, error) {
+	for _, dim := range []level.Dimension{level.OverWorld, level.Nether, level.TheEnd} {
+		if dim.Name() == name || (dim == level.TheEnd && name == "end") {
+			return dim, nil
+		}
+	}
+
+	return level.Unknown, fmt.Errorf("unknown dimension %s", name)
+}
+
+// openWorld opens a world of leveldb (mcbe), anvil or mcregion (mcje) in the dimension
+func openWorld(path string, dimension string) (level.Format, error) {
+	dim, err := dimensionByName(dimension)
+	if err != nil {
+		return nil, err
+	}
+
+	format, err := level.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	format.SetDimension(dim)
+
+	return format, nil
+}
+
+// editioner is a format which has a edition
+type editioner interface {
+	Edition() asset.Edition
+}
+
+// editionOf returns the edition of the format
+func editionOf(format level.Format) asset.Edition {
+	if f, ok := format.(editioner); ok {
+		return f.Edition()
+	}
+
+	return asset.JavaEdition
+}
+
+// editionByName returns a edition by the name
+func editionByName(name string) (asset.Edition, error) {
+	for _, edition := range []asset.Edition{asset.JavaEdition, asset.BedrockEdition} {
+		if edition.Name() == name {
+			return edition, nil
+		}
+	}
+
+	return asset.JavaEdition, fmt.Errorf("unknown edition %s", name)
+}
+
+// parseInts parses integer arguments
+func parseInts(args ...string) ([]int, error) {
+	result := make([]int, len(args))
+	for i, arg := range args {
+		v, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invaild number %s", arg)
+		}
+
+		result[i] = v
+	}
+
+	return result, nil
+}
+
+// chunkRange is a range of chunks from x0, y0 to x1, y1 (exclusive)
+type chunkRange struct {
+	X0, Y0, X1, Y1 int
+}
+
+// Contains returns whether the range contains the chunk
+func (r chunkRange) Contains(x, y int) bool {
+	return x >= r.X0 && x < r.X1 && y >= r.Y0 && y < r.Y1
+}
+
+// parseRange parses a range "x0,z0,x1,z1" of chunk coordinate, x1 and z1 are exclusive
+func parseRange(s string) (chunkRange, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 4 {
+		return chunkRange{}, fmt.Errorf("invaild range %s, expected x0,z0,x1,z1", s)
+	}
+
+	v, err := parseInts(parts...)
+	if err != nil {
+		return chunkRange{}, err
+	}
+
+	if v[0] >= v[2] || v[1] >= v[3] {
+		return chunkRange{}, fmt.Errorf("invaild range %s, x1 and z1 need to be larger", s)
+	}
+
+	return chunkRange{v[0], v[1], v[2], v[3]}, nil
+}
+
+// generatedChunks returns sorted coordinates of all generated chunks
+func generatedChunks(format level.Format) ([]level.ChunkCoord, error) {
+	lister, ok := format.(level.ChunkLister)
+	if !ok {
+		return nil, errors.New("the format can't list chunks")
+	}
+
+	coords, err := lister.GeneratedChunks()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(coords, func(i, j int) bool {
+		if coords[i].Y != coords[j].Y {
+			return coords[i].Y < coords[j].Y
+		}
+
+		return coords[i].X < coords[j].X
+	})
+
+	return coords, nil
+}
+
+// boundsOf returns the range containing all chunks
+func boundsOf(coords []level.ChunkCoord) (chunkRange, bool) {
+	if len(coords) == 0 {
+		return chunkRange{}, false
+	}
+
+	r := chunkRange{coords[0].X, coords[0].Y, coords[0].X + 1, coords[0].Y + 1}
+	for _, c := range coords {
+		if c.X < r.X0 {
+			r.X0 = c.X
+		}
+
+		if c.Y < r.Y0 {
+			r.Y0 = c.Y
+		}
+
+		if c.X >= r.X1 {
+			r.X1 = c.X + 1
+		}
+
+		if c.Y >= r.Y1 {
+			r.Y1 = c.Y + 1
+		}
+	}
+
+	return r, true
+}
+
+// closeWorld closes the format, and returns err if it's not nil
+func closeWorld(format level.Format, err error) error {
+	cerr := format.Close()
+	if err != nil {
+		return err
+	}
+
+	return cerr
+}
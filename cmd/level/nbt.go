@@ -0,0 +1,495 @@
+package main
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/anvil"
+	"github.com/beito123/level/leveldb"
+	"github.com/beito123/level/render"
+	"github.com/beito123/level/snbt"
+	"github.com/beito123/level/util"
+	"github.com/beito123/nbt"
+)
+
+// maxArrayValues is the max number of printed values of arrays in tree format
+const maxArrayValues = 16
+
+// nbt text formats
+const (
+	formatTree = "tree"
+	formatSNBT = "snbt"
+	formatJSON = "json"
+)
+
+// playerSaver is a format which can save data of players
+type playerSaver interface {
+	SavePlayer(key string, com *nbt.Compound) error
+}
+
+func runDumpNBT(cmd *command, args []string) error {
+	fs := cmd.flags()
+	dim := dimensionFlag(fs)
+	textFormat := fs.String("format", formatTree, "output format (tree, snbt or json)")
+
+	err := cmd.parse(fs, args, 2, 4)
+	if err != nil {
+		return err
+	}
+
+	if *textFormat != formatTree && *textFormat != formatSNBT && *textFormat != formatJSON {
+		return fmt.Errorf("unknown format %s", *textFormat)
+	}
+
+	path := fs.Arg(0)
+
+	format, err := openWorld(path, *dim)
+	if err != nil {
+		return err
+	}
+
+	tag, err := nbtOf(format, path, fs.Arg(1), fs.Args()[2:])
+	if err != nil {
+		if err == errUsage {
+			fs.Usage()
+		}
+
+		return closeWorld(format, err)
+	}
+
+	switch *textFormat {
+	case formatSNBT:
+		fmt.Println(snbt.MarshalIndent(tag, "  "))
+	case formatJSON:
+		b, err := snbt.MarshalJSONIndent(tag, "", "  ")
+		if err != nil {
+			return closeWorld(format, err)
+		}
+
+		fmt.Println(string(b))
+	default:
+		printTag(os.Stdout, tag, 0)
+	}
+
+	return closeWorld(format, nil)
+}
+
+func runLoadNBT(cmd *command, args []string) error {
+	fs := cmd.flags()
+	dim := dimensionFlag(fs)
+	textFormat := fs.String("format", "", "input format (snbt or json, default by the extension of the file)")
+
+	err := cmd.parse(fs, args, 3, 5)
+	if err != nil {
+		return err
+	}
+
+	path := fs.Arg(0)
+	file := fs.Arg(fs.NArg() - 1)
+	targs := fs.Args()[2 : fs.NArg()-1]
+
+	if *textFormat == "" {
+		*textFormat = formatSNBT
+		if strings.EqualFold(filepath.Ext(file), ".json") {
+			*textFormat = formatJSON
+		}
+	}
+
+	tag, err := readText(file, *textFormat)
+	if err != nil {
+		return err
+	}
+
+	format, err := openWorld(path, *dim)
+	if err != nil {
+		return err
+	}
+
+	err = storeNBT(format, path, fs.Arg(1), targs, tag)
+	if err == errUsage {
+		fs.Usage()
+	}
+
+	return closeWorld(format, err)
+}
+
+// readText reads a tag from the file in snbt or json, "-" is stdin
+func readText(file string, textFormat string) (nbt.Tag, error) {
+	var b []byte
+	var err error
+
+	if file == "-" {
+		b, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		b, err = ioutil.ReadFile(file)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch textFormat {
+	case formatSNBT:
+		return snbt.Unmarshal(string(b))
+	case formatJSON:
+		return snbt.UnmarshalJSON(b)
+	}
+
+	return nil, fmt.Errorf("unknown format %s", textFormat)
+}
+
+// chunkArgs parses the coordinate of a chunk and checks the chunk is generated
+func chunkArgs(format level.Format, args []string) (x, y int, err error) {
+	if len(args) != 2 {
+		return 0, 0, errUsage
+	}
+
+	pos, err := parseInts(args...)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	ok, err := format.HasGeneratedChunk(pos[0], pos[1])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if !ok {
+		return 0, 0, fmt.Errorf("the chunk %d, %d isn't generated", pos[0], pos[1])
+	}
+
+	return pos[0], pos[1], nil
+}
+
+// nbtOf returns nbt data of the target
+// players is a compound of players by key, entities and block-entities are lists of compounds
+func nbtOf(format level.Format, path string, target string, args []string) (nbt.Tag, error) {
+	switch target {
+	case "level":
+		if len(args) != 0 {
+			return nil, errUsage
+		}
+
+		return format.AllProperties(), nil
+	case "players":
+		if len(args) > 1 {
+			return nil, errUsage
+		}
+
+		storage, ok := format.(render.PlayerStorage)
+		if !ok {
+			return nil, errors.New("the format doesn't have players")
+		}
+
+		players, err := storage.Players()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(args) == 1 {
+			com, ok := players[args[0]]
+			if !ok {
+				return nil, fmt.Errorf("couldn't find the player %s", args[0])
+			}
+
+			return com, nil
+		}
+
+		result := nbt.NewCompoundTag("", make(map[string]nbt.Tag))
+		for key, com := range players {
+			com.SetName(key)
+			result.Set(com)
+		}
+
+		return result, nil
+	case "entities", "block-entities":
+		x, y, err := chunkArgs(format, args)
+		if err != nil {
+			return nil, err
+		}
+
+		chunk, err := format.Chunk(x, y)
+		if err != nil {
+			return nil, err
+		}
+
+		list := chunk.Entities()
+		if target == "block-entities" {
+			list = chunk.BlockEntities()
+		}
+
+		tags := make([]nbt.Tag, len(list))
+		for i, com := range list {
+			tags[i] = com
+		}
+
+		return nbt.NewListTag("", tags, nbt.IDTagCompound), nil
+	case "record":
+		if len(args) != 1 {
+			return nil, errUsage
+		}
+
+		return recordOf(format, path, args[0])
+	}
+
+	return nil, errUsage
+}
+
+// storeNBT writes nbt data of the target
+func storeNBT(format level.Format, path string, target string, args []string, tag nbt.Tag) error {
+	switch target {
+	case "level":
+		if len(args) != 0 {
+			return errUsage
+		}
+
+		com, err := asCompound(tag)
+		if err != nil {
+			return err
+		}
+
+		format.SetAllProperties(com)
+
+		if lvl, ok := format.(*leveldb.LevelDB); ok { // anvil saves level.dat when it's closed
+			return leveldb.SaveLevelData(path, &leveldb.Properties{
+				Data:    com,
+				Version: lvl.PropertiesVersion(),
+			})
+		}
+
+		return nil
+	case "players":
+		if len(args) > 1 {
+			return errUsage
+		}
+
+		saver, ok := format.(playerSaver)
+		if !ok {
+			return errors.New("the format doesn't have players")
+		}
+
+		com, err := asCompound(tag)
+		if err != nil {
+			return err
+		}
+
+		if len(args) == 1 {
+			return saver.SavePlayer(args[0], com)
+		}
+
+		for key, player := range com.Value {
+			pcom, err := asCompound(player)
+			if err != nil {
+				return fmt.Errorf("%s: %s", key, err)
+			}
+
+			err = saver.SavePlayer(key, pcom)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case "entities", "block-entities":
+		x, y, err := chunkArgs(format, args)
+		if err != nil {
+			return err
+		}
+
+		list, err := asCompounds(tag)
+		if err != nil {
+			return err
+		}
+
+		chunk, err := format.Chunk(x, y)
+		if err != nil {
+			return err
+		}
+
+		if target == "block-entities" {
+			chunk.SetBlockEntities(list)
+		} else {
+			chunk.SetEntities(list)
+		}
+
+		return format.SaveChunk(x, y)
+	case "record":
+		if len(args) != 1 {
+			return errUsage
+		}
+
+		com, err := asCompound(tag)
+		if err != nil {
+			return err
+		}
+
+		return storeRecord(format, path, args[0], com)
+	}
+
+	return errUsage
+}
+
+// asCompound returns the tag as a compound
+func asCompound(tag nbt.Tag) (*nbt.Compound, error) {
+	com, ok := tag.(*nbt.Compound)
+	if !ok {
+		return nil, fmt.Errorf("unexpected %sTag, expected CompoundTag", nbt.GetTagName(tag.ID()))
+	}
+
+	return com, nil
+}
+
+// asCompounds returns the tag as a list of compounds
+func asCompounds(tag nbt.Tag) ([]*nbt.Compound, error) {
+	list, ok := tag.(*nbt.List)
+	if !ok {
+		return nil, fmt.Errorf("unexpected %sTag, expected ListTag", nbt.GetTagName(tag.ID()))
+	}
+
+	result := make([]*nbt.Compound, len(list.Value))
+	for i, child := range list.Value {
+		com, err := asCompound(child)
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = com
+	}
+
+	return result, nil
+}
+
+// recordPath returns the path of a data file in data directory of mcje
+func recordPath(path string, key string) string {
+	return filepath.Join(path, anvil.DataDir, key+".dat")
+}
+
+// recordOf returns a global record of mcbe or a data file in data directory of mcje
+func recordOf(format level.Format, path string, key string) (nbt.Tag, error) {
+	switch lvl := format.(type) {
+	case *leveldb.LevelDB:
+		com, ok, err := lvl.GlobalRecord(key)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			return nil, fmt.Errorf("couldn't find the record %s", key)
+		}
+
+		return com, nil
+	case *anvil.Anvil:
+		stream, err := nbt.FromFile(recordPath(path, key), nbt.BigEndian)
+		if err != nil {
+			return nil, err
+		}
+
+		return stream.ReadTag()
+	}
+
+	return nil, errors.New("the format doesn't have records")
+}
+
+// storeRecord writes a global record of mcbe or a gzipped data file in data directory of mcje
+func storeRecord(format level.Format, path string, key string, com *nbt.Compound) error {
+	switch lvl := format.(type) {
+	case *leveldb.LevelDB:
+		return lvl.SetGlobalRecord(key, com)
+	case *anvil.Anvil:
+		stream := nbt.NewStream(nbt.BigEndian)
+
+		err := stream.WriteTag(util.FixArrays(com))
+		if err != nil {
+			return err
+		}
+
+		b, err := nbt.Compress(stream, nbt.CompressGZip, nbt.DefaultCompressionLevel)
+		if err != nil {
+			return err
+		}
+
+		return ioutil.WriteFile(recordPath(path, key), b, os.ModePerm)
+	}
+
+	return errors.New("the format doesn't have records")
+}
+
+// printTag prints the tag as an indented tree
+func printTag(w io.Writer, tag nbt.Tag, depth int) {
+	indent := strings.Repeat("  ", depth)
+	label := nbt.GetTagName(tag.ID())
+	if tag.Name() != "" {
+		label = tag.Name() + " (" + label + ")"
+	}
+
+	switch t := tag.(type) {
+	case *nbt.Compound:
+		fmt.Fprintf(w, "%s%s: %d entries\n", indent, label, len(t.Value))
+
+		keys := make([]string, 0, len(t.Value))
+		for key := range t.Value {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			printTag(w, t.Value[key], depth+1)
+		}
+	case *nbt.List:
+		fmt.Fprintf(w, "%s%s: %d entries of %s\n", indent, label, len(t.Value), nbt.GetTagName(t.ListType))
+
+		for _, child := range t.Value {
+			printTag(w, child, depth+1)
+		}
+	case *nbt.ByteArray:
+		fmt.Fprintf(w, "%s%s: %s\n", indent, label, formatArray(len(t.Value), func(i int) interface{} { return t.Value[i] }))
+	case *nbt.IntArray:
+		fmt.Fprintf(w, "%s%s: %s\n", indent, label, formatArray(len(t.Value), func(i int) interface{} { return t.Value[i] }))
+	case *nbt.LongArray:
+		fmt.Fprintf(w, "%s%s: %s\n", indent, label, formatArray(len(t.Value), func(i int) interface{} { return t.Value[i] }))
+	case *nbt.String:
+		fmt.Fprintf(w, "%s%s: %q\n", indent, label, t.Value)
+	case *nbt.Float:
+		fmt.Fprintf(w, "%s%s: %g\n", indent, label, t.Value)
+	case *nbt.Double:
+		fmt.Fprintf(w, "%s%s: %g\n", indent, label, t.Value)
+	default:
+		val, err := tag.ToString()
+		if err != nil {
+			val = "?"
+		}
+
+		fmt.Fprintf(w, "%s%s: %s\n", indent, label, val)
+	}
+}
+
+// formatArray returns a string of an array, it's shortened if it's long
+func formatArray(n int, value func(i int) interface{}) string {
+	values := make([]string, 0, maxArrayValues+1)
+	for i := 0; i < n && i < maxArrayValues; i++ {
+		values = append(values, fmt.Sprint(value(i)))
+	}
+
+	if n > maxArrayValues {
+		values = append(values, "...")
+	}
+
+	return fmt.Sprintf("[%d] %s", n, strings.Join(values, " "))
+}
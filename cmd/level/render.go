@@ -0,0 +1,192 @@
+package main
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"errors"
+	"fmt"
+	"image/png"
+	"os"
+	"strings"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/render"
+)
+
+// stringList is a flag which can be repeated
+type stringList []string
+
+func (list *stringList) String() string {
+	return strings.Join(*list, ",")
+}
+
+func (list *stringList) Set(val string) error {
+	*list = append(*list, val)
+
+	return nil
+}
+
+func runRender(cmd *command, args []string) error {
+	var textures stringList
+
+	fs := cmd.flags()
+	dim := dimensionFlag(fs)
+	out := fs.String("o", "map.png", "output png file")
+	rangeFlag := fs.String("range", "", "range of chunks as x0,z0,x1,z1 (default all generated chunks)")
+	modeName := fs.String("mode", "topdown", "rendering mode (topdown, cave, slice, isometric or biome)")
+	scale := fs.Int("scale", 0, "pixels per block (default 1 for colors, 16 for textures)")
+	slice := fs.Int("slice", 0, "the highest y coordinate for slice mode")
+	overlay := fs.Int("biome-overlay", 0, "alpha (0-255) of biome colors drawn over the map")
+	markers := fs.Bool("markers", false, "draw markers of the spawn, players, entities, signs and beacons")
+	tiles := fs.String("tiles", "", "output directory of web map tiles instead of a png file")
+	zooms := fs.Int("zooms", render.DefaultZooms, "the number of zoom levels of tiles")
+	fs.Var(&textures, "textures", "resource pack of textures (directory or zip), can be repeated (default block colors)")
+
+	err := cmd.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	mode, ok := render.ModeByName(*modeName)
+	if !ok {
+		return fmt.Errorf("unknown mode %s", *modeName)
+	}
+
+	format, err := openWorld(fs.Arg(0), *dim)
+	if err != nil {
+		return err
+	}
+
+	var renderer *render.Renderer
+	var icons render.MarkerIcons
+
+	if len(textures) == 0 {
+		renderer = render.NewColorRenderer(format)
+	} else {
+		tm := render.NewTextureManager()
+		defer tm.Close()
+
+		for _, path := range textures {
+			err = tm.LoadResourcePack(path)
+			if err != nil {
+				return closeWorld(format, err)
+			}
+		}
+
+		renderer = render.NewRenderer(format, tm)
+		icons = tm.Icon
+	}
+
+	renderer.Mode = mode
+	renderer.BiomeOverlay = *overlay
+
+	if *scale > 0 {
+		renderer.Scale = *scale
+	}
+
+	if mode == render.ModeSlice {
+		renderer.SliceY = *slice
+	}
+
+	cr, err := renderRange(format, *rangeFlag)
+	if err != nil {
+		return closeWorld(format, err)
+	}
+
+	if *tiles != "" {
+		return closeWorld(format, renderTiles(renderer, *tiles, *zooms, cr, *markers))
+	}
+
+	img, err := renderer.Render(cr.X0, cr.Y0, cr.X1, cr.Y1)
+	if err != nil {
+		return closeWorld(format, err)
+	}
+
+	if *markers {
+		list, err := renderer.CollectMarkers(cr.X0, cr.Y0, cr.X1, cr.Y1)
+		if err != nil {
+			return closeWorld(format, err)
+		}
+
+		renderer.DrawMarkers(img, list, cr.X0, cr.Y0, cr.X1, cr.Y1, icons)
+	}
+
+	file, err := os.Create(*out)
+	if err != nil {
+		return closeWorld(format, err)
+	}
+
+	err = png.Encode(file, img)
+	if err != nil {
+		file.Close()
+
+		return closeWorld(format, err)
+	}
+
+	err = file.Close()
+	if err != nil {
+		return closeWorld(format, err)
+	}
+
+	fmt.Printf("wrote %s (%dx%d)\n", *out, img.Bounds().Dx(), img.Bounds().Dy())
+
+	return closeWorld(format, nil)
+}
+
+// renderRange returns the range of the flag, or the range of all generated chunks
+func renderRange(format level.Format, s string) (chunkRange, error) {
+	if s != "" {
+		return parseRange(s)
+	}
+
+	coords, err := generatedChunks(format)
+	if err != nil {
+		return chunkRange{}, err
+	}
+
+	r, ok := boundsOf(coords)
+	if !ok {
+		return chunkRange{}, errors.New("the world doesn't have chunks")
+	}
+
+	return r, nil
+}
+
+// renderTiles writes web map tiles to the directory
+func renderTiles(renderer *render.Renderer, dir string, zooms int, cr chunkRange, markers bool) error {
+	tm := render.NewTileMap(renderer, dir)
+	tm.Zooms = zooms
+
+	n, err := tm.Render(cr.X0, cr.Y0, cr.X1, cr.Y1)
+	if err != nil {
+		return err
+	}
+
+	err = tm.WriteViewer()
+	if err != nil {
+		return err
+	}
+
+	if markers {
+		list, err := renderer.CollectMarkers(cr.X0, cr.Y0, cr.X1, cr.Y1)
+		if err != nil {
+			return err
+		}
+
+		err = tm.WriteMarkers(list)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("rendered %d tiles to %s\n", n, dir)
+
+	return nil
+}
@@ -27,6 +27,20 @@ const (
 	Unknown
 )
 
+// Name returns the name of dimension
+func (dim Dimension) Name() string {
+	switch dim {
+	case OverWorld:
+		return "overworld"
+	case Nether:
+		return "nether"
+	case TheEnd:
+		return "the_end"
+	}
+
+	return "unknown"
+}
+
 // GameType is a gamemode of players
 type GameType int
 
@@ -53,10 +67,29 @@ const (
 	Spectator
 )
 
-/*
 // HeightMapType returns type of heightmap
 type HeightMapType int
 
+// Name returns the name of heightmap type used in mcje
+func (typ HeightMapType) Name() string {
+	switch typ {
+	case MotionBlocking:
+		return "MOTION_BLOCKING"
+	case MotionBlockingNoLeaves:
+		return "MOTION_BLOCKING_NO_LEAVES"
+	case OceanFloor:
+		return "OCEAN_FLOOR"
+	case OceanFloorWorldGeneration:
+		return "OCEAN_FLOOR_WG"
+	case WorldSurface:
+		return "WORLD_SURFACE"
+	case WorldSurfaceWorldGeneration:
+		return "WORLD_SURFACE_WG"
+	}
+
+	return "unknown"
+}
+
 const (
 	// MotionBlocking contains blocks block motion and a fluid
 	MotionBlocking HeightMapType = iota
@@ -67,7 +100,7 @@ const (
 	// OceanFloor contains non air and soild block
 	OceanFloor
 
-	// OceanFloorWorldGeneration contains non air and fluid block. For world generation
+	// OceanFloorWorldGeneration contains non air and soild block. For world generation
 	OceanFloorWorldGeneration
 
 	// WorldSurface contains non air block
@@ -77,6 +110,21 @@ const (
 	WorldSurfaceWorldGeneration
 )
 
-// Height returns the height of the highest block at chunk coordinate
-// If kind is not supported for a format, returns false for ok
-Height(x, y int, kind HeightMapType) (height uint16, ok bool)*/
+// HeightMapTypes is all types of heightmap
+var HeightMapTypes = []HeightMapType{
+	MotionBlocking,
+	MotionBlockingNoLeaves,
+	OceanFloor,
+	OceanFloorWorldGeneration,
+	WorldSurface,
+	WorldSurfaceWorldGeneration,
+}
+
+const (
+	// LayerBlock is a layer of normal blocks
+	LayerBlock = 0
+
+	// LayerLiquid is a layer of liquid in blocks, such as water of waterlogged blocks
+	// It's the second storage in mcbe, and waterlogged property in mcje
+	LayerLiquid = 1
+)
@@ -0,0 +1,574 @@
+package edit
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/blockentity"
+	"github.com/beito123/level/util"
+	"github.com/beito123/nbt"
+)
+
+// NewBox returns new Box with two corners
+// Both corners are included in the box
+func NewBox(x1, y1, z1, x2, y2, z2 int) Box {
+	return Box{
+		MinX: min(x1, x2),
+		MinY: min(y1, y2),
+		MinZ: min(z1, z2),
+		MaxX: max(x1, x2),
+		MaxY: max(y1, y2),
+		MaxZ: max(z1, z2),
+	}
+}
+
+// Box is a cuboid area at world coordinate
+type Box struct {
+	MinX int
+	MinY int
+	MinZ int
+	MaxX int
+	MaxY int
+	MaxZ int
+}
+
+// Size returns the size of the box
+func (box Box) Size() (width, height, length int) {
+	return box.MaxX - box.MinX + 1, box.MaxY - box.MinY + 1, box.MaxZ - box.MinZ + 1
+}
+
+// Volume returns the number of blocks in the box
+func (box Box) Volume() int {
+	w, h, l := box.Size()
+
+	return w * h * l
+}
+
+// Contains returns whether the box contains the coordinate
+func (box Box) Contains(x, y, z int) bool {
+	return x >= box.MinX && x <= box.MaxX &&
+		y >= box.MinY && y <= box.MaxY &&
+		z >= box.MinZ && z <= box.MaxZ
+}
+
+// Predicate returns whether the block is matched
+type Predicate func(state level.BlockState) bool
+
+// MatchName returns a Predicate matching blocks with the names
+func MatchName(names ...string) Predicate {
+	m := make(map[string]bool, len(names))
+	for _, name := range names {
+		m[name] = true
+	}
+
+	return func(state level.BlockState) bool {
+		return state != nil && m[state.Name()]
+	}
+}
+
+// NewEditor returns new Editor
+func NewEditor(format level.Format) *Editor {
+	return &Editor{
+		Format: format,
+		chunks: make(map[uint64]level.Chunk),
+		dirty:  make(map[uint64]level.Chunk),
+		loaded: make(map[uint64]bool),
+		mutex:  new(sync.Mutex),
+	}
+}
+
+// Editor edits blocks at world coordinate
+// Chunks are loaded automatically, and saved with Flush()
+type Editor struct {
+	Format level.Format
+
+	// Create generates chunks which don't exist yet when blocks are set
+	Create bool
+
+	chunks map[uint64]level.Chunk
+	dirty  map[uint64]level.Chunk
+	loaded map[uint64]bool // chunks loaded by the editor
+
+	mutex *sync.Mutex
+}
+
+func (Editor) at(x, y int) uint64 {
+	return (uint64(uint32(y)) << 32) | uint64(uint32(x))
+}
+
+// chunk returns a chunk at chunk coordinate
+// If the chunk doesn't exist and create is false, returns nil
+func (e *Editor) chunk(x, y int, create bool) (level.Chunk, error) {
+	index := e.at(x, y)
+
+	chunk, ok := e.chunks[index]
+	if ok {
+		return chunk, nil
+	}
+
+	if !e.Format.IsLoadedChunk(x, y) {
+		exist, err := e.Format.HasGeneratedChunk(x, y)
+		if err != nil {
+			return nil, err
+		}
+
+		if !exist && !create {
+			return nil, nil
+		}
+
+		err = e.Format.LoadChunk(x, y, create)
+		if err != nil {
+			return nil, err
+		}
+
+		e.loaded[index] = true
+	}
+
+	chunk, err := e.Format.Chunk(x, y)
+	if err != nil {
+		return nil, err
+	}
+
+	e.chunks[index] = chunk
+
+	return chunk, nil
+}
+
+// GetBlock returns a block at world coordinate
+// If the chunk doesn't exist, returns nil
+func (e *Editor) GetBlock(x, y, z int) (level.BlockState, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return e.getBlock(x, y, z, level.LayerBlock)
+}
+
+// GetBlockAtLayer returns a block at world coordinate from the layer
+// If the chunk doesn't exist, returns nil
+func (e *Editor) GetBlockAtLayer(x, y, z, layer int) (level.BlockState, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return e.getBlock(x, y, z, layer)
+}
+
+func (e *Editor) getBlock(x, y, z, layer int) (level.BlockState, error) {
+	chunk, err := e.chunk(x>>4, z>>4, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if chunk == nil {
+		return nil, nil
+	}
+
+	return chunk.GetBlockAtLayer(x&15, y, z&15, layer)
+}
+
+// SetBlock sets a block at world coordinate
+func (e *Editor) SetBlock(x, y, z int, state level.BlockState) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return e.setBlock(x, y, z, level.LayerBlock, state)
+}
+
+// SetBlockAtLayer sets a block at world coordinate to the layer
+func (e *Editor) SetBlockAtLayer(x, y, z, layer int, state level.BlockState) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return e.setBlock(x, y, z, layer, state)
+}
+
+func (e *Editor) setBlock(x, y, z, layer int, state level.BlockState) error {
+	if state == nil {
+		return fmt.Errorf("level.edit: the block state is nil")
+	}
+
+	chunk, err := e.chunk(x>>4, z>>4, e.Create)
+	if err != nil {
+		return err
+	}
+
+	if chunk == nil {
+		return fmt.Errorf("level.edit: the chunk (x: %d, y: %d) isn't generated", x>>4, z>>4)
+	}
+
+	err = chunk.SetBlockAtLayer(x&15, y, z&15, layer, state)
+	if err != nil {
+		return err
+	}
+
+	e.dirty[e.at(x>>4, z>>4)] = chunk
+
+	return nil
+}
+
+// Fill sets blocks in the box, returns the number of set blocks
+// state mustn't be nil
+func (e *Editor) Fill(box Box, state level.BlockState) (int, error) {
+	return e.Replace(box, nil, state)
+}
+
+// Replace sets blocks matched pred in the box, returns the number of set blocks
+// If pred is nil, all blocks are replaced
+func (e *Editor) Replace(box Box, pred Predicate, state level.BlockState) (int, error) {
+	if state == nil {
+		return 0, fmt.Errorf("level.edit: the block state is nil")
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	var count int
+	err := e.each(box, func(x, y, z int) error {
+		if pred != nil {
+			bs, err := e.getBlock(x, y, z, level.LayerBlock)
+			if err != nil {
+				return err
+			}
+
+			if !pred(bs) {
+				return nil
+			}
+		}
+
+		err := e.setBlock(x, y, z, level.LayerBlock, state)
+		if err != nil {
+			return err
+		}
+
+		count++
+
+		return nil
+	})
+
+	return count, err
+}
+
+// each calls fn for coordinates in the box
+// Coordinates are walked chunk by chunk
+func (e *Editor) each(box Box, fn func(x, y, z int) error) error {
+	for cz := box.MinZ >> 4; cz <= box.MaxZ>>4; cz++ {
+		for cx := box.MinX >> 4; cx <= box.MaxX>>4; cx++ {
+			for x := max(box.MinX, cx<<4); x <= min(box.MaxX, cx<<4|15); x++ {
+				for z := max(box.MinZ, cz<<4); z <= min(box.MaxZ, cz<<4|15); z++ {
+					for y := box.MinY; y <= box.MaxY; y++ {
+						err := fn(x, y, z)
+						if err != nil {
+							return err
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Copy copies blocks and block entities in the box to a clipboard
+// Blocks in chunks which don't exist are nil
+func (e *Editor) Copy(box Box) (*Clipboard, error) {
+	return e.CopyAtLayer(box, level.LayerBlock)
+}
+
+// CopyAtLayer copies blocks in the box from the layer to a clipboard
+// Block entities are copied only from the block layer
+func (e *Editor) CopyAtLayer(box Box, layer int) (*Clipboard, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	clip := NewClipboard(box.Size())
+
+	err := e.each(box, func(x, y, z int) error {
+		bs, err := e.getBlock(x, y, z, layer)
+		if err != nil {
+			return err
+		}
+
+		clip.Set(x-box.MinX, y-box.MinY, z-box.MinZ, bs)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if layer != level.LayerBlock {
+		return clip, nil
+	}
+
+	entities, err := e.blockEntities(box)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, com := range entities {
+		pos, _ := blockentity.PosOf(com)
+
+		clip.BlockEntities = append(clip.BlockEntities, util.WithPos(com, pos.X-box.MinX, pos.Y-box.MinY, pos.Z-box.MinZ))
+	}
+
+	return clip, nil
+}
+
+// Paste sets blocks and block entities in the clipboard at world coordinate x, y, z as the minimum corner
+// nil blocks in the clipboard are skipped, returns the number of set blocks
+func (e *Editor) Paste(clip *Clipboard, x, y, z int) (int, error) {
+	return e.PasteAtLayer(clip, x, y, z, level.LayerBlock)
+}
+
+// PasteAtLayer sets blocks in the clipboard to the layer
+// Block entities are set only to the block layer
+func (e *Editor) PasteAtLayer(clip *Clipboard, x, y, z, layer int) (int, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	box := NewBox(x, y, z, x+clip.Width-1, y+clip.Height-1, z+clip.Length-1)
+
+	var count int
+	err := e.each(box, func(bx, by, bz int) error {
+		bs := clip.Get(bx-x, by-y, bz-z)
+		if bs == nil {
+			return nil
+		}
+
+		err := e.setBlock(bx, by, bz, layer, bs)
+		if err != nil {
+			return err
+		}
+
+		count++
+
+		return nil
+	})
+	if err != nil || layer != level.LayerBlock {
+		return count, err
+	}
+
+	for _, com := range clip.BlockEntities {
+		pos, ok := blockentity.PosOf(com)
+		if !ok || !clip.Vaild(pos.X, pos.Y, pos.Z) {
+			continue
+		}
+
+		err := e.setBlockEntity(util.WithPos(com, x+pos.X, y+pos.Y, z+pos.Z))
+		if err != nil {
+			return count, err
+		}
+	}
+
+	return count, nil
+}
+
+// BlockEntities returns block entities in the box
+// Coordinates of block entities are world coordinate
+func (e *Editor) BlockEntities(box Box) ([]*nbt.Compound, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return e.blockEntities(box)
+}
+
+func (e *Editor) blockEntities(box Box) ([]*nbt.Compound, error) {
+	var result []*nbt.Compound
+	for cz := box.MinZ >> 4; cz <= box.MaxZ>>4; cz++ {
+		for cx := box.MinX >> 4; cx <= box.MaxX>>4; cx++ {
+			chunk, err := e.chunk(cx, cz, false)
+			if err != nil {
+				return nil, err
+			}
+
+			if chunk == nil {
+				continue
+			}
+
+			for _, com := range chunk.BlockEntities() {
+				pos, ok := blockentity.PosOf(com)
+				if ok && box.Contains(pos.X, pos.Y, pos.Z) {
+					result = append(result, com)
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// BlockEntity returns a block entity at world coordinate
+func (e *Editor) BlockEntity(x, y, z int) (*nbt.Compound, bool, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	chunk, err := e.chunk(x>>4, z>>4, false)
+	if err != nil || chunk == nil {
+		return nil, false, err
+	}
+
+	com, ok := chunk.BlockEntity(x&15, y, z&15)
+
+	return com, ok, nil
+}
+
+// SetBlockEntity sets a block entity at the coordinate of com
+// A block entity at the same coordinate is replaced
+func (e *Editor) SetBlockEntity(com *nbt.Compound) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return e.setBlockEntity(com)
+}
+
+func (e *Editor) setBlockEntity(com *nbt.Compound) error {
+	pos, ok := blockentity.PosOf(com)
+	if !ok {
+		return fmt.Errorf("level.edit: the block entity doesn't have a coordinate")
+	}
+
+	x, z := pos.X, pos.Z
+
+	chunk, err := e.chunk(x>>4, z>>4, e.Create)
+	if err != nil {
+		return err
+	}
+
+	if chunk == nil {
+		return fmt.Errorf("level.edit: the chunk (x: %d, y: %d) isn't generated", x>>4, z>>4)
+	}
+
+	err = chunk.SetBlockEntity(com)
+	if err != nil {
+		return err
+	}
+
+	e.dirty[e.at(x>>4, z>>4)] = chunk
+
+	return nil
+}
+
+// RemoveBlockEntity removes a block entity at world coordinate
+// If the block entity isn't found, returns false
+func (e *Editor) RemoveBlockEntity(x, y, z int) (bool, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	chunk, err := e.chunk(x>>4, z>>4, false)
+	if err != nil || chunk == nil {
+		return false, err
+	}
+
+	if !chunk.RemoveBlockEntity(x&15, y, z&15) {
+		return false, nil
+	}
+
+	e.dirty[e.at(x>>4, z>>4)] = chunk
+
+	return true, nil
+}
+
+// Flush saves changed chunks and unloads chunks which are loaded by the editor
+func (e *Editor) Flush() error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	for index, chunk := range e.dirty {
+		err := e.Format.SaveChunk(chunk.X(), chunk.Y())
+		if err != nil {
+			return err
+		}
+
+		delete(e.dirty, index)
+	}
+
+	for index, chunk := range e.chunks {
+		if e.loaded[index] {
+			err := e.Format.UnloadChunk(chunk.X(), chunk.Y())
+			if err != nil {
+				return err
+			}
+		}
+
+		delete(e.chunks, index)
+		delete(e.loaded, index)
+	}
+
+	return nil
+}
+
+// NewClipboard returns new Clipboard with the size
+func NewClipboard(width, height, length int) *Clipboard {
+	return &Clipboard{
+		Width:  width,
+		Height: height,
+		Length: length,
+		Blocks: make([]level.BlockState, width*height*length),
+	}
+}
+
+// Clipboard is copied blocks and block entities
+type Clipboard struct {
+	Width  int
+	Height int
+	Length int
+
+	Blocks []level.BlockState
+
+	// BlockEntities is block entities which have coordinates (x, y, z) relative to the clipboard
+	BlockEntities []*nbt.Compound
+}
+
+// At returns a index for Blocks
+func (clip *Clipboard) At(x, y, z int) int {
+	return (y*clip.Length+z)*clip.Width + x
+}
+
+// Vaild returns whether the coordinate is in the clipboard
+func (clip *Clipboard) Vaild(x, y, z int) bool {
+	return x >= 0 && x < clip.Width && y >= 0 && y < clip.Height && z >= 0 && z < clip.Length
+}
+
+// Get returns a block at the clipboard coordinate
+func (clip *Clipboard) Get(x, y, z int) level.BlockState {
+	if !clip.Vaild(x, y, z) {
+		return nil
+	}
+
+	return clip.Blocks[clip.At(x, y, z)]
+}
+
+// Set sets a block at the clipboard coordinate
+func (clip *Clipboard) Set(x, y, z int, state level.BlockState) {
+	if !clip.Vaild(x, y, z) {
+		return
+	}
+
+	clip.Blocks[clip.At(x, y, z)] = state
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,189 @@
+package edit
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/entity"
+	"github.com/beito123/nbt"
+)
+
+// EntityFilter returns whether the entity is matched
+type EntityFilter func(en *entity.Entity) bool
+
+// MatchEntityType returns a EntityFilter matching entities with the identifiers
+func MatchEntityType(ids ...string) EntityFilter {
+	m := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		m[id] = true
+	}
+
+	return func(en *entity.Entity) bool {
+		return m[en.Identifier()]
+	}
+}
+
+// Entities returns entities in the box matched filter
+// If filter is nil, all entities in the box are returned
+func (e *Editor) Entities(box Box, filter EntityFilter) ([]*entity.Entity, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	var result []*entity.Entity
+	for cz := box.MinZ >> 4; cz <= box.MaxZ>>4; cz++ {
+		for cx := box.MinX >> 4; cx <= box.MaxX>>4; cx++ {
+			chunk, err := e.chunk(cx, cz, false)
+			if err != nil {
+				return nil, err
+			}
+
+			if chunk == nil {
+				continue
+			}
+
+			for _, com := range chunk.Entities() {
+				en := entity.Wrap(com)
+
+				x, y, z, ok := en.BlockPos()
+				if !ok || !box.Contains(x, y, z) {
+					continue
+				}
+
+				if filter == nil || filter(en) {
+					result = append(result, en)
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// AddEntity adds the entity to the chunk at the position of the entity
+func (e *Editor) AddEntity(en *entity.Entity) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	cx, cy, ok := en.ChunkPos()
+	if !ok {
+		return fmt.Errorf("level.edit: the entity doesn't have a position")
+	}
+
+	chunk, err := e.chunk(cx, cy, e.Create)
+	if err != nil {
+		return err
+	}
+
+	if chunk == nil {
+		return fmt.Errorf("level.edit: the chunk (x: %d, y: %d) isn't generated", cx, cy)
+	}
+
+	chunk.SetEntities(append(chunk.Entities(), en.Compound))
+
+	e.dirty[e.at(cx, cy)] = chunk
+
+	return nil
+}
+
+// RemoveEntity removes the entity from the chunk at the position of the entity
+// If the entity isn't found, returns false
+func (e *Editor) RemoveEntity(en *entity.Entity) (bool, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	cx, cy, ok := en.ChunkPos()
+	if !ok {
+		return false, nil
+	}
+
+	chunk, err := e.chunk(cx, cy, false)
+	if err != nil || chunk == nil {
+		return false, err
+	}
+
+	return e.removeEntity(chunk, en), nil
+}
+
+func (e *Editor) removeEntity(chunk level.Chunk, en *entity.Entity) bool {
+	var entities []*nbt.Compound
+	var found bool
+	for _, com := range chunk.Entities() {
+		if !found && en.SameEntity(entity.Wrap(com)) {
+			found = true
+
+			continue
+		}
+
+		entities = append(entities, com)
+	}
+
+	if found {
+		chunk.SetEntities(entities)
+
+		e.dirty[e.at(chunk.X(), chunk.Y())] = chunk
+	}
+
+	return found
+}
+
+// MoveEntity moves the entity to the position
+// If the chunk at the position is changed, the entity is moved to the chunk
+func (e *Editor) MoveEntity(en *entity.Entity, x, y, z float64) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	from, err := e.entityChunk(en, false)
+	if err != nil {
+		return err
+	}
+
+	// Resolve the chunk before moving, so the entity isn't changed on errors
+	cx, cy := int(math.Floor(x))>>4, int(math.Floor(z))>>4
+
+	to, err := e.chunk(cx, cy, e.Create)
+	if err != nil {
+		return err
+	}
+
+	if to == nil {
+		return fmt.Errorf("level.edit: the chunk (x: %d, y: %d) isn't generated", cx, cy)
+	}
+
+	en.SetPos(x, y, z)
+
+	if from != nil && from.X() == to.X() && from.Y() == to.Y() {
+		e.dirty[e.at(to.X(), to.Y())] = to
+
+		return nil
+	}
+
+	if from != nil {
+		e.removeEntity(from, en)
+	}
+
+	to.SetEntities(append(to.Entities(), en.Compound))
+
+	e.dirty[e.at(to.X(), to.Y())] = to
+
+	return nil
+}
+
+// entityChunk returns a chunk at the position of the entity
+func (e *Editor) entityChunk(en *entity.Entity, create bool) (level.Chunk, error) {
+	cx, cy, ok := en.ChunkPos()
+	if !ok {
+		return nil, nil
+	}
+
+	return e.chunk(cx, cy, create)
+}
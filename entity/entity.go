@@ -0,0 +1,304 @@
+package entity
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"math"
+
+	"github.com/beito123/level/asset"
+	"github.com/beito123/nbt"
+)
+
+// EditionOf returns the edition of entity nbt data
+// Entities for mcbe have identifier, and entities for mcje have id
+func EditionOf(com *nbt.Compound) asset.Edition {
+	if com.Has("identifier") {
+		return asset.BedrockEdition
+	}
+
+	return asset.JavaEdition
+}
+
+// Wrap returns a view of entity nbt data
+func Wrap(com *nbt.Compound) *Entity {
+	return &Entity{
+		Compound: com,
+		Edition:  EditionOf(com),
+	}
+}
+
+// New returns new entity with a random unique id at the position
+func New(edition asset.Edition, identifier string, x, y, z float64) *Entity {
+	en := &Entity{
+		Compound: nbt.NewCompoundTag("", make(map[string]nbt.Tag)),
+		Edition:  edition,
+	}
+
+	en.SetIdentifier(identifier)
+	en.SetPos(x, y, z)
+	en.SetMotion(0, 0, 0)
+	en.SetRotation(0, 0)
+
+	var uuid [16]byte
+	rand.Read(uuid[:])
+
+	if edition == asset.BedrockEdition {
+		en.SetUniqueID(int64(binary.BigEndian.Uint64(uuid[:8])))
+	} else {
+		uuid[6] = (uuid[6] & 0x0f) | 0x40 // version 4
+		uuid[8] = (uuid[8] & 0x3f) | 0x80 // variant
+
+		en.SetUUID(uuid)
+	}
+
+	return en
+}
+
+// Entity is a view of entity nbt data
+// Values are read and written to Compound directly
+type Entity struct {
+	*nbt.Compound
+
+	Edition asset.Edition
+}
+
+// Identifier returns the type of the entity such as minecraft:zombie
+func (en *Entity) Identifier() string {
+	name := "id"
+	if en.Edition == asset.BedrockEdition {
+		name = "identifier"
+	}
+
+	id, err := en.GetString(name)
+	if err != nil {
+		return ""
+	}
+
+	return id
+}
+
+// SetIdentifier sets the type of the entity
+func (en *Entity) SetIdentifier(id string) {
+	name := "id"
+	if en.Edition == asset.BedrockEdition {
+		name = "identifier"
+	}
+
+	en.Set(nbt.NewStringTag(name, id))
+}
+
+// UUID returns the uuid of the entity for mcje
+// UUIDMost and UUIDLeast, and UUID (mcje v1.16 and after) are supported
+func (en *Entity) UUID() (uuid [16]byte, ok bool) {
+	if en.Has("UUID") {
+		ints, err := en.GetIntArray("UUID")
+		if err != nil || len(ints) != 4 {
+			return uuid, false
+		}
+
+		for i, v := range ints {
+			binary.BigEndian.PutUint32(uuid[i*4:], uint32(v))
+		}
+
+		return uuid, true
+	}
+
+	most, err := en.GetLong("UUIDMost")
+	if err != nil {
+		return uuid, false
+	}
+
+	least, err := en.GetLong("UUIDLeast")
+	if err != nil {
+		return uuid, false
+	}
+
+	binary.BigEndian.PutUint64(uuid[:8], uint64(most))
+	binary.BigEndian.PutUint64(uuid[8:], uint64(least))
+
+	return uuid, true
+}
+
+// SetUUID sets the uuid of the entity for mcje
+// It's written as the same form as the entity has
+func (en *Entity) SetUUID(uuid [16]byte) {
+	if en.Has("UUID") {
+		ints := make([]int32, 4)
+		for i := range ints {
+			ints[i] = int32(binary.BigEndian.Uint32(uuid[i*4:]))
+		}
+
+		en.Set(nbt.NewIntArrayTag("UUID", ints))
+
+		return
+	}
+
+	en.Set(nbt.NewLongTag("UUIDMost", int64(binary.BigEndian.Uint64(uuid[:8]))))
+	en.Set(nbt.NewLongTag("UUIDLeast", int64(binary.BigEndian.Uint64(uuid[8:]))))
+}
+
+// UniqueID returns the unique id of the entity for mcbe
+func (en *Entity) UniqueID() (id int64, ok bool) {
+	id, err := en.GetLong("UniqueID")
+	if err != nil {
+		return 0, false
+	}
+
+	return id, true
+}
+
+// SetUniqueID sets the unique id of the entity for mcbe
+func (en *Entity) SetUniqueID(id int64) {
+	en.Set(nbt.NewLongTag("UniqueID", id))
+}
+
+// SameEntity returns whether other is the same entity as en by the uuid or the unique id
+func (en *Entity) SameEntity(other *Entity) bool {
+	if en.Compound == other.Compound {
+		return true
+	}
+
+	if id, ok := en.UniqueID(); ok {
+		oid, ok := other.UniqueID()
+
+		return ok && id == oid
+	}
+
+	if uuid, ok := en.UUID(); ok {
+		ouuid, ok := other.UUID()
+
+		return ok && uuid == ouuid
+	}
+
+	return false
+}
+
+// Pos returns the position of the entity
+func (en *Entity) Pos() (x, y, z float64, ok bool) {
+	values, ok := en.floats("Pos", 3)
+	if !ok {
+		return 0, 0, 0, false
+	}
+
+	return values[0], values[1], values[2], true
+}
+
+// SetPos sets the position of the entity
+func (en *Entity) SetPos(x, y, z float64) {
+	en.setFloats("Pos", x, y, z)
+}
+
+// BlockPos returns the block coordinate at the position of the entity
+func (en *Entity) BlockPos() (x, y, z int, ok bool) {
+	fx, fy, fz, ok := en.Pos()
+	if !ok {
+		return 0, 0, 0, false
+	}
+
+	return int(math.Floor(fx)), int(math.Floor(fy)), int(math.Floor(fz)), true
+}
+
+// ChunkPos returns the chunk coordinate at the position of the entity
+func (en *Entity) ChunkPos() (x, y int, ok bool) {
+	bx, _, bz, ok := en.BlockPos()
+	if !ok {
+		return 0, 0, false
+	}
+
+	return bx >> 4, bz >> 4, true
+}
+
+// Rotation returns the rotation of the entity
+func (en *Entity) Rotation() (yaw, pitch float32, ok bool) {
+	values, ok := en.floats("Rotation", 2)
+	if !ok {
+		return 0, 0, false
+	}
+
+	return float32(values[0]), float32(values[1]), true
+}
+
+// SetRotation sets the rotation of the entity
+func (en *Entity) SetRotation(yaw, pitch float32) {
+	en.Set(nbt.NewListTag("Rotation", []nbt.Tag{
+		nbt.NewFloatTag("", yaw),
+		nbt.NewFloatTag("", pitch),
+	}, nbt.IDTagFloat))
+}
+
+// Motion returns the velocity of the entity
+func (en *Entity) Motion() (x, y, z float64, ok bool) {
+	values, ok := en.floats("Motion", 3)
+	if !ok {
+		return 0, 0, 0, false
+	}
+
+	return values[0], values[1], values[2], true
+}
+
+// SetMotion sets the velocity of the entity
+func (en *Entity) SetMotion(x, y, z float64) {
+	en.setFloats("Motion", x, y, z)
+}
+
+// CustomName returns the custom name
+// It's a json text for mcje v1.13 and after
+func (en *Entity) CustomName() string {
+	name, err := en.GetString("CustomName")
+	if err != nil {
+		return ""
+	}
+
+	return name
+}
+
+// SetCustomName sets the custom name
+func (en *Entity) SetCustomName(name string) {
+	en.Set(nbt.NewStringTag("CustomName", name))
+}
+
+// floats reads a list of floats or doubles
+func (en *Entity) floats(name string, count int) ([]float64, bool) {
+	list, err := en.GetList(name)
+	if err != nil || len(list) < count {
+		return nil, false
+	}
+
+	values := make([]float64, len(list))
+	for i, tag := range list {
+		values[i], err = tag.ToFloat64()
+		if err != nil {
+			return nil, false
+		}
+	}
+
+	return values, true
+}
+
+// setFloats writes a list of doubles for mcje, or floats for mcbe
+func (en *Entity) setFloats(name string, values ...float64) {
+	list := make([]nbt.Tag, len(values))
+	for i, v := range values {
+		if en.Edition == asset.BedrockEdition {
+			list[i] = nbt.NewFloatTag("", float32(v))
+		} else {
+			list[i] = nbt.NewDoubleTag("", v)
+		}
+	}
+
+	var typ byte = nbt.IDTagDouble
+	if en.Edition == asset.BedrockEdition {
+		typ = nbt.IDTagFloat
+	}
+
+	en.Set(nbt.NewListTag(name, list, typ))
+}
@@ -0,0 +1,193 @@
+package heightmap
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"strings"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/asset"
+	"github.com/beito123/level/block"
+)
+
+const (
+	// Height is the height of a chunk
+	Height = 256
+
+	// Size is the number of entries in a heightmap
+	Size = 16 * 16
+)
+
+// Chunk is a chunk which heightmaps are computed from
+type Chunk interface {
+	// GetBlock gets a BlockState at chunk coordinate
+	GetBlock(x, y, z int) (level.BlockState, error)
+}
+
+// Editioner is a chunk which has a edition
+// Names of blocks are looked up in block lists of the edition
+type Editioner interface {
+	Edition() asset.Edition
+}
+
+// EditionOf returns the edition of the chunk
+// If the chunk doesn't implement Editioner, returns JavaEdition
+func EditionOf(chunk Chunk) asset.Edition {
+	if e, ok := chunk.(Editioner); ok {
+		return e.Edition()
+	}
+
+	return asset.JavaEdition
+}
+
+// At returns a index for a heightmap at chunk coordinate
+func At(x, y int) int {
+	return y*16 + x
+}
+
+// IsAir returns whether the block is air
+func IsAir(state level.BlockState) bool {
+	if state == nil {
+		return true
+	}
+
+	switch state.Name() {
+	case "minecraft:air", "minecraft:cave_air", "minecraft:void_air":
+		return true
+	}
+
+	return false
+}
+
+// IsFluid returns whether the block is a fluid or contains a fluid
+func IsFluid(state level.BlockState) bool {
+	if state == nil {
+		return false
+	}
+
+	switch state.Name() {
+	case "minecraft:water", "minecraft:flowing_water",
+		"minecraft:lava", "minecraft:flowing_lava",
+		"minecraft:bubble_column":
+		return true
+	}
+
+	_, properties, ok := state.ToBlockNameProperties()
+
+	return ok && properties["waterlogged"] == "true"
+}
+
+// IsLeaves returns whether the block is leaves
+func IsLeaves(state level.BlockState) bool {
+	if state == nil {
+		return false
+	}
+
+	name := state.Name()
+
+	return strings.HasSuffix(name, "leaves") || strings.HasSuffix(name, "leaves2")
+}
+
+// BlocksMotion returns whether the block has a collision box
+// The block is looked up by the name of the edition, unknown blocks are treated as solid blocks
+func BlocksMotion(edition asset.Edition, state level.BlockState) bool {
+	if IsAir(state) {
+		return false
+	}
+
+	bl, ok := block.LookupEdition(edition, state.Name())
+	if !ok {
+		return true
+	}
+
+	return bl.IsSolid()
+}
+
+// Matches returns whether the block of the edition is counted for a kind of heightmap
+func Matches(edition asset.Edition, state level.BlockState, kind level.HeightMapType) bool {
+	switch kind {
+	case level.MotionBlocking:
+		return BlocksMotion(edition, state) || IsFluid(state)
+	case level.MotionBlockingNoLeaves:
+		return (BlocksMotion(edition, state) || IsFluid(state)) && !IsLeaves(state)
+	case level.OceanFloor, level.OceanFloorWorldGeneration:
+		return BlocksMotion(edition, state)
+	case level.WorldSurface, level.WorldSurfaceWorldGeneration:
+		return !IsAir(state)
+	}
+
+	return false
+}
+
+// Column returns the height of a column at chunk coordinate x, y
+// It scans blocks downward from top
+// The height is y+1 of the highest block which is matched kind, or 0 if there is no block
+func Column(chunk Chunk, x, y, top int, kind level.HeightMapType) (uint16, error) {
+	edition := EditionOf(chunk)
+
+	for i := top; i >= 0; i-- {
+		state, err := chunk.GetBlock(x, i, y)
+		if err != nil {
+			return 0, err
+		}
+
+		if Matches(edition, state, kind) {
+			return uint16(i + 1), nil
+		}
+	}
+
+	return 0, nil
+}
+
+// Compute computes a heightmap of a chunk
+func Compute(chunk Chunk, kind level.HeightMapType) ([]uint16, error) {
+	heights := make([]uint16, Size)
+
+	for y := 0; y < 16; y++ {
+		for x := 0; x < 16; x++ {
+			h, err := Column(chunk, x, y, Height-1, kind)
+			if err != nil {
+				return nil, err
+			}
+
+			heights[At(x, y)] = h
+		}
+	}
+
+	return heights, nil
+}
+
+// Update updates a heightmap after a block is set at chunk coordinate x, y, z
+// The block needs to be set to the chunk in advance
+func Update(chunk Chunk, heights []uint16, x, y, z int, state level.BlockState, kind level.HeightMapType) error {
+	index := At(x, z)
+	current := int(heights[index])
+
+	if Matches(EditionOf(chunk), state, kind) {
+		if y+1 > current {
+			heights[index] = uint16(y + 1)
+		}
+
+		return nil
+	}
+
+	if y+1 != current { // the highest block isn't changed
+		return nil
+	}
+
+	h, err := Column(chunk, x, z, y-1, kind)
+	if err != nil {
+		return err
+	}
+
+	heights[index] = h
+
+	return nil
+}
@@ -13,10 +13,6 @@ import (
 	"github.com/beito123/nbt"
 )
 
-// Level is a simple level loader
-type Level struct {
-}
-
 // Format is a simple interface for level formats
 // This needs to be supported concurrency
 type Format interface {
@@ -92,6 +88,25 @@ type Format interface {
 	LoadedChunks() []Chunk
 }
 
+// ChunkCoord is a chunk coordinate
+type ChunkCoord struct {
+	X int
+	Y int
+}
+
+// ChunkLister is a level format which can list generated chunks
+type ChunkLister interface {
+	// GeneratedChunks returns coordinates of all generated chunks in the dimension
+	GeneratedChunks() ([]ChunkCoord, error)
+}
+
+// ChunkDeleter is a level format which can delete chunks
+type ChunkDeleter interface {
+	// DeleteChunk deletes the chunk from the storage, and unloads it
+	// If the chunk isn't generated, it does nothing
+	DeleteChunk(x, y int) error
+}
+
 // Chunk is a simple interface for chunk
 type Chunk interface {
 
@@ -108,7 +123,8 @@ type Chunk interface {
 	SetY(y int)
 
 	// Height returns the height of the highest block at chunk coordinate
-	Height(x, y int) (height uint16)
+	// If kind is not supported for a format, returns false for ok
+	Height(x, y int, kind HeightMapType) (height uint16, ok bool)
 
 	// Biome returns biome
 	Biome(x, y int) byte
@@ -128,11 +144,56 @@ type Chunk interface {
 	// SetBlockEntities set block entities of nbt data
 	SetBlockEntities(entities []*nbt.Compound)
 
+	// BlockEntity returns a block entity at chunk coordinate
+	BlockEntity(x, y, z int) (*nbt.Compound, bool)
+
+	// SetBlockEntity sets a block entity at the coordinate (x, y, z) of nbt data
+	// A block entity at the same coordinate is replaced
+	SetBlockEntity(com *nbt.Compound) error
+
+	// RemoveBlockEntity removes a block entity at chunk coordinate
+	// If the block entity isn't found, returns false
+	RemoveBlockEntity(x, y, z int) bool
+
 	// GetBlock gets a BlockState at chunk coordinate
 	GetBlock(x, y, z int) (BlockState, error)
 
 	// SetBlock set a BlockState at chunk coordinate
+	// If the block is changed, a block entity at the coordinate is removed
 	SetBlock(x, y, z int, state BlockState) error
+
+	// GetBlockAtLayer gets a BlockState at chunk coordinate from the layer
+	// See LayerBlock and LayerLiquid
+	GetBlockAtLayer(x, y, z, layer int) (BlockState, error)
+
+	// SetBlockAtLayer set a BlockState at chunk coordinate to the layer
+	SetBlockAtLayer(x, y, z, layer int, state BlockState) error
+
+	// ScheduledTicks returns scheduled block updates in the chunk
+	ScheduledTicks() []ScheduledTick
+
+	// SetScheduledTicks sets scheduled block updates in the chunk
+	SetScheduledTicks(ticks []ScheduledTick)
+}
+
+// ScheduledTick is a scheduled block update such as redstone and liquids
+type ScheduledTick struct {
+	// X, Y and Z is world coordinate of the block
+	X int
+	Y int
+	Z int
+
+	// Block is the block which is updated
+	Block BlockState
+
+	// Delay is ticks until the update
+	Delay int
+
+	// Priority is a priority of the update (mcje)
+	Priority int
+
+	// Liquid is whether the update is for liquids (LiquidTicks in mcje)
+	Liquid bool
 }
 
 // BlockState is a block information
@@ -0,0 +1,229 @@
+package leveldb
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+
+	lvldb "github.com/beito123/goleveldb/leveldb"
+	"github.com/beito123/level"
+	"github.com/beito123/level/entity"
+	"github.com/beito123/nbt"
+)
+
+const (
+	// ActorPrefix is a key prefix of actors (entities), a storage key follows it
+	ActorPrefix = "actorprefix"
+
+	// DigestPrefix is a key prefix of storage keys of actors in a chunk, a chunk key follows it
+	DigestPrefix = "digp"
+
+	// ActorStorageKeySize is a size of storage keys of actors
+	ActorStorageKeySize = 8
+
+	// ActorStorageChunkVersion is a chunk version storing entities as actors (mcbe v1.18.30)
+	ActorStorageChunkVersion = 40
+)
+
+// ActorStorageKey returns a storage key of the actor
+// If the actor doesn't have it, a key is made from UniqueID and set to the actor
+func ActorStorageKey(com *nbt.Compound) ([]byte, error) {
+	internal, err := com.GetCompound("internalComponents")
+	if err == nil {
+		component, err := internal.GetCompound("EntityStorageKeyComponent")
+		if err == nil {
+			key, err := component.GetString("StorageKey")
+			if err == nil && len(key) == ActorStorageKeySize {
+				return []byte(key), nil
+			}
+		}
+	}
+
+	en := entity.Wrap(com)
+
+	id, ok := en.UniqueID()
+	if !ok {
+		b := make([]byte, 8)
+
+		_, err := rand.Read(b)
+		if err != nil {
+			return nil, err
+		}
+
+		id = int64(binary.BigEndian.Uint64(b))
+
+		en.SetUniqueID(id)
+	}
+
+	key := make([]byte, ActorStorageKeySize)
+	binary.BigEndian.PutUint64(key, uint64(id))
+
+	if internal == nil {
+		internal = nbt.NewCompoundTag("internalComponents", make(map[string]nbt.Tag))
+		com.Set(internal)
+	}
+
+	internal.Set(nbt.NewCompoundTag("EntityStorageKeyComponent", map[string]nbt.Tag{
+		"StorageKey": nbt.NewStringTag("StorageKey", string(key)),
+	}))
+
+	return key, nil
+}
+
+// getActorKey returns a key of the actor with storage key
+func (format *ChunkFormatV100) getActorKey(storageKey []byte) []byte {
+	return append([]byte(ActorPrefix), storageKey...)
+}
+
+// getDigestKey returns a key of storage keys of actors in the chunk
+func (format *ChunkFormatV100) getDigestKey(x, y int, dimension level.Dimension) []byte {
+	key := format.getChunkKey(x, y, dimension, 0, -1)
+
+	return append([]byte(DigestPrefix), key[:len(key)-1]...) // without tag
+}
+
+// readDigest reads storage keys of actors in the chunk
+// If the chunk doesn't have actors, returns false for ok
+func (format *ChunkFormatV100) readDigest(db *lvldb.DB, x, y int, dimension level.Dimension) (keys [][]byte, ok bool, err error) {
+	digestKey := format.getDigestKey(x, y, dimension)
+
+	ok, err = db.Has(digestKey, nil)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	b, err := db.Get(digestKey, nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if len(b)%ActorStorageKeySize != 0 {
+		return nil, false, fmt.Errorf("level.leveldb: invaild actor digest")
+	}
+
+	for i := 0; i < len(b); i += ActorStorageKeySize {
+		keys = append(keys, b[i:i+ActorStorageKeySize])
+	}
+
+	return keys, true, nil
+}
+
+// ReadActors reads actors in the chunk
+// If the chunk doesn't have actors, returns false for ok
+func (format *ChunkFormatV100) ReadActors(db *lvldb.DB, x, y int, dimension level.Dimension) (actors []*nbt.Compound, ok bool, err error) {
+	keys, ok, err := format.readDigest(db, x, y, dimension)
+	if err != nil || !ok {
+		return nil, ok, err
+	}
+
+	for _, key := range keys {
+		b, err := db.Get(format.getActorKey(key), nil)
+		if err == lvldb.ErrNotFound { // broken digest
+			continue
+		}
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		list, err := format.ReadCompounds(b)
+		if err != nil {
+			return nil, false, err
+		}
+
+		actors = append(actors, list...)
+	}
+
+	return actors, true, nil
+}
+
+// WriteActors writes entities of the chunk as actors
+// Entities stored with the old entity key are removed, and actors which aren't in the chunk are deleted
+func (format *ChunkFormatV100) WriteActors(db *lvldb.DB, chunk *Chunk, dimension level.Dimension) error {
+	oldKeys, _, err := format.readDigest(db, chunk.x, chunk.y, dimension)
+	if err != nil {
+		return err
+	}
+
+	written := make(map[string]bool, len(chunk.entities))
+
+	var digest []byte
+	for _, com := range chunk.entities {
+		key, err := ActorStorageKey(com)
+		if err != nil {
+			return err
+		}
+
+		b, err := format.WriteCompounds([]*nbt.Compound{com})
+		if err != nil {
+			return err
+		}
+
+		err = db.Put(format.getActorKey(key), b, nil)
+		if err != nil {
+			return err
+		}
+
+		digest = append(digest, key...)
+		written[string(key)] = true
+	}
+
+	for _, key := range oldKeys {
+		if written[string(key)] {
+			continue
+		}
+
+		err := format.deleteActor(db, chunk, key)
+		if err != nil {
+			return err
+		}
+	}
+
+	digestKey := format.getDigestKey(chunk.x, chunk.y, dimension)
+	if len(digest) == 0 {
+		err = db.Delete(digestKey, nil)
+	} else {
+		err = db.Put(digestKey, digest, nil)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// Migrate entities from the old format
+	return db.Delete(format.getChunkKey(chunk.x, chunk.y, dimension, TagEntity, -1), nil)
+}
+
+// deleteActor deletes a actor which is removed from the chunk
+// If the actor is moved to other chunk and saved already, it isn't deleted
+func (format *ChunkFormatV100) deleteActor(db *lvldb.DB, chunk *Chunk, key []byte) error {
+	actorKey := format.getActorKey(key)
+
+	b, err := db.Get(actorKey, nil)
+	if err == lvldb.ErrNotFound {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	list, err := format.ReadCompounds(b)
+	if err == nil && len(list) > 0 {
+		x, y, ok := entity.Wrap(list[0]).ChunkPos()
+		if ok && (x != chunk.x || y != chunk.y) {
+			return nil
+		}
+	}
+
+	return db.Delete(actorKey, nil)
+}
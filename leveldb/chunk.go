@@ -10,7 +10,9 @@ package leveldb
 */
 
 import (
+	"bytes"
 	"fmt"
+	"hash/fnv"
 	"io/ioutil"
 	"os"
 
@@ -20,21 +22,59 @@ import (
 	lvldb "github.com/beito123/goleveldb/leveldb"
 	"github.com/beito123/goleveldb/leveldb/util"
 	"github.com/beito123/level"
+	"github.com/beito123/level/asset"
+	"github.com/beito123/level/blockentity"
+	"github.com/beito123/level/heightmap"
+	lvlutil "github.com/beito123/level/util"
 )
 
 // DefaultStorageIndex is the default index for StorageIndex
 const DefaultStorageIndex = 0
 
+// Data2DHeightMap is a type of heightmap stored in Data2D
+const Data2DHeightMap = level.MotionBlocking
+
+// DefaultChunkVersion is a chunk version written for new chunks (mcbe v1.14)
+const DefaultChunkVersion = 15
+
+// BlockStateVersionV114 is a version of block states for mcbe v1.14
+const BlockStateVersionV114 = 17760256
+
+// NewAirBlockState returns new air block for mcbe v1.13 or after
+func NewAirBlockState() *RawBlockState {
+	return NewRawBlockStateWithStates("minecraft:air", nil, BlockStateVersionV114)
+}
+
+// NewWaterBlockState returns new water source block
+// If version is 0, returns a block with value
+func NewWaterBlockState(version int) *RawBlockState {
+	if version == 0 {
+		return NewRawBlockState("minecraft:water", 0)
+	}
+
+	states := nbt.NewCompoundTag("states", map[string]nbt.Tag{
+		"liquid_depth": nbt.NewIntTag("liquid_depth", 0),
+	})
+
+	return NewRawBlockStateWithStates("minecraft:water", states, version)
+}
+
 // NewChunk returns new Chunk
 func NewChunk(x, y int) *Chunk {
 	return &Chunk{
-		x:                   x,
-		y:                   y,
-		heightMap:           make([]uint16, 256),
+		x: x,
+		y: y,
+		heightMaps: map[level.HeightMapType][]uint16{
+			Data2DHeightMap: make([]uint16, heightmap.Size),
+		},
 		biomes:              make([]byte, 256),
 		subChunks:           make([]*SubChunk, 16),
+		Version:             DefaultChunkVersion,
+		VersionTag:          TagLegacyVersion,
 		Finalization:        NotGenerated,
+		DefaultBlock:        NewAirBlockState(),
 		DefaultStorageIndex: DefaultStorageIndex,
+		blockEntities:       blockentity.NewIndex(nil),
 	}
 }
 
@@ -89,15 +129,31 @@ type Chunk struct {
 	x             int
 	y             int
 	subChunks     []*SubChunk
-	heightMap     []uint16
+	heightMaps    map[level.HeightMapType][]uint16
 	biomes        []byte
 	entities      []*nbt.Compound
-	blockEntities []*nbt.Compound
+	blockEntities *blockentity.Index
+	ticks         []level.ScheduledTick
+	extraBlocks   map[int]ExtraBlock
 
+	Version      byte
 	Finalization Finalization
 
+	// VersionTag is a tag which the version is saved with (TagVersion or TagLegacyVersion)
+	VersionTag byte
+
+	// DefaultBlock is a block filled in empty subchunks
 	DefaultBlock        *RawBlockState
 	DefaultStorageIndex int
+
+	// ActorStorage is whether entities are saved as actors (mcbe v1.18.30 or after)
+	ActorStorage bool
+
+	// RandomTicks is random block updates which are saved
+	RandomTicks []level.ScheduledTick
+
+	// CurrentTick is a tick which Delay of ticks is relative from
+	CurrentTick int64
 }
 
 // X returns x coordinate
@@ -110,6 +166,11 @@ func (chunk *Chunk) Y() int {
 	return chunk.y
 }
 
+// Edition returns the edition of minecraft using the chunk
+func (Chunk) Edition() asset.Edition {
+	return asset.BedrockEdition
+}
+
 // SetX set x coordinate
 func (chunk *Chunk) SetX(x int) {
 	chunk.x = x
@@ -125,8 +186,57 @@ func (chunk *Chunk) atData2D(x, y int) int {
 }
 
 // Height returns the height of the highest block at chunk coordinate
-func (chunk *Chunk) Height(x, y int) uint16 {
-	return chunk.heightMap[chunk.atData2D(x, y)]
+// If the heightmap of kind isn't computed yet, it's computed from blocks
+func (chunk *Chunk) Height(x, y int, kind level.HeightMapType) (height uint16, ok bool) {
+	heights, ok := chunk.heightMaps[kind]
+	if !ok {
+		var err error
+		heights, err = heightmap.Compute(chunk, kind)
+		if err != nil {
+			return 0, false
+		}
+
+		chunk.heightMaps[kind] = heights
+	}
+
+	return heights[chunk.atData2D(x, y)], true
+}
+
+// SetHeight sets the height of the highest block at chunk coordinate
+func (chunk *Chunk) SetHeight(x, y int, kind level.HeightMapType, height uint16) {
+	heights, ok := chunk.heightMaps[kind]
+	if !ok {
+		heights = make([]uint16, heightmap.Size)
+		chunk.heightMaps[kind] = heights
+	}
+
+	heights[chunk.atData2D(x, y)] = height
+}
+
+// RecalculateHeightMaps recomputes all heightmaps from blocks
+func (chunk *Chunk) RecalculateHeightMaps() error {
+	for kind := range chunk.heightMaps {
+		heights, err := heightmap.Compute(chunk, kind)
+		if err != nil {
+			return err
+		}
+
+		chunk.heightMaps[kind] = heights
+	}
+
+	return nil
+}
+
+// updateHeightMaps updates heightmaps after a block is set
+func (chunk *Chunk) updateHeightMaps(x, y, z int, bs level.BlockState) error {
+	for kind, heights := range chunk.heightMaps {
+		err := heightmap.Update(chunk, heights, x, y, z, bs, kind)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // Biome returns biome
@@ -151,12 +261,56 @@ func (chunk *Chunk) SetEntities(entities []*nbt.Compound) {
 
 // BlockEntities returns block entities of nbt data
 func (chunk *Chunk) BlockEntities() []*nbt.Compound {
-	return chunk.blockEntities
+	return chunk.blockEntities.All()
 }
 
 // SetBlockEntities set block entities of nbt data
 func (chunk *Chunk) SetBlockEntities(entities []*nbt.Compound) {
-	chunk.blockEntities = entities
+	chunk.blockEntities = blockentity.NewIndex(entities)
+}
+
+// BlockEntity returns a block entity at chunk coordinate
+func (chunk *Chunk) BlockEntity(x, y, z int) (*nbt.Compound, bool) {
+	return chunk.blockEntities.Get(chunk.x*16+x, y, chunk.y*16+z)
+}
+
+// SetBlockEntity sets a block entity at the coordinate (x, y, z) of nbt data
+// A block entity at the same coordinate is replaced
+func (chunk *Chunk) SetBlockEntity(com *nbt.Compound) error {
+	pos, ok := blockentity.PosOf(com)
+	if !ok {
+		return fmt.Errorf("level.leveldb: the block entity doesn't have a coordinate")
+	}
+
+	if pos.X>>4 != chunk.x || pos.Z>>4 != chunk.y {
+		return fmt.Errorf("level.leveldb: the block entity is out of the chunk")
+	}
+
+	return chunk.blockEntities.Set(com)
+}
+
+// RemoveBlockEntity removes a block entity at chunk coordinate
+// If the block entity isn't found, returns false
+func (chunk *Chunk) RemoveBlockEntity(x, y, z int) bool {
+	return chunk.blockEntities.Remove(chunk.x*16+x, y, chunk.y*16+z)
+}
+
+// removeOrphan removes a block entity at chunk coordinate if the block is changed to state
+func (chunk *Chunk) removeOrphan(x, y, z int, state level.BlockState) error {
+	if !chunk.blockEntities.Has(chunk.x*16+x, y, chunk.y*16+z) {
+		return nil
+	}
+
+	old, err := chunk.GetBlock(x, y, z)
+	if err != nil {
+		return err
+	}
+
+	if state == nil || old.Name() != state.Name() {
+		chunk.RemoveBlockEntity(x, y, z)
+	}
+
+	return nil
 }
 
 // SubChunks returns sub chunks
@@ -178,9 +332,14 @@ func (chunk *Chunk) AtSubChunk(y int) (*SubChunk, bool) {
 	return chunk.GetSubChunk(y / 16)
 }
 
+// BuildHeight returns the height of blocks which the chunk can store
+func (chunk *Chunk) BuildHeight() int {
+	return len(chunk.subChunks) * 16
+}
+
 // Vaild vailds a chunk coordinates
 func (chunk *Chunk) Vaild(x, y, z int) bool {
-	return x >= 0 && x <= 15 && y >= 0 && y <= 256 && z >= 0 && z <= 15
+	return x >= 0 && x <= 15 && y >= 0 && y < len(chunk.subChunks)*16 && z >= 0 && z <= 15
 }
 
 // GetBlock gets a BlockState at a chunk coordinate
@@ -199,28 +358,87 @@ func (chunk *Chunk) GetBlockAtStorage(x, y, z, index int) (*RawBlockState, error
 		return chunk.DefaultBlock, nil // Air
 	}
 
+	if _, ok := sub.GetBlockStorage(index); !ok && index >= 0 {
+		return chunk.DefaultBlock, nil // Air
+	}
+
 	return sub.GetBlock(x, y&15, z, index)
 }
 
+// GetBlockAtLayer gets a BlockState at a chunk coordinate from the layer
+// The layer is a index of storages, storages which don't exist are air
+func (chunk *Chunk) GetBlockAtLayer(x, y, z, layer int) (level.BlockState, error) {
+	return chunk.GetBlockAtStorage(x, y, z, layer)
+}
+
 // SetBlock set a BlockState at chunk coordinate
+// waterlogged property of blocks for mcje is set as water in the liquid layer
 func (chunk *Chunk) SetBlock(x, y, z int, bs level.BlockState) error {
-	rbs, err := FromRawBlockState(bs)
+	return chunk.SetBlockAtLayer(x, y, z, DefaultStorageIndex, bs)
+}
+
+// SetBlockAtLayer set a BlockState at chunk coordinate to the layer
+// The layer is a index of storages, storages are created if they don't exist
+func (chunk *Chunk) SetBlockAtLayer(x, y, z, layer int, bs level.BlockState) error {
+	rbs, waterlogged, ok, err := SplitRawBlockState(bs)
 	if err != nil {
 		return err
 	}
 
-	return chunk.SetBlockAtStorage(x, y, z, DefaultStorageIndex, rbs)
+	err = chunk.SetBlockAtStorage(x, y, z, layer, rbs)
+	if err != nil || !ok || layer != level.LayerBlock {
+		return err
+	}
+
+	liquid := chunk.DefaultBlock // Air
+	if waterlogged {
+		liquid = NewWaterBlockState(chunk.DefaultBlock.Version())
+	} else if !chunk.hasStorage(y, level.LayerLiquid) {
+		return nil
+	}
+
+	return chunk.SetBlockAtStorage(x, y, z, level.LayerLiquid, liquid)
+}
+
+// hasStorage returns whether the subchunk at y has storage of index
+func (chunk *Chunk) hasStorage(y, index int) bool {
+	sub, ok := chunk.AtSubChunk(y)
+	if !ok {
+		return false
+	}
+
+	_, ok = sub.GetBlockStorage(index)
+
+	return ok
 }
 
 // SetBlockAtStorage set a BlockState at chunk coordinate to storage of index
+// If the storage doesn't exist, storages are added until index
 func (chunk *Chunk) SetBlockAtStorage(x, y, z, index int, bs *RawBlockState) error {
 	if !chunk.Vaild(x, y, z) {
 		return fmt.Errorf("level.leveldb: invaild chunk coordinate")
 	}
 
+	if index < 0 {
+		return fmt.Errorf("level.leveldb: invaild storage index")
+	}
+
 	sub, ok := chunk.AtSubChunk(y)
 	if !ok {
 		sub = NewSubChunk(byte(y / 16))
+
+		chunk.subChunks[y/16] = sub
+	}
+
+	if index == chunk.DefaultStorageIndex {
+		err := chunk.removeOrphan(x, y, z, bs)
+		if err != nil {
+			return err
+		}
+	}
+
+	for len(sub.Storages) <= index {
+		sub.Storages = append(sub.Storages, NewBlockStorageWith(chunk.DefaultBlock))
 	}
 
 	err := sub.SetBlock(x, y&15, z, index, bs)
@@ -229,6 +447,13 @@ func (chunk *Chunk) SetBlockAtStorage(x, y, z, index int, bs *RawBlockState) err
 		return err
 	}
 
+	if index == chunk.DefaultStorageIndex {
+		err = chunk.updateHeightMaps(x, y, z, bs)
+		if err != nil {
+			return err
+		}
+	}
+
 	if chunk.Finalization == NotGenerated {
 		chunk.Finalization = NotSpawnMobs
 	}
@@ -237,6 +462,7 @@ func (chunk *Chunk) SetBlockAtStorage(x, y, z, index int, bs *RawBlockState) err
 }
 
 const (
+	TagVersion        = 44 // mcbe v1.16.100 or after
 	TagData2D         = 45
 	TagData2DLegacy   = 46
 	TagSubChunkPrefix = 47
@@ -247,7 +473,7 @@ const (
 	TagBlockExtraData = 52
 	TagBiomeState     = 53
 	TagFinalizedState = 54
-	TagVersion        = 118
+	TagLegacyVersion  = 118
 )
 
 // ChunkFormat is a chunk format reader and writer
@@ -256,6 +482,16 @@ type ChunkFormat interface {
 	Read(db *lvldb.DB, x, y int, dimension level.Dimension) (*Chunk, error)
 	Write(db *lvldb.DB, chunk *Chunk, dimension level.Dimension) error
 	Exist(db *lvldb.DB, x, y int, dimension level.Dimension) (bool, error)
+
+	// Stamp returns a hash of raw data of the chunk
+	// If the chunk doesn't exist, returns false for ok
+	Stamp(db *lvldb.DB, x, y int, dimension level.Dimension) (stamp uint64, ok bool, err error)
+
+	// Chunks returns coordinates of all chunks in the dimension
+	Chunks(db *lvldb.DB, dimension level.Dimension) ([]level.ChunkCoord, error)
+
+	// Delete deletes all records of the chunk
+	Delete(db *lvldb.DB, x, y int, dimension level.Dimension) error
 }
 
 const (
@@ -264,6 +500,13 @@ const (
 	SubChunkVersionV130  = 8
 )
 
+// NewChunkFormatV100 returns new ChunkFormatV100 writing subchunks as v1.3 format
+func NewChunkFormatV100() *ChunkFormatV100 {
+	return &ChunkFormatV100{
+		SubChunkVersion: SubChunkVersionV130,
+	}
+}
+
 // ChunkFormatV100 is a chunk format v1.0.0 or after
 type ChunkFormatV100 struct {
 	// SubChunkVersion is used a format when it writes a chunk
@@ -272,12 +515,13 @@ type ChunkFormatV100 struct {
 	DisabledData2D      bool
 	DisabledEntity      bool
 	DisabledBlockEntity bool
+	DisabledTicks       bool
+	DisabledExtraBlocks bool
 }
 
 // Read reads a chunk
 func (format *ChunkFormatV100) Read(db *lvldb.DB, x, y int, dimension level.Dimension) (*Chunk, error) {
 	chunk := NewChunk(x, y)
-	chunk.DefaultBlock = NewRawBlockState("minecraft:air", 0)
 
 	// Exist
 
@@ -290,6 +534,24 @@ func (format *ChunkFormatV100) Read(db *lvldb.DB, x, y int, dimension level.Dime
 		return nil, fmt.Errorf("level.leveldb: the chunk isn't generated")
 	}
 
+	// Version
+
+	tag, _, err := format.versionTag(db, x, y, dimension)
+	if err != nil {
+		return nil, err
+	}
+
+	ver, err := db.Get(format.getChunkKey(x, y, dimension, tag, -1), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ver) > 0 {
+		chunk.Version = ver[0]
+	}
+
+	chunk.VersionTag = tag
+
 	// Finalization
 
 	stateKey := format.getChunkKey(x, y, dimension, TagFinalizedState, -1)
@@ -341,6 +603,27 @@ func (format *ChunkFormatV100) Read(db *lvldb.DB, x, y int, dimension level.Dime
 		chunk.subChunks[y] = sub
 	}
 
+	iter.Release()
+
+	err = iter.Error()
+	if err != nil {
+		return nil, err
+	}
+
+	// Use air in the same palette format as the chunk
+	chunk.DefaultBlock = NewRawBlockState("minecraft:air", 0)
+	for _, sub := range chunk.subChunks {
+		if sub == nil || len(sub.Storages) == 0 || len(sub.Storages[0].Palettes) == 0 {
+			continue
+		}
+
+		if sub.Storages[0].Palettes[0].HasStates() {
+			chunk.DefaultBlock = NewRawBlockStateWithStates("minecraft:air", nil, sub.Storages[0].Palettes[0].Version())
+		}
+
+		break
+	}
+
 	// Read Data2D
 	if !format.DisabledData2D {
 		data2dKey := format.getChunkKey(x, y, dimension, TagData2D, -1)
@@ -366,12 +649,14 @@ func (format *ChunkFormatV100) Read(db *lvldb.DB, x, y int, dimension level.Dime
 
 			rawHeightMap := b[:512]
 
-			chunk.heightMap = make([]uint16, 16*16)
-			for i := 0; i < len(chunk.heightMap); i++ {
-				chunk.heightMap[i] = binary.ReadLUShort(rawHeightMap[i*2 : i*2+2])
+			heights := make([]uint16, heightmap.Size)
+			for i := 0; i < len(heights); i++ {
+				heights[i] = binary.ReadLUShort(rawHeightMap[i*2 : i*2+2])
 			}
 
-			if ln < biomesLen {
+			chunk.heightMaps[Data2DHeightMap] = heights
+
+			if ln < heightMapLen+biomesLen {
 				return nil, fmt.Errorf("level.leveldb: not enough bytes for Biomes")
 			}
 
@@ -381,6 +666,13 @@ func (format *ChunkFormatV100) Read(db *lvldb.DB, x, y int, dimension level.Dime
 
 	// Read Entity
 	if !format.DisabledEntity {
+		actors, ok, err := format.ReadActors(db, x, y, dimension)
+		if err != nil {
+			return nil, err
+		}
+
+		chunk.ActorStorage = ok || chunk.Version >= ActorStorageChunkVersion
+
 		entityKey := format.getChunkKey(x, y, dimension, TagEntity, -1)
 
 		hasEntity, err := db.Has(entityKey, nil)
@@ -399,6 +691,34 @@ func (format *ChunkFormatV100) Read(db *lvldb.DB, x, y int, dimension level.Dime
 				return nil, err
 			}
 		}
+
+		chunk.entities = append(chunk.entities, actors...)
+	}
+
+	// Read ticks
+	if !format.DisabledTicks {
+		chunk.ticks, chunk.CurrentTick, err = format.ReadTicks(db, x, y, dimension, TagPendingTicks)
+		if err != nil {
+			return nil, err
+		}
+
+		var current int64
+		chunk.RandomTicks, current, err = format.ReadTicks(db, x, y, dimension, TagRandomTicks)
+		if err != nil {
+			return nil, err
+		}
+
+		if chunk.ticks == nil {
+			chunk.CurrentTick = current
+		}
+	}
+
+	// Read extra blocks
+	if !format.DisabledExtraBlocks {
+		chunk.extraBlocks, err = format.ReadExtraBlocks(db, x, y, dimension)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Read BlockEntity
@@ -416,10 +736,12 @@ func (format *ChunkFormatV100) Read(db *lvldb.DB, x, y int, dimension level.Dime
 				return nil, err
 			}
 
-			chunk.blockEntities, err = format.ReadCompounds(b)
+			entities, err := format.ReadCompounds(b)
 			if err != nil {
 				return nil, err
 			}
+
+			chunk.blockEntities = blockentity.NewIndex(entities)
 		}
 	}
 
@@ -428,10 +750,20 @@ func (format *ChunkFormatV100) Read(db *lvldb.DB, x, y int, dimension level.Dime
 
 // Write writes a chunk
 func (format *ChunkFormatV100) Write(db *lvldb.DB, chunk *Chunk, dimension level.Dimension) error {
+	tag := chunk.VersionTag
+	if tag != TagVersion {
+		tag = TagLegacyVersion
+	}
+
+	err := db.Put(format.getChunkKey(chunk.x, chunk.y, dimension, tag, -1), []byte{chunk.Version}, nil)
+	if err != nil {
+		return err
+	}
+
 	if chunk.Finalization != Unsupported {
-		stateKey := format.getChunkKey(chunk.X(), chunk.X(), dimension, TagFinalizedState, -1)
+		stateKey := format.getChunkKey(chunk.x, chunk.y, dimension, TagFinalizedState, -1)
 
-		err := db.Put(stateKey, []byte{chunk.Finalization.ID()}, nil)
+		err := db.Put(stateKey, binary.WriteLInt(int32(chunk.Finalization.ID())), nil)
 		if err != nil {
 			return err
 		}
@@ -439,6 +771,10 @@ func (format *ChunkFormatV100) Write(db *lvldb.DB, chunk *Chunk, dimension level
 
 	// Write subchunks
 	for _, sub := range chunk.SubChunks() {
+		if sub == nil {
+			continue
+		}
+
 		b, err := format.WriteSubChunk(sub)
 		if err != nil {
 			return err
@@ -459,12 +795,13 @@ func (format *ChunkFormatV100) Write(db *lvldb.DB, chunk *Chunk, dimension level
 
 		b := make([]byte, heightMapLen+biomesLen)
 
-		if len(chunk.heightMap) < count {
+		heights, ok := chunk.heightMaps[Data2DHeightMap]
+		if !ok || len(heights) < count {
 			return fmt.Errorf("level.leveldb: invaild height map")
 		}
 
 		for i := 0; i < count; i++ {
-			short := binary.WriteLUShort(chunk.heightMap[i])
+			short := binary.WriteLUShort(heights[i])
 			b[i*2] = short[0]
 			b[(i*2)+1] = short[1]
 		}
@@ -482,24 +819,39 @@ func (format *ChunkFormatV100) Write(db *lvldb.DB, chunk *Chunk, dimension level
 	}
 
 	if !format.DisabledEntity {
-		b, err := format.WriteCompounds(chunk.entities)
+		var err error
+		if chunk.ActorStorage {
+			err = format.WriteActors(db, chunk, dimension)
+		} else {
+			err = format.putCompounds(db, format.getChunkKey(chunk.x, chunk.y, dimension, TagEntity, -1), chunk.entities)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !format.DisabledTicks {
+		err := format.WriteTicks(db, chunk, dimension, TagPendingTicks, chunk.ticks)
 		if err != nil {
 			return err
 		}
 
-		err = db.Put(format.getChunkKey(chunk.x, chunk.y, dimension, TagEntity, -1), b, nil)
+		err = format.WriteTicks(db, chunk, dimension, TagRandomTicks, chunk.RandomTicks)
 		if err != nil {
 			return err
 		}
 	}
 
-	if !format.DisabledBlockEntity {
-		b, err := format.WriteCompounds(chunk.blockEntities)
+	if !format.DisabledExtraBlocks {
+		err := format.WriteExtraBlocks(db, chunk, dimension)
 		if err != nil {
 			return err
 		}
+	}
 
-		err = db.Put(format.getChunkKey(chunk.x, chunk.y, dimension, TagBlockEntity, -1), b, nil)
+	if !format.DisabledBlockEntity {
+		err := format.putCompounds(db, format.getChunkKey(chunk.x, chunk.y, dimension, TagBlockEntity, -1), chunk.blockEntities.All())
 		if err != nil {
 			return err
 		}
@@ -508,9 +860,165 @@ func (format *ChunkFormatV100) Write(db *lvldb.DB, chunk *Chunk, dimension level
 	return nil
 }
 
+// putCompounds puts compounds with the key
+// If there are no compounds, the key is deleted
+func (format *ChunkFormatV100) putCompounds(db *lvldb.DB, key []byte, tags []*nbt.Compound) error {
+	if len(tags) == 0 {
+		return db.Delete(key, nil)
+	}
+
+	b, err := format.WriteCompounds(tags)
+	if err != nil {
+		return err
+	}
+
+	return db.Put(key, b, nil)
+}
+
 // Exist returns whether a chunk is generated
 func (format *ChunkFormatV100) Exist(db *lvldb.DB, x, y int, dimension level.Dimension) (bool, error) {
-	return db.Has(format.getChunkKey(x, y, dimension, TagVersion, -1), nil)
+	_, ok, err := format.versionTag(db, x, y, dimension)
+
+	return ok, err
+}
+
+// versionTag returns a tag of the version record of the chunk
+// TagVersion is checked before TagLegacyVersion, if the chunk doesn't have both, returns false for ok
+func (format *ChunkFormatV100) versionTag(db *lvldb.DB, x, y int, dimension level.Dimension) (tag byte, ok bool, err error) {
+	for _, tag := range []byte{TagVersion, TagLegacyVersion} {
+		ok, err := db.Has(format.getChunkKey(x, y, dimension, tag, -1), nil)
+		if err != nil || ok {
+			return tag, ok, err
+		}
+	}
+
+	return TagLegacyVersion, false, nil
+}
+
+// Stamp returns a hash of raw data of the chunk
+// It's changed when any record of the chunk is changed
+// If the chunk doesn't exist, returns false for ok
+func (format *ChunkFormatV100) Stamp(db *lvldb.DB, x, y int, dimension level.Dimension) (stamp uint64, ok bool, err error) {
+	key := format.getChunkKey(x, y, dimension, 0, -1)
+	prefix := key[:len(key)-1] // without tag
+
+	hash := fnv.New64a()
+
+	iter := db.NewIterator(util.BytesPrefix(prefix), nil)
+	for iter.Next() {
+		// keys of other dimensions have the same prefix in the overworld
+		if n := len(iter.Key()) - len(prefix); n != 1 && n != 2 {
+			continue
+		}
+
+		hash.Write(iter.Key())
+		hash.Write(iter.Value())
+
+		ok = true
+	}
+
+	iter.Release()
+
+	err = iter.Error()
+	if err != nil || !ok {
+		return 0, false, err
+	}
+
+	digest, err := db.Get(format.getDigestKey(x, y, dimension), nil)
+	if err != nil && err != lvldb.ErrNotFound {
+		return 0, false, err
+	}
+
+	hash.Write(digest)
+
+	return hash.Sum64(), true, nil
+}
+
+// Chunks returns coordinates of all chunks in the dimension
+// Chunks are found by version records
+func (format *ChunkFormatV100) Chunks(db *lvldb.DB, dimension level.Dimension) ([]level.ChunkCoord, error) {
+	size := len(format.getChunkKey(0, 0, dimension, TagVersion, -1))
+
+	var result []level.ChunkCoord
+	found := make(map[level.ChunkCoord]bool)
+
+	iter := db.NewIterator(nil, nil)
+	for iter.Next() {
+		key := iter.Key()
+		if len(key) != size {
+			continue
+		}
+
+		tag := key[size-1]
+		if tag != TagVersion && tag != TagLegacyVersion {
+			continue
+		}
+
+		x := int(binary.ReadLInt(key[0:4]))
+		y := int(binary.ReadLInt(key[4:8]))
+
+		// other keys which have the same length such as ~local_player
+		if !bytes.Equal(key, format.getChunkKey(x, y, dimension, tag, -1)) {
+			continue
+		}
+
+		coord := level.ChunkCoord{X: x, Y: y}
+		if found[coord] {
+			continue
+		}
+
+		found[coord] = true
+		result = append(result, coord)
+	}
+
+	iter.Release()
+
+	err := iter.Error()
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Delete deletes all records of the chunk and actors in the chunk
+func (format *ChunkFormatV100) Delete(db *lvldb.DB, x, y int, dimension level.Dimension) error {
+	key := format.getChunkKey(x, y, dimension, 0, -1)
+	prefix := key[:len(key)-1] // without tag
+
+	batch := new(lvldb.Batch)
+
+	iter := db.NewIterator(util.BytesPrefix(prefix), nil)
+	for iter.Next() {
+		// keys of other dimensions have the same prefix in the overworld
+		if n := len(iter.Key()) - len(prefix); n != 1 && n != 2 {
+			continue
+		}
+
+		batch.Delete(append([]byte(nil), iter.Key()...))
+	}
+
+	iter.Release()
+
+	err := iter.Error()
+	if err != nil {
+		return err
+	}
+
+	keys, ok, err := format.readDigest(db, x, y, dimension)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		for _, key := range keys {
+			batch.Delete(format.getActorKey(key))
+		}
+
+		batch.Delete(format.getDigestKey(x, y, dimension))
+	}
+
+	return db.Write(batch, nil)
 }
 
 // ReadSubChunk reads a subchunk from bytes b
@@ -597,7 +1105,7 @@ func (format *ChunkFormatV100) ReadCompounds(b []byte) ([]*nbt.Compound, error)
 func (format *ChunkFormatV100) WriteCompounds(tags []*nbt.Compound) ([]byte, error) {
 	stream := nbt.NewStream(nbt.LittleEndian)
 	for _, com := range tags {
-		err := stream.WriteTag(com)
+		err := stream.WriteTag(lvlutil.FixArrays(com))
 		if err != nil {
 			return nil, err
 		}
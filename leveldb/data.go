@@ -11,12 +11,14 @@ package leveldb
 
 import (
 	"errors"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 
 	"github.com/beito123/binary"
 	"github.com/beito123/level"
+	"github.com/beito123/level/util"
 	"github.com/beito123/nbt"
 )
 
@@ -40,6 +42,8 @@ var (
 	TagSpawnX    = "SpawnX"
 	TagSpawnY    = "SpawnY"
 	TagSpawnZ    = "SpawnZ"
+
+	TagLastOpenedWithVersion = "lastOpenedWithVersion"
 )
 
 // LoadLevelData loads properties from level.dat
@@ -83,7 +87,7 @@ func LoadLevelData(path string) (*Properties, error) {
 func SaveLevelData(path string, pro *Properties) error {
 	nstream := nbt.NewStream(nbt.LittleEndian)
 
-	err := nstream.WriteTag(pro.Data)
+	err := nstream.WriteTag(util.FixArrays(pro.Data))
 	if err != nil {
 		return err
 	}
@@ -116,3 +120,34 @@ type Properties struct {
 	Data    *nbt.Compound
 	Version int
 }
+
+// Detect returns whether the directory is a leveldb level
+// It checks db directory and the little-endian header of level.dat (the version and the length)
+func Detect(path string) bool {
+	if !util.ExistDir(filepath.Join(path, DBPath)) {
+		return false
+	}
+
+	file, err := os.Open(filepath.Join(path, LevelDataFile))
+	if err != nil {
+		return false
+	}
+
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	header := make([]byte, 8)
+
+	_, err = io.ReadFull(file, header)
+	if err != nil {
+		return false
+	}
+
+	ln := int64(int32(header[4]) | int32(header[5])<<8 | int32(header[6])<<16 | int32(header[7])<<24)
+
+	return ln == info.Size()-8
+}
@@ -0,0 +1,117 @@
+package leveldb
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+
+	"github.com/beito123/binary"
+	lvldb "github.com/beito123/goleveldb/leveldb"
+	"github.com/beito123/level"
+)
+
+// ExtraBlock is a block in the extra layer of a chunk (TagBlockExtraData)
+// It's used before mcbe v1.2.13 for blocks such as snow layers on tall grass
+type ExtraBlock struct {
+	ID   byte
+	Meta byte
+}
+
+// extraIndex returns a key of an extra block by chunk coordinate
+func extraIndex(x, y, z int) int {
+	return (y << 8) | ((z & 15) << 4) | (x & 15)
+}
+
+// ExtraBlock returns a block in the extra layer at chunk coordinate
+// If there is no block, returns false for ok
+func (chunk *Chunk) ExtraBlock(x, y, z int) (bl ExtraBlock, ok bool) {
+	bl, ok = chunk.extraBlocks[extraIndex(x, y, z)]
+
+	return bl, ok
+}
+
+// SetExtraBlock sets a block in the extra layer at chunk coordinate
+// If the id of bl is 0 (air), the block is removed
+func (chunk *Chunk) SetExtraBlock(x, y, z int, bl ExtraBlock) error {
+	if !chunk.Vaild(x, y, z) {
+		return fmt.Errorf("level.leveldb: invaild chunk coordinate")
+	}
+
+	if bl.ID == 0 {
+		delete(chunk.extraBlocks, extraIndex(x, y, z))
+
+		return nil
+	}
+
+	if chunk.extraBlocks == nil {
+		chunk.extraBlocks = make(map[int]ExtraBlock)
+	}
+
+	chunk.extraBlocks[extraIndex(x, y, z)] = bl
+
+	return nil
+}
+
+// ReadExtraBlocks reads blocks in the extra layer
+// Blocks are indexed by (y << 8) | (z << 4) | x
+func (format *ChunkFormatV100) ReadExtraBlocks(db *lvldb.DB, x, y int, dimension level.Dimension) (map[int]ExtraBlock, error) {
+	key := format.getChunkKey(x, y, dimension, TagBlockExtraData, -1)
+
+	ok, err := db.Has(key, nil)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	b, err := db.Get(key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) < 4 {
+		return nil, fmt.Errorf("level.leveldb: not enough bytes for block extra data")
+	}
+
+	count := int(binary.ReadLInt(b[:4]))
+	if count < 0 || len(b) < 4+count*6 {
+		return nil, fmt.Errorf("level.leveldb: not enough bytes for block extra data")
+	}
+
+	blocks := make(map[int]ExtraBlock, count)
+	for i := 0; i < count; i++ {
+		entry := b[4+i*6 : 4+i*6+6]
+
+		val := binary.ReadLUShort(entry[4:6])
+
+		blocks[int(binary.ReadLInt(entry[:4]))] = ExtraBlock{
+			ID:   byte(val),
+			Meta: byte(val >> 8),
+		}
+	}
+
+	return blocks, nil
+}
+
+// WriteExtraBlocks writes blocks in the extra layer
+// If there are no blocks, the key is deleted
+func (format *ChunkFormatV100) WriteExtraBlocks(db *lvldb.DB, chunk *Chunk, dimension level.Dimension) error {
+	key := format.getChunkKey(chunk.x, chunk.y, dimension, TagBlockExtraData, -1)
+	if len(chunk.extraBlocks) == 0 {
+		return db.Delete(key, nil)
+	}
+
+	b := make([]byte, 0, 4+len(chunk.extraBlocks)*6)
+	b = append(b, binary.WriteLInt(int32(len(chunk.extraBlocks)))...)
+	for index, bl := range chunk.extraBlocks {
+		b = append(b, binary.WriteLInt(int32(index))...)
+		b = append(b, binary.WriteLUShort(uint16(bl.Meta)<<8|uint16(bl.ID))...)
+	}
+
+	return db.Put(key, b, nil)
+}
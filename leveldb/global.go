@@ -0,0 +1,608 @@
+package leveldb
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	lvldb "github.com/beito123/goleveldb/leveldb"
+	"github.com/beito123/goleveldb/leveldb/util"
+	lvlutil "github.com/beito123/level/util"
+	"github.com/beito123/nbt"
+)
+
+// Keys of global records
+const (
+	KeyPortals    = "portals"
+	KeyMobEvents  = "mobevents"
+	KeyScoreboard = "scoreboard"
+	KeyBiomeData  = "BiomeData"
+
+	// KeyLocalPlayer is a key of the player of the local world
+	KeyLocalPlayer = "~local_player"
+
+	// KeyPlayerPrefix is a key prefix of other players such as player_<id> and player_server_<uuid>
+	KeyPlayerPrefix = "player_"
+
+	// KeyVillagePrefix is a key prefix of villages
+	// Keys are VILLAGE_<uuid>_<kind>, or VILLAGE_<dimension>_<uuid>_<kind> (mcbe v1.16.100 or after)
+	KeyVillagePrefix = "VILLAGE_"
+)
+
+// GlobalRecord returns a compound stored with the key
+// If the key isn't found, returns false for ok
+func (lvl *LevelDB) GlobalRecord(key string) (com *nbt.Compound, ok bool, err error) {
+	lvl.mutex.RLock()
+	defer lvl.mutex.RUnlock()
+
+	return lvl.globalRecord(key)
+}
+
+func (lvl *LevelDB) globalRecord(key string) (*nbt.Compound, bool, error) {
+	b, err := lvl.Database.Get([]byte(key), nil)
+	if err == lvldb.ErrNotFound {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	stream := nbt.NewStreamBytes(nbt.LittleEndian, b)
+
+	tag, err := stream.ReadTag()
+	if err != nil {
+		return nil, false, err
+	}
+
+	com, ok := tag.(*nbt.Compound)
+	if !ok {
+		return nil, false, errors.New("unexpected " + nbt.GetTagName(tag.ID()) + "Tag, expected CompoundTag")
+	}
+
+	return com, true, nil
+}
+
+// SetGlobalRecord stores a compound with the key
+func (lvl *LevelDB) SetGlobalRecord(key string, com *nbt.Compound) error {
+	lvl.mutex.Lock()
+	defer lvl.mutex.Unlock()
+
+	return lvl.setGlobalRecord(key, com)
+}
+
+func (lvl *LevelDB) setGlobalRecord(key string, com *nbt.Compound) error {
+	stream := nbt.NewStream(nbt.LittleEndian)
+
+	err := stream.WriteTag(lvlutil.FixArrays(com))
+	if err != nil {
+		return err
+	}
+
+	return lvl.Database.Put([]byte(key), stream.Bytes(), nil)
+}
+
+// DeleteGlobalRecord deletes a record with the key
+func (lvl *LevelDB) DeleteGlobalRecord(key string) error {
+	lvl.mutex.Lock()
+	defer lvl.mutex.Unlock()
+
+	return lvl.Database.Delete([]byte(key), nil)
+}
+
+// Village kinds of records
+const (
+	VillageInfo     = "INFO"
+	VillageDwellers = "DWELLERS"
+	VillagePOI      = "POI"
+	VillagePlayers  = "PLAYERS"
+)
+
+// VillageKinds is all kinds of village records
+var VillageKinds = []string{
+	VillageInfo,
+	VillageDwellers,
+	VillagePOI,
+	VillagePlayers,
+}
+
+// Village is records of a village
+type Village struct {
+	// ID is the uuid of the village
+	ID string
+
+	// Dimension is a dimension name such as Overworld
+	// It's empty for villages before mcbe v1.16.100
+	Dimension string
+
+	// Records is records by kind (VillageInfo, VillageDwellers, VillagePOI or VillagePlayers)
+	Records map[string]*nbt.Compound
+}
+
+// key returns a key of the record
+func (village *Village) key(kind string) string {
+	if village.Dimension == "" {
+		return KeyVillagePrefix + village.ID + "_" + kind
+	}
+
+	return KeyVillagePrefix + village.Dimension + "_" + village.ID + "_" + kind
+}
+
+// Bounds returns the bounding box of the village from INFO
+func (village *Village) Bounds() (x0, y0, z0, x1, y1, z1 int, ok bool) {
+	info, has := village.Records[VillageInfo]
+	if !has {
+		return 0, 0, 0, 0, 0, 0, false
+	}
+
+	var values [6]int
+	for i, key := range []string{"X0", "Y0", "Z0", "X1", "Y1", "Z1"} {
+		tag, has := info.Get(key)
+		if !has {
+			return 0, 0, 0, 0, 0, 0, false
+		}
+
+		var err error
+		values[i], err = tag.ToInt()
+		if err != nil {
+			return 0, 0, 0, 0, 0, 0, false
+		}
+	}
+
+	return values[0], values[1], values[2], values[3], values[4], values[5], true
+}
+
+// parseVillageKey returns the dimension, the uuid and the kind from a key of villages
+func parseVillageKey(key string) (dimension, id, kind string, ok bool) {
+	if !strings.HasPrefix(key, KeyVillagePrefix) {
+		return "", "", "", false
+	}
+
+	parts := strings.Split(strings.TrimPrefix(key, KeyVillagePrefix), "_")
+	switch len(parts) {
+	case 2:
+		return "", parts[0], parts[1], true
+	case 3:
+		return parts[0], parts[1], parts[2], true
+	}
+
+	return "", "", "", false
+}
+
+// Villages returns all villages sorted by uuid
+func (lvl *LevelDB) Villages() ([]*Village, error) {
+	lvl.mutex.RLock()
+	defer lvl.mutex.RUnlock()
+
+	villages := make(map[string]*Village)
+
+	iter := lvl.Database.NewIterator(util.BytesPrefix([]byte(KeyVillagePrefix)), nil)
+	for iter.Next() {
+		dimension, id, kind, ok := parseVillageKey(string(iter.Key()))
+		if !ok {
+			continue
+		}
+
+		com, ok, err := lvl.globalRecord(string(iter.Key()))
+		if err != nil {
+			iter.Release()
+
+			return nil, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		village, ok := villages[dimension+"_"+id]
+		if !ok {
+			village = &Village{
+				ID:        id,
+				Dimension: dimension,
+				Records:   make(map[string]*nbt.Compound),
+			}
+
+			villages[dimension+"_"+id] = village
+		}
+
+		village.Records[kind] = com
+	}
+
+	iter.Release()
+
+	err := iter.Error()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*Village, 0, len(villages))
+	for _, village := range villages {
+		result = append(result, village)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].ID != result[j].ID {
+			return result[i].ID < result[j].ID
+		}
+
+		return result[i].Dimension < result[j].Dimension
+	})
+
+	return result, nil
+}
+
+// Village returns a village by uuid
+// If the village isn't found, returns false for ok
+func (lvl *LevelDB) Village(id string) (*Village, bool, error) {
+	villages, err := lvl.Villages()
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, village := range villages {
+		if village.ID == id {
+			return village, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// SaveVillage saves records of the village
+// Records which aren't in the village are deleted
+func (lvl *LevelDB) SaveVillage(village *Village) error {
+	lvl.mutex.Lock()
+	defer lvl.mutex.Unlock()
+
+	for _, kind := range VillageKinds {
+		com, ok := village.Records[kind]
+		if !ok {
+			err := lvl.Database.Delete([]byte(village.key(kind)), nil)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		err := lvl.setGlobalRecord(village.key(kind), com)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteVillage deletes all records of the village
+// The game makes the village again if there are villagers and beds
+func (lvl *LevelDB) DeleteVillage(village *Village) error {
+	lvl.mutex.Lock()
+	defer lvl.mutex.Unlock()
+
+	for _, kind := range VillageKinds {
+		err := lvl.Database.Delete([]byte(village.key(kind)), nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PortalRecord is a nether portal record
+type PortalRecord struct {
+	// Dimension is a dimension id (0: overworld, 1: nether, 2: the end)
+	Dimension int
+
+	// X, Y and Z is a teleport coordinate
+	X int
+	Y int
+	Z int
+
+	// Span is the width of the portal
+	Span int
+
+	// XAxis and ZAxis is a direction of the portal (0 or 1)
+	XAxis int
+	ZAxis int
+}
+
+var portalKeys = []string{"DimId", "TpX", "TpY", "TpZ", "Span", "Xa", "Za"}
+
+// Portals returns nether portal records
+func (lvl *LevelDB) Portals() ([]PortalRecord, error) {
+	lvl.mutex.RLock()
+	defer lvl.mutex.RUnlock()
+
+	com, ok, err := lvl.globalRecord(KeyPortals)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	data, err := com.GetCompound("data")
+	if err != nil {
+		return nil, err
+	}
+
+	if !data.Has("PortalRecords") {
+		return nil, nil
+	}
+
+	list, err := data.GetList("PortalRecords")
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]PortalRecord, 0, len(list))
+	for _, tag := range list {
+		record, ok := tag.(*nbt.Compound)
+		if !ok {
+			return nil, errors.New("level.leveldb: invaild portal record")
+		}
+
+		var values [7]int
+		for i, key := range portalKeys {
+			tag, ok := record.Get(key)
+			if !ok {
+				continue
+			}
+
+			values[i], err = tag.ToInt()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		records = append(records, PortalRecord{
+			Dimension: values[0],
+			X:         values[1],
+			Y:         values[2],
+			Z:         values[3],
+			Span:      values[4],
+			XAxis:     values[5],
+			ZAxis:     values[6],
+		})
+	}
+
+	return records, nil
+}
+
+// SetPortals sets nether portal records
+func (lvl *LevelDB) SetPortals(records []PortalRecord) error {
+	lvl.mutex.Lock()
+	defer lvl.mutex.Unlock()
+
+	com, ok, err := lvl.globalRecord(KeyPortals)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		com = nbt.NewCompoundTag("", make(map[string]nbt.Tag))
+	}
+
+	data, err := com.GetCompound("data")
+	if err != nil {
+		data = nbt.NewCompoundTag("data", make(map[string]nbt.Tag))
+		com.Set(data)
+	}
+
+	list := make([]nbt.Tag, len(records))
+	for i, record := range records {
+		list[i] = nbt.NewCompoundTag("", map[string]nbt.Tag{
+			"DimId": nbt.NewIntTag("DimId", int32(record.Dimension)),
+			"TpX":   nbt.NewIntTag("TpX", int32(record.X)),
+			"TpY":   nbt.NewIntTag("TpY", int32(record.Y)),
+			"TpZ":   nbt.NewIntTag("TpZ", int32(record.Z)),
+			"Span":  nbt.NewByteTag("Span", int8(record.Span)),
+			"Xa":    nbt.NewByteTag("Xa", int8(record.XAxis)),
+			"Za":    nbt.NewByteTag("Za", int8(record.ZAxis)),
+		})
+	}
+
+	data.Set(nbt.NewListTag("PortalRecords", list, nbt.IDTagCompound))
+
+	return lvl.setGlobalRecord(KeyPortals, com)
+}
+
+// Mob events
+const (
+	MobEventsEnabled        = "events_enabled"
+	MobEventEnderDragon     = "minecraft:ender_dragon_event"
+	MobEventPillagerPatrols = "minecraft:pillager_patrols_event"
+	MobEventWanderingTrader = "minecraft:wandering_trader_event"
+)
+
+// MobEvents returns whether mob events are enabled by name
+func (lvl *LevelDB) MobEvents() (map[string]bool, error) {
+	lvl.mutex.RLock()
+	defer lvl.mutex.RUnlock()
+
+	com, ok, err := lvl.globalRecord(KeyMobEvents)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	events := make(map[string]bool, len(com.Value))
+	for name, tag := range com.Value {
+		val, err := tag.ToInt()
+		if err != nil {
+			continue
+		}
+
+		events[name] = val != 0
+	}
+
+	return events, nil
+}
+
+// SetMobEvents sets whether mob events are enabled
+// Events which aren't in events are kept
+func (lvl *LevelDB) SetMobEvents(events map[string]bool) error {
+	lvl.mutex.Lock()
+	defer lvl.mutex.Unlock()
+
+	com, ok, err := lvl.globalRecord(KeyMobEvents)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		com = nbt.NewCompoundTag("", make(map[string]nbt.Tag))
+	}
+
+	for name, enabled := range events {
+		var val int8
+		if enabled {
+			val = 1
+		}
+
+		com.Set(nbt.NewByteTag(name, val))
+	}
+
+	return lvl.setGlobalRecord(KeyMobEvents, com)
+}
+
+// Scoreboard returns the raw scoreboard record
+// If the world doesn't have it, returns false for ok
+func (lvl *LevelDB) Scoreboard() (*nbt.Compound, bool, error) {
+	return lvl.GlobalRecord(KeyScoreboard)
+}
+
+// SetScoreboard sets the raw scoreboard record
+func (lvl *LevelDB) SetScoreboard(com *nbt.Compound) error {
+	return lvl.SetGlobalRecord(KeyScoreboard, com)
+}
+
+// BiomeData is a state of a biome
+type BiomeData struct {
+	ID               int
+	SnowAccumulation float32
+}
+
+// BiomeData returns states of biomes
+func (lvl *LevelDB) BiomeData() ([]BiomeData, error) {
+	lvl.mutex.RLock()
+	defer lvl.mutex.RUnlock()
+
+	com, ok, err := lvl.globalRecord(KeyBiomeData)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	if !com.Has("list") {
+		return nil, nil
+	}
+
+	list, err := com.GetList("list")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]BiomeData, 0, len(list))
+	for _, tag := range list {
+		entry, ok := tag.(*nbt.Compound)
+		if !ok {
+			return nil, errors.New("level.leveldb: invaild biome data")
+		}
+
+		id, err := entry.GetByte("id")
+		if err != nil {
+			return nil, err
+		}
+
+		var snow float32
+		if entry.Has("snowAccumulation") {
+			snow, err = entry.GetFloat("snowAccumulation")
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		result = append(result, BiomeData{
+			ID:               int(id),
+			SnowAccumulation: snow,
+		})
+	}
+
+	return result, nil
+}
+
+// SetBiomeData sets states of biomes
+func (lvl *LevelDB) SetBiomeData(data []BiomeData) error {
+	list := make([]nbt.Tag, len(data))
+	for i, d := range data {
+		list[i] = nbt.NewCompoundTag("", map[string]nbt.Tag{
+			"id":               nbt.NewByteTag("id", int8(d.ID)),
+			"snowAccumulation": nbt.NewFloatTag("snowAccumulation", d.SnowAccumulation),
+		})
+	}
+
+	return lvl.SetGlobalRecord(KeyBiomeData, nbt.NewCompoundTag("", map[string]nbt.Tag{
+		"list": nbt.NewListTag("list", list, nbt.IDTagCompound),
+	}))
+}
+
+// Player returns nbt data of the player by the key such as KeyLocalPlayer
+// If the player isn't found, returns false for ok
+func (lvl *LevelDB) Player(key string) (com *nbt.Compound, ok bool, err error) {
+	return lvl.GlobalRecord(key)
+}
+
+// Players returns nbt data of all players by key
+// It contains KeyLocalPlayer and keys with KeyPlayerPrefix
+func (lvl *LevelDB) Players() (map[string]*nbt.Compound, error) {
+	lvl.mutex.RLock()
+	defer lvl.mutex.RUnlock()
+
+	players := make(map[string]*nbt.Compound)
+
+	com, ok, err := lvl.globalRecord(KeyLocalPlayer)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		players[KeyLocalPlayer] = com
+	}
+
+	iter := lvl.Database.NewIterator(util.BytesPrefix([]byte(KeyPlayerPrefix)), nil)
+	for iter.Next() {
+		key := string(iter.Key())
+
+		com, ok, err := lvl.globalRecord(key)
+		if err != nil {
+			iter.Release()
+
+			return nil, err
+		}
+
+		if ok {
+			players[key] = com
+		}
+	}
+
+	iter.Release()
+
+	err = iter.Error()
+	if err != nil {
+		return nil, err
+	}
+
+	return players, nil
+}
+
+// SavePlayer saves nbt data of the player by the key
+func (lvl *LevelDB) SavePlayer(key string, com *nbt.Compound) error {
+	return lvl.SetGlobalRecord(key, com)
+}
@@ -20,6 +20,8 @@ import (
 	"github.com/beito123/goleveldb/leveldb/filter"
 	"github.com/beito123/goleveldb/leveldb/opt"
 	"github.com/beito123/level"
+	"github.com/beito123/level/asset"
+	"github.com/beito123/level/block"
 	"github.com/beito123/nbt"
 )
 
@@ -38,6 +40,16 @@ const (
 	DBPath = "/db"
 )
 
+func init() {
+	level.RegisterFormat(&level.Opener{
+		Name:   "leveldb",
+		Detect: Detect,
+		Open: func(path string) (level.Format, error) {
+			return Load(path)
+		},
+	})
+}
+
 // New returns new LevelDB
 // The path is a directory for save
 func New(path string) (*LevelDB, error) {
@@ -57,7 +69,7 @@ func NewWithOptions(path string, options *opt.Options) (*LevelDB, error) {
 
 	return &LevelDB{
 		Database:   db,
-		Format:     &ChunkFormatV100{},
+		Format:     NewChunkFormatV100(),
 		properties: DefaultProperties,
 		chunks:     make(map[uint64]*Chunk),
 		mutex:      new(sync.RWMutex),
@@ -85,7 +97,7 @@ func LoadWithOptions(path string, options *opt.Options) (*LevelDB, error) {
 
 	return &LevelDB{
 		Database:   db,
-		Format:     &ChunkFormatV100{},
+		Format:     NewChunkFormatV100(),
 		properties: properties,
 		chunks:     make(map[uint64]*Chunk),
 		mutex:      new(sync.RWMutex),
@@ -121,7 +133,7 @@ func (lvl *LevelDB) Name() string {
 
 // SetName sets the name of level
 func (lvl *LevelDB) SetName(name string) {
-	lvl.SetProperty(nbt.NewStringTag(TagGameType, name))
+	lvl.SetProperty(nbt.NewStringTag(TagLevelName, name))
 }
 
 // GameType returns the default game mode of level
@@ -153,8 +165,8 @@ func (lvl *LevelDB) Spawn() (x, y, z int) {
 // SetSpawn sets the default spawn of level
 func (lvl *LevelDB) SetSpawn(x, y, z int) {
 	lvl.SetProperty(nbt.NewIntTag(TagSpawnX, int32(x)))
-	lvl.SetProperty(nbt.NewIntTag(TagSpawnY, int32(x)))
-	lvl.SetProperty(nbt.NewIntTag(TagSpawnZ, int32(x)))
+	lvl.SetProperty(nbt.NewIntTag(TagSpawnY, int32(y)))
+	lvl.SetProperty(nbt.NewIntTag(TagSpawnZ, int32(z)))
 }
 
 // Property returns a property of level.dat
@@ -201,6 +213,11 @@ func (lvl *LevelDB) Close() error {
 	return nil
 }
 
+// Edition returns the edition of minecraft using the level format
+func (LevelDB) Edition() asset.Edition {
+	return asset.BedrockEdition
+}
+
 // Dimension return dimension of the level
 func (lvl *LevelDB) Dimension() level.Dimension {
 	return lvl.dimension
@@ -260,6 +277,12 @@ func (lvl *LevelDB) GenerateChunk(x, y int) error {
 
 	chunk := NewChunk(x, y)
 
+	if lvl.openedWith(1, 16, 100) {
+		chunk.VersionTag = TagVersion
+	}
+
+	chunk.ActorStorage = lvl.openedWith(1, 18, 30)
+
 	lvl.mutex.Lock()
 	lvl.chunks[lvl.at(x, y)] = chunk
 	lvl.mutex.Unlock()
@@ -267,11 +290,63 @@ func (lvl *LevelDB) GenerateChunk(x, y int) error {
 	return nil
 }
 
+// openedWith returns whether the level was opened with the version of mcbe or after at last
+func (lvl *LevelDB) openedWith(version ...int) bool {
+	tag, ok := lvl.Property(TagLastOpenedWithVersion)
+	if !ok {
+		return false
+	}
+
+	list, ok := tag.(*nbt.List)
+	if !ok {
+		return false
+	}
+
+	for i, v := range version {
+		if i >= len(list.Value) {
+			return false
+		}
+
+		n, err := list.Value[i].ToInt()
+		if err != nil {
+			return false
+		}
+
+		if n != v {
+			return n > v
+		}
+	}
+
+	return true
+}
+
 // HasGeneratedChunk returns whether the chunk is generaged
 func (lvl *LevelDB) HasGeneratedChunk(x, y int) (bool, error) {
 	return lvl.Format.Exist(lvl.Database, x, y, lvl.dimension)
 }
 
+// ChunkStamp returns a hash of raw data of the chunk
+// It's changed when the chunk is saved with changes
+// If the chunk isn't generated, returns false for ok
+func (lvl *LevelDB) ChunkStamp(x, y int) (stamp uint64, ok bool, err error) {
+	return lvl.Format.Stamp(lvl.Database, x, y, lvl.dimension)
+}
+
+// GeneratedChunks returns coordinates of all generated chunks in the dimension
+func (lvl *LevelDB) GeneratedChunks() ([]level.ChunkCoord, error) {
+	return lvl.Format.Chunks(lvl.Database, lvl.dimension)
+}
+
+// DeleteChunk deletes all records of the chunk, and unloads it
+// If the chunk isn't generated, it does nothing
+func (lvl *LevelDB) DeleteChunk(x, y int) error {
+	lvl.mutex.Lock()
+	delete(lvl.chunks, lvl.at(x, y))
+	lvl.mutex.Unlock()
+
+	return lvl.Format.Delete(lvl.Database, x, y, lvl.dimension)
+}
+
 // IsLoadedChunk returns weather a chunk is loaded.
 func (lvl *LevelDB) IsLoadedChunk(x, y int) bool {
 	lvl.mutex.RLock()
@@ -361,20 +436,172 @@ func NewRawBlockState(name string, value int) *RawBlockState {
 	}
 }
 
+// NewRawBlockStateWithStates returns new RawBlockState with block states
+// It's used in mcbe v1.13 or after
+func NewRawBlockStateWithStates(name string, states *nbt.Compound, version int) *RawBlockState {
+	if states == nil {
+		states = nbt.NewCompoundTag("states", make(map[string]nbt.Tag))
+	}
+
+	return &RawBlockState{
+		name:    strings.ToLower(name),
+		states:  states,
+		version: version,
+	}
+}
+
+// ReadBlockStateTag reads a block state compound such as palettes (name, states and version, or name and val)
+func ReadBlockStateTag(com *nbt.Compound) (*RawBlockState, error) {
+	name, err := com.GetString("name")
+	if err != nil {
+		return nil, err
+	}
+
+	if com.Has("states") { // v1.13 or after
+		states, err := com.GetCompound("states")
+		if err != nil {
+			return nil, err
+		}
+
+		var version int32
+		if com.Has("version") {
+			version, err = com.GetInt("version")
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return NewRawBlockStateWithStates(name, states, int(version)), nil
+	}
+
+	var val int16
+	if com.Has("val") {
+		val, err = com.GetShort("val")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewRawBlockState(name, int(val)), nil
+}
+
+// WriteBlockStateTag returns a block state compound with the name
+func WriteBlockStateTag(name string, bs *RawBlockState) *nbt.Compound {
+	tag := nbt.NewCompoundTag(name, map[string]nbt.Tag{
+		"name": nbt.NewStringTag("name", bs.Name()),
+	})
+
+	if bs.HasStates() {
+		tag.Value["states"] = bs.States()
+		tag.Set(nbt.NewIntTag("version", int32(bs.Version())))
+	} else {
+		tag.Set(nbt.NewShortTag("val", int16(bs.Value())))
+	}
+
+	return tag
+}
+
 // FromRawBlockState returns new RawBlockState
 func FromRawBlockState(bs level.BlockState) (*RawBlockState, error) {
-	name, meta, ok := bs.ToBlockNameMeta()
-	if !ok {
-		return nil, fmt.Errorf("level.leveldb: usable to convert from %s to RawBlockState", bs.Name())
+	rbs, _, _, err := SplitRawBlockState(bs)
+
+	return rbs, err
+}
+
+// SplitRawBlockState converts bs to RawBlockState, and splits waterlogged property of blocks for mcje
+// If bs doesn't have waterlogged property, returns false for ok
+func SplitRawBlockState(bs level.BlockState) (rbs *RawBlockState, waterlogged bool, ok bool, err error) {
+	if rbs, ok := bs.(*RawBlockState); ok {
+		return rbs, false, false, nil
+	}
+
+	name, meta, isMeta := bs.ToBlockNameMeta()
+	if isMeta {
+		return NewRawBlockState(name, meta), false, false, nil
+	}
+
+	name, properties, hasProperties := bs.ToBlockNameProperties()
+	if !hasProperties {
+		return nil, false, false, fmt.Errorf("level.leveldb: usable to convert from %s to RawBlockState", bs.Name())
+	}
+
+	properties, waterlogged, ok = block.SplitWaterlogged(properties)
+
+	state, err := block.ToBedrockState(name, properties)
+	if err != nil {
+		return nil, false, false, err
+	}
+
+	return NewRawBlockStateWithStates(state.Name, writeStates(state.States), BlockStateVersionV114), waterlogged, ok, nil
+}
+
+// JavaBlockState converts RawBlockState to a block name and properties for mcje v1.13
+// Blocks which have a value except air (mcbe v1.12 or before) aren't supported
+func JavaBlockState(rbs *RawBlockState) (name string, properties map[string]string, err error) {
+	if !rbs.HasStates() && rbs.Value() != 0 {
+		return "", nil, fmt.Errorf("level.leveldb: unable to convert %s:%d to a block state for mcje", rbs.Name(), rbs.Value())
+	}
+
+	values := make(map[string]interface{})
+	if rbs.HasStates() {
+		values, err = readStates(rbs.States())
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	return block.FromBedrockState(&block.BedrockState{Name: rbs.Name(), States: values})
+}
+
+// readStates returns values of a states compound
+// byte tags are read as bool values, int tags are read as int values
+func readStates(states *nbt.Compound) (map[string]interface{}, error) {
+	values := make(map[string]interface{}, len(states.Value))
+	for key, tag := range states.Value {
+		switch t := tag.(type) {
+		case *nbt.Byte:
+			values[key] = t.Value != 0
+		case *nbt.Int:
+			values[key] = int(t.Value)
+		case *nbt.String:
+			values[key] = t.Value
+		default:
+			return nil, fmt.Errorf("level.leveldb: unsupported tag type for the state %s", key)
+		}
+	}
+
+	return values, nil
+}
+
+// writeStates returns a states compound
+// bool values are written as byte tags, int values are written as int tags
+func writeStates(values map[string]interface{}) *nbt.Compound {
+	states := nbt.NewCompoundTag("states", make(map[string]nbt.Tag, len(values)))
+	for key, val := range values {
+		switch v := val.(type) {
+		case bool:
+			var b int8
+			if v {
+				b = 1
+			}
+
+			states.Set(nbt.NewByteTag(key, b))
+		case int:
+			states.Set(nbt.NewIntTag(key, int32(v)))
+		case string:
+			states.Set(nbt.NewStringTag(key, v))
+		}
 	}
 
-	return NewRawBlockState(name, meta), nil
+	return states
 }
 
 // RawBlockState is a raw block information
 type RawBlockState struct {
-	name  string
-	value int
+	name    string
+	value   int
+	states  *nbt.Compound
+	version int
 }
 
 // Name returns block name
@@ -387,20 +614,82 @@ func (block *RawBlockState) Value() int {
 	return block.value
 }
 
+// States returns block states
+// If the block doesn't have block states, returns nil
+func (block *RawBlockState) States() *nbt.Compound {
+	return block.states
+}
+
+// HasStates returns whether the block has block states
+func (block *RawBlockState) HasStates() bool {
+	return block.states != nil
+}
+
+// Version returns a version of block states
+func (block *RawBlockState) Version() int {
+	return block.version
+}
+
 // Equal returns whether block is equal b
 func (block *RawBlockState) Equal(b *RawBlockState) bool {
-	return block.name == b.name && block.value == b.value
+	if block.name != b.name || block.value != b.value {
+		return false
+	}
+
+	if block.HasStates() != b.HasStates() {
+		return false
+	}
+
+	if !block.HasStates() {
+		return true
+	}
+
+	if len(block.states.Value) != len(b.states.Value) {
+		return false
+	}
+
+	for key, tag := range block.states.Value {
+		tag2, ok := b.states.Get(key)
+		if !ok || tag.ID() != tag2.ID() {
+			return false
+		}
+
+		v1, err1 := tag.ToString()
+		v2, err2 := tag2.ToString()
+		if err1 != nil || err2 != nil || v1 != v2 {
+			return false
+		}
+	}
+
+	return true
 }
 
 // ToBlockNameProperties returns block name and properties
 // If it's not supported, returns false for ok
 func (block *RawBlockState) ToBlockNameProperties() (name string, properties map[string]string, ok bool) {
-	return block.name, make(map[string]string), true
+	properties = make(map[string]string)
+
+	if block.HasStates() {
+		for key, tag := range block.states.Value {
+			val, err := tag.ToString()
+			if err != nil {
+				continue
+			}
+
+			properties[key] = val
+		}
+	}
+
+	return block.name, properties, true
 }
 
 // ToBlockNameMeta returns block name and meta
 // If it's not supported, returns false for ok
 func (block *RawBlockState) ToBlockNameMeta() (name string, meta int, ok bool) {
+	if block.HasStates() {
+		return "", 0, false
+	}
+
 	return block.name, block.value, true
 }
 
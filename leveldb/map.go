@@ -0,0 +1,121 @@
+package leveldb
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/beito123/goleveldb/leveldb/util"
+	"github.com/beito123/level/asset"
+	"github.com/beito123/level/mapitem"
+)
+
+// KeyMapPrefix is a key prefix of maps
+// Keys are map_<id>
+const KeyMapPrefix = "map_"
+
+// getMapKey returns a key of the map
+func getMapKey(id int64) string {
+	return KeyMapPrefix + strconv.FormatInt(id, 10)
+}
+
+// MapIDs returns ids of all maps sorted
+func (lvl *LevelDB) MapIDs() ([]int64, error) {
+	lvl.mutex.RLock()
+	defer lvl.mutex.RUnlock()
+
+	var ids []int64
+
+	iter := lvl.Database.NewIterator(util.BytesPrefix([]byte(KeyMapPrefix)), nil)
+	for iter.Next() {
+		id, err := strconv.ParseInt(strings.TrimPrefix(string(iter.Key()), KeyMapPrefix), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		ids = append(ids, id)
+	}
+
+	iter.Release()
+
+	err := iter.Error()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	return ids, nil
+}
+
+// Map returns a map by id
+// If the map isn't found, returns false for ok
+func (lvl *LevelDB) Map(id int64) (*mapitem.Map, bool, error) {
+	com, ok, err := lvl.GlobalRecord(getMapKey(id))
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	m := mapitem.Wrap(com, asset.BedrockEdition, id)
+	m.ID = id
+
+	return m, true, nil
+}
+
+// Maps returns all maps
+func (lvl *LevelDB) Maps() ([]*mapitem.Map, error) {
+	ids, err := lvl.MapIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	maps := make([]*mapitem.Map, 0, len(ids))
+	for _, id := range ids {
+		m, ok, err := lvl.Map(id)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			maps = append(maps, m)
+		}
+	}
+
+	return maps, nil
+}
+
+// SaveMap saves the map
+func (lvl *LevelDB) SaveMap(m *mapitem.Map) error {
+	return lvl.SetGlobalRecord(getMapKey(m.ID), m.Compound)
+}
+
+// DeleteMap deletes the map
+func (lvl *LevelDB) DeleteMap(id int64) error {
+	return lvl.DeleteGlobalRecord(getMapKey(id))
+}
+
+// NewMapID returns a unused map id
+// mcbe uses unique ids of entities for maps, returns the next of the largest id
+func (lvl *LevelDB) NewMapID() (int64, error) {
+	ids, err := lvl.MapIDs()
+	if err != nil {
+		return 0, err
+	}
+
+	if len(ids) == 0 {
+		return 1, nil
+	}
+
+	return ids[len(ids)-1] + 1, nil
+}
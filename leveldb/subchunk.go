@@ -12,7 +12,7 @@ package leveldb
 import "fmt"
 import "math"
 
-// GetStorageTypeFromSize returns 
+// GetStorageTypeFromSize returns the smallest StorageType which can contain a palette of size
 func GetStorageTypeFromSize(size uint) StorageType {
 	size--
 	size |= (size >> 1)
@@ -22,7 +22,15 @@ func GetStorageTypeFromSize(size uint) StorageType {
 	size |= (size >> 16)
 	size++
 
-	return StorageType(math.Log2(float64(size)))
+	bits := StorageType(math.Log2(float64(size)))
+
+	for _, typ := range StorageTypes {
+		if typ >= bits {
+			return typ
+		}
+	}
+
+	return TypePalette16
 }
 
 // StorageType is a type of BlockStorage
@@ -49,6 +57,18 @@ const (
 	TypePalette16 StorageType = 16
 )
 
+// StorageTypes is all StorageType in ascending order
+var StorageTypes = []StorageType{
+	TypePalette1,
+	TypePalette2,
+	TypePalette3,
+	TypePalette4,
+	TypePalette5,
+	TypePalette6,
+	TypePalette8,
+	TypePalette16,
+}
+
 // BlockStorageSize is a size of BlockStorage
 const BlockStorageSize = 16 * 16 * 16
 
@@ -120,6 +140,14 @@ func (storage *BlockStorage) SetBlock(x, y, z int, bs *RawBlockState) error {
 	return nil
 }
 
+// NewBlockStorageWith returns new BlockStorage filled with the block
+func NewBlockStorageWith(bs *RawBlockState) *BlockStorage {
+	storage := NewBlockStorage()
+	storage.Palettes = []*RawBlockState{bs}
+
+	return storage
+}
+
 // NewSubChunk returns new SubChunk
 func NewSubChunk(y byte) *SubChunk {
 	return &SubChunk{
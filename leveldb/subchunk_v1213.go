@@ -164,19 +164,11 @@ func (format *SubChunkFormatV1213) ReadBlockStorage(stream *binary.Stream) (*Blo
 			return nil, fmt.Errorf("level.leveldb: unexpected tag %s (%d)", tag.Name(), tag.ID())
 		}
 
-		name, err := com.GetString("name")
+		state, err := ReadBlockStateTag(com)
 		if err != nil {
 			return nil, err
 		}
 
-		/*val, err := com.GetInt("val")
-		if err != nil {
-			return nil, err
-		}*/
-		val := 0
-
-		state := NewRawBlockState(name, int(val))
-
 		storage.Palettes = append(storage.Palettes, state)
 	}
 
@@ -210,12 +202,14 @@ func (format *SubChunkFormatV1213) WriteBlockStorage(stream *binary.Stream, stor
 
 	count := 0
 	for i := 0; i < wordCount; i++ {
-		var value int32
+		var value uint32
 		for j := 0; j < blocksPerWord && count < BlockStorageSize; j++ {
-			value = int32(storage.Blocks[count]) << uint(j*bitsPerBlock)
+			value |= uint32(storage.Blocks[count]) << uint(j*bitsPerBlock)
+
+			count++
 		}
 
-		err := stream.PutLInt(value)
+		err := stream.PutLInt(int32(value))
 		if err != nil {
 			return err
 		}
@@ -229,16 +223,7 @@ func (format *SubChunkFormatV1213) WriteBlockStorage(stream *binary.Stream, stor
 	nbtStream := nbt.NewStream(nbt.LittleEndian)
 
 	for _, bs := range storage.Palettes {
-		tag := &nbt.Compound{
-			Value: map[string]nbt.Tag{
-				"name": &nbt.String{
-					Value: bs.Name(),
-				},
-				"val": &nbt.Int{
-					Value: int32(bs.Value()),
-				},
-			},
-		}
+		tag := WriteBlockStateTag("", bs)
 
 		err := nbtStream.WriteTag(tag)
 		if err != nil {
@@ -0,0 +1,117 @@
+package leveldb
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/beito123/binary"
+	"github.com/beito123/nbt"
+)
+
+// newTestStorage returns a block storage with the number of palettes
+func newTestStorage(t *testing.T, size int) *BlockStorage {
+	palettes := make([]*RawBlockState, size)
+	for i := range palettes {
+		palettes[i] = NewRawBlockStateWithStates("minecraft:wool", nbt.NewCompoundTag("states", map[string]nbt.Tag{
+			"color": nbt.NewIntTag("color", int32(i)),
+		}), BlockStateVersionV114)
+	}
+
+	storage := NewBlockStorage()
+	for x := 0; x < 16; x++ {
+		for y := 0; y < 16; y++ {
+			for z := 0; z < 16; z++ {
+				err := storage.SetBlock(x, y, z, palettes[(x+y*3+z*7)%size])
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+	}
+
+	return storage
+}
+
+func TestBlockStorageReadWrite(t *testing.T) {
+	format := &SubChunkFormatV1213{}
+
+	for _, size := range []int{1, 2, 3, 5, 9, 17, 33, 100} {
+		storage := newTestStorage(t, size)
+
+		stream := binary.NewStream()
+
+		err := format.WriteBlockStorage(stream, storage)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b := stream.Bytes()
+
+		bits := GetStorageTypeFromSize(uint(size)).BitsPerBlock()
+		if int(b[0]>>1) != bits {
+			t.Errorf("palette size %d: expected %d bits per block, but got %d", size, bits, b[0]>>1)
+		}
+
+		read, err := format.ReadBlockStorage(binary.NewStreamBytes(b))
+		if err != nil {
+			t.Fatalf("palette size %d: %s", size, err)
+		}
+
+		if len(read.Palettes) != size {
+			t.Fatalf("palette size %d: got %d palettes", size, len(read.Palettes))
+		}
+
+		for i, bs := range read.Palettes {
+			if !bs.Equal(storage.Palettes[i]) {
+				t.Errorf("palette size %d: palette %d is different", size, i)
+			}
+		}
+
+		if !reflect.DeepEqual(read.Blocks, storage.Blocks) {
+			t.Errorf("palette size %d: read blocks are different from written blocks", size)
+		}
+	}
+}
+
+func TestSubChunkReadWrite(t *testing.T) {
+	for _, old := range []bool{false, true} {
+		format := &SubChunkFormatV1213{OldFormat: old}
+
+		sub := NewSubChunk(4)
+		sub.Storages = []*BlockStorage{newTestStorage(t, 20), newTestStorage(t, 2)}
+
+		b, err := format.Write(sub)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		read, err := format.Read(4, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		layers := 2
+		if old { // the old format has only a block storage
+			layers = 1
+		}
+
+		if len(read.Storages) != layers {
+			t.Fatalf("old format: %t: expected %d storages, but got %d", old, layers, len(read.Storages))
+		}
+
+		for i, storage := range read.Storages {
+			if !reflect.DeepEqual(storage.Blocks, sub.Storages[i].Blocks) {
+				t.Errorf("old format: %t: blocks of storage %d are different", old, i)
+			}
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package leveldb
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	lvldb "github.com/beito123/goleveldb/leveldb"
+	"github.com/beito123/level"
+	"github.com/beito123/level/block"
+	"github.com/beito123/nbt"
+)
+
+// TagRandomTicks is a tag of random ticks (mcbe v1.14 or after)
+const TagRandomTicks = 58
+
+// ScheduledTicks returns scheduled block updates in the chunk
+func (chunk *Chunk) ScheduledTicks() []level.ScheduledTick {
+	return chunk.ticks
+}
+
+// SetScheduledTicks sets scheduled block updates in the chunk
+func (chunk *Chunk) SetScheduledTicks(ticks []level.ScheduledTick) {
+	chunk.ticks = ticks
+}
+
+// ReadTicks reads ticks with the tag (TagPendingTicks or TagRandomTicks)
+// It returns the current tick of the chunk, Delay of ticks is relative from it
+func (format *ChunkFormatV100) ReadTicks(db *lvldb.DB, x, y int, dimension level.Dimension, tag byte) (ticks []level.ScheduledTick, current int64, err error) {
+	key := format.getChunkKey(x, y, dimension, tag, -1)
+
+	ok, err := db.Has(key, nil)
+	if err != nil || !ok {
+		return nil, 0, err
+	}
+
+	b, err := db.Get(key, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	list, err := format.ReadCompounds(b)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, com := range list {
+		if com.Has("currentTick") {
+			tick, err := com.GetInt("currentTick")
+			if err != nil {
+				return nil, 0, err
+			}
+
+			current = int64(tick)
+		}
+
+		entries, err := com.GetList("tickList")
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, entry := range entries {
+			tick, ok := entry.(*nbt.Compound)
+			if !ok {
+				continue
+			}
+
+			st, err := format.readTick(tick)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			time, err := tick.GetLong("time")
+			if err != nil {
+				return nil, 0, err
+			}
+
+			st.Delay = int(time - current)
+
+			ticks = append(ticks, st)
+		}
+	}
+
+	return ticks, current, nil
+}
+
+func (format *ChunkFormatV100) readTick(com *nbt.Compound) (level.ScheduledTick, error) {
+	var values [3]int32
+	for i, key := range []string{"x", "y", "z"} {
+		var err error
+		values[i], err = com.GetInt(key)
+		if err != nil {
+			return level.ScheduledTick{}, err
+		}
+	}
+
+	var bs *RawBlockState
+	if com.Has("blockState") {
+		state, err := com.GetCompound("blockState")
+		if err != nil {
+			return level.ScheduledTick{}, err
+		}
+
+		bs, err = ReadBlockStateTag(state)
+		if err != nil {
+			return level.ScheduledTick{}, err
+		}
+	} else { // before v1.13, it has a block id
+		id, err := com.GetInt("tileID")
+		if err != nil {
+			return level.ScheduledTick{}, err
+		}
+
+		bl, err := block.FromBedrockID(int(id), 0)
+		if err != nil {
+			return level.ScheduledTick{}, err
+		}
+
+		bs = NewRawBlockState(bl.Name, 0)
+	}
+
+	return level.ScheduledTick{
+		X:      int(values[0]),
+		Y:      int(values[1]),
+		Z:      int(values[2]),
+		Block:  bs,
+		Liquid: block.IsLiquid(bs.Name()),
+	}, nil
+}
+
+// WriteTicks writes ticks with the tag (TagPendingTicks or TagRandomTicks)
+// If there are no ticks, the key is deleted
+func (format *ChunkFormatV100) WriteTicks(db *lvldb.DB, chunk *Chunk, dimension level.Dimension, tag byte, ticks []level.ScheduledTick) error {
+	key := format.getChunkKey(chunk.x, chunk.y, dimension, tag, -1)
+	if len(ticks) == 0 {
+		return db.Delete(key, nil)
+	}
+
+	list := make([]nbt.Tag, 0, len(ticks))
+	for _, tick := range ticks {
+		if tick.Block == nil {
+			continue
+		}
+
+		bs, err := FromRawBlockState(tick.Block)
+		if err != nil {
+			return err
+		}
+
+		list = append(list, nbt.NewCompoundTag("", map[string]nbt.Tag{
+			"blockState": WriteBlockStateTag("blockState", bs),
+			"time":       nbt.NewLongTag("time", chunk.CurrentTick+int64(tick.Delay)),
+			"x":          nbt.NewIntTag("x", int32(tick.X)),
+			"y":          nbt.NewIntTag("y", int32(tick.Y)),
+			"z":          nbt.NewIntTag("z", int32(tick.Z)),
+		}))
+	}
+
+	com := nbt.NewCompoundTag("", map[string]nbt.Tag{
+		"currentTick": nbt.NewIntTag("currentTick", int32(chunk.CurrentTick)),
+		"tickList":    nbt.NewListTag("tickList", list, nbt.IDTagCompound),
+	})
+
+	b, err := format.WriteCompounds([]*nbt.Compound{com})
+	if err != nil {
+		return err
+	}
+
+	return db.Put(key, b, nil)
+}
@@ -0,0 +1,370 @@
+package light
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/asset"
+	"github.com/beito123/level/block"
+	"github.com/beito123/level/heightmap"
+)
+
+const (
+	// MaxLight is the maximum light level
+	MaxLight = 15
+
+	// Height is the height of a chunk which doesn't implement Heighter
+	Height = 256
+
+	// ChunkSize is the number of blocks in a chunk of Height
+	ChunkSize = 16 * 16 * Height
+)
+
+// Heighter is a chunk which has a build height
+// anvil.Chunk and leveldb.Chunk satisfy it
+type Heighter interface {
+	BuildHeight() int
+}
+
+// HeightOf returns the build height of the chunk
+// If the chunk doesn't implement Heighter, returns Height
+func HeightOf(chunk level.Chunk) int {
+	if h, ok := chunk.(Heighter); ok {
+		return h.BuildHeight()
+	}
+
+	return Height
+}
+
+// Provider provides chunks for Engine
+// level.Format satisfies it
+type Provider interface {
+	// HasGeneratedChunk returns whether the chunk is generaged
+	HasGeneratedChunk(x, y int) (bool, error)
+
+	// Chunk returns a chunk.
+	Chunk(x, y int) (level.Chunk, error)
+}
+
+// Storage is a chunk which can store light
+// anvil.Chunk satisfies it
+type Storage interface {
+	// SetBlockLight sets a blocklight at chunk coordinate
+	SetBlockLight(x, y, z int, light byte) error
+
+	// SetSkyLight sets a skylight at chunk coordinate
+	SetSkyLight(x, y, z int, light byte) error
+}
+
+// BlockInfo returns light emission and light filter of a block
+// edition is the edition of the chunk which has the block
+type BlockInfo func(edition asset.Edition, state level.BlockState) (emit, filter int)
+
+// DefaultBlockInfo returns light information from block metadata of the edition
+// Blocks which aren't lit such as furnace[lit=false] don't emit light
+// Unknown blocks are treated as opaque blocks
+func DefaultBlockInfo(edition asset.Edition, state level.BlockState) (emit, filter int) {
+	if state == nil { // air
+		return 0, 0
+	}
+
+	bl, ok := block.LookupEdition(edition, state.Name())
+	if !ok {
+		return 0, MaxLight
+	}
+
+	if !isLit(edition, state) {
+		return 0, bl.FilterLight
+	}
+
+	return bl.EmitLight, bl.FilterLight
+}
+
+// isLit returns whether the block is lit
+// Blocks of mcje have lit property, and mcbe has lit blocks as other names such as lit_furnace
+func isLit(edition asset.Edition, state level.BlockState) bool {
+	if edition != asset.BedrockEdition {
+		_, properties, ok := state.ToBlockNameProperties()
+		if !ok {
+			return true
+		}
+
+		lit, ok := properties["lit"]
+
+		return !ok || lit == "true"
+	}
+
+	name := state.Name()
+	if !strings.Contains(name, ":") {
+		name = block.MinecraftPrefix + name
+	}
+
+	i := strings.Index(name, ":")
+
+	_, ok := block.LookupEdition(edition, name[:i+1]+"lit_"+name[i+1:])
+
+	return !ok
+}
+
+// Coord is a chunk coordinate
+type Coord struct {
+	X int
+	Y int
+}
+
+// Neighbors returns the chunk and 8 chunks around it
+func Neighbors(x, y int) []Coord {
+	result := make([]Coord, 0, 9)
+	for i := -1; i <= 1; i++ {
+		for j := -1; j <= 1; j++ {
+			result = append(result, Coord{X: x + i, Y: y + j})
+		}
+	}
+
+	return result
+}
+
+// ChunkLight is computed light of a chunk
+type ChunkLight struct {
+	X int
+	Y int
+
+	BlockLight []byte
+	SkyLight   []byte
+}
+
+// Height returns the height of the computed light
+func (cl *ChunkLight) Height() int {
+	return len(cl.BlockLight) >> 8
+}
+
+// At returns a index for BlockLight and SkyLight at chunk coordinate
+func (ChunkLight) At(x, y, z int) int {
+	return y<<8 | z<<4 | x
+}
+
+// BlockLightAt returns a blocklight at chunk coordinate
+func (cl *ChunkLight) BlockLightAt(x, y, z int) byte {
+	return cl.BlockLight[cl.At(x, y, z)]
+}
+
+// SkyLightAt returns a skylight at chunk coordinate
+func (cl *ChunkLight) SkyLightAt(x, y, z int) byte {
+	return cl.SkyLight[cl.At(x, y, z)]
+}
+
+// NewEngine returns new Engine
+func NewEngine(provider Provider) *Engine {
+	return &Engine{
+		Provider:  provider,
+		BlockInfo: DefaultBlockInfo,
+		cache:     make(map[infoKey]info),
+		mutex:     new(sync.Mutex),
+	}
+}
+
+type info struct {
+	emit   byte
+	filter byte
+}
+
+// infoKey is a key of cached light information
+type infoKey struct {
+	edition asset.Edition
+	state   string
+}
+
+// stateKey returns a string which identifies the block state with properties
+func stateKey(state level.BlockState) string {
+	if name, properties, ok := state.ToBlockNameProperties(); ok {
+		return block.FormatState(name, properties)
+	}
+
+	if name, meta, ok := state.ToBlockNameMeta(); ok {
+		return name + ":" + strconv.Itoa(meta)
+	}
+
+	return state.Name()
+}
+
+// Engine recomputes sky light and block light
+type Engine struct {
+	Provider  Provider
+	BlockInfo BlockInfo
+
+	cache map[infoKey]info
+	mutex *sync.Mutex
+}
+
+func (e *Engine) info(edition asset.Edition, state level.BlockState) info {
+	if state == nil {
+		return info{}
+	}
+
+	key := infoKey{
+		edition: edition,
+		state:   stateKey(state),
+	}
+
+	in, ok := e.cache[key]
+	if !ok {
+		emit, filter := e.BlockInfo(edition, state)
+		in = info{
+			emit:   byte(clamp(emit)),
+			filter: byte(clamp(filter)),
+		}
+
+		e.cache[key] = in
+	}
+
+	return in
+}
+
+func clamp(v int) int {
+	switch {
+	case v < 0:
+		return 0
+	case v > MaxLight:
+		return MaxLight
+	}
+
+	return v
+}
+
+// Relight recomputes sky light and block light of chunks
+// Neighbor chunks are read too, so light propagates across chunk borders.
+// If you want to propagate changes to neighbor chunks, you need to pass them as well. (see Neighbors())
+// Computed light is written back to chunks which implement Storage.
+func (e *Engine) Relight(coords ...Coord) ([]*ChunkLight, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	w := newWorld()
+
+	for _, c := range coords {
+		for _, n := range Neighbors(c.X, c.Y) {
+			if w.has(n) {
+				continue
+			}
+
+			err := e.load(w, n)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	w.computeSkyLight()
+	w.computeBlockLight()
+
+	var result []*ChunkLight
+	for _, c := range coords {
+		col, ok := w.columns[c]
+		if !ok { // not generated
+			continue
+		}
+
+		cl := &ChunkLight{
+			X:          c.X,
+			Y:          c.Y,
+			BlockLight: col.block,
+			SkyLight:   col.sky,
+		}
+
+		storage, ok := col.chunk.(Storage)
+		if ok {
+			err := writeBack(storage, cl)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		result = append(result, cl)
+	}
+
+	return result, nil
+}
+
+func (e *Engine) load(w *world, c Coord) error {
+	ok, err := e.Provider.HasGeneratedChunk(c.X, c.Y)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		w.columns[c] = nil
+
+		return nil
+	}
+
+	chunk, err := e.Provider.Chunk(c.X, c.Y)
+	if err != nil {
+		return err
+	}
+
+	height := HeightOf(chunk)
+	edition := heightmap.EditionOf(chunk)
+
+	size := 16 * 16 * height
+
+	col := &column{
+		chunk:  chunk,
+		height: height,
+		emit:   make([]byte, size),
+		filter: make([]byte, size),
+		block:  make([]byte, size),
+		sky:    make([]byte, size),
+	}
+
+	for y := 0; y < height; y++ {
+		for z := 0; z < 16; z++ {
+			for x := 0; x < 16; x++ {
+				state, err := chunk.GetBlock(x, y, z)
+				if err != nil {
+					return err
+				}
+
+				in := e.info(edition, state)
+				index := y<<8 | z<<4 | x
+
+				col.emit[index] = in.emit
+				col.filter[index] = in.filter
+			}
+		}
+	}
+
+	w.columns[c] = col
+
+	return nil
+}
+
+func writeBack(storage Storage, cl *ChunkLight) error {
+	for y := 0; y < cl.Height(); y++ {
+		for z := 0; z < 16; z++ {
+			for x := 0; x < 16; x++ {
+				err := storage.SetBlockLight(x, y, z, cl.BlockLightAt(x, y, z))
+				if err != nil {
+					return err
+				}
+
+				err = storage.SetSkyLight(x, y, z, cl.SkyLightAt(x, y, z))
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package light
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"github.com/beito123/level"
+)
+
+// column is a working data for a chunk
+type column struct {
+	chunk  level.Chunk
+	height int
+
+	emit   []byte
+	filter []byte
+	block  []byte
+	sky    []byte
+}
+
+func newWorld() *world {
+	return &world{
+		columns: make(map[Coord]*column),
+	}
+}
+
+// world is a working area over several chunks
+// columns has nil for chunks which aren't generated
+type world struct {
+	columns map[Coord]*column
+}
+
+func (w *world) has(c Coord) bool {
+	_, ok := w.columns[c]
+
+	return ok
+}
+
+// node is a block position in world coordinate
+type node struct {
+	x int
+	y int
+	z int
+}
+
+// at returns a column and a index at world coordinate
+func (w *world) at(x, y, z int) (*column, int, bool) {
+	if y < 0 {
+		return nil, 0, false
+	}
+
+	col := w.columns[Coord{X: x >> 4, Y: z >> 4}]
+	if col == nil || y >= col.height {
+		return nil, 0, false
+	}
+
+	return col, y<<8 | (z&15)<<4 | (x & 15), true
+}
+
+var faces = []node{
+	{x: 1}, {x: -1},
+	{y: 1}, {y: -1},
+	{z: 1}, {z: -1},
+}
+
+// computeSkyLight fills sky light from the top and spreads it
+func (w *world) computeSkyLight() {
+	var queue []node
+
+	for c, col := range w.columns {
+		if col == nil {
+			continue
+		}
+
+		for z := 0; z < 16; z++ {
+			for x := 0; x < 16; x++ {
+				light := MaxLight
+				for y := col.height - 1; y >= 0; y-- {
+					index := y<<8 | z<<4 | x
+
+					light -= int(col.filter[index])
+					if light < 0 {
+						light = 0
+					}
+
+					col.sky[index] = byte(light)
+				}
+			}
+		}
+
+		// Seed blocks which can spread light to the side
+		for y := 0; y < col.height; y++ {
+			for z := 0; z < 16; z++ {
+				for x := 0; x < 16; x++ {
+					light := col.sky[y<<8|z<<4|x]
+					if light <= 1 {
+						continue
+					}
+
+					wx := c.X<<4 | x
+					wz := c.Y<<4 | z
+
+					for _, f := range faces {
+						ncol, index, ok := w.at(wx+f.x, y+f.y, wz+f.z)
+						if ok && ncol.sky[index] < light-1 {
+							queue = append(queue, node{x: wx, y: y, z: wz})
+							break
+						}
+					}
+				}
+			}
+		}
+	}
+
+	w.propagate(queue, func(col *column) []byte {
+		return col.sky
+	})
+}
+
+// computeBlockLight spreads light from light sources
+func (w *world) computeBlockLight() {
+	var queue []node
+
+	for c, col := range w.columns {
+		if col == nil {
+			continue
+		}
+
+		for index, emit := range col.emit {
+			col.block[index] = emit
+			if emit == 0 {
+				continue
+			}
+
+			queue = append(queue, node{
+				x: c.X<<4 | index&15,
+				y: index >> 8,
+				z: c.Y<<4 | (index>>4)&15,
+			})
+		}
+	}
+
+	w.propagate(queue, func(col *column) []byte {
+		return col.block
+	})
+}
+
+// propagate spreads light with breadth first search
+func (w *world) propagate(queue []node, light func(col *column) []byte) {
+	for i := 0; i < len(queue); i++ {
+		n := queue[i]
+
+		col, index, ok := w.at(n.x, n.y, n.z)
+		if !ok {
+			continue
+		}
+
+		value := int(light(col)[index])
+
+		for _, f := range faces {
+			nx, ny, nz := n.x+f.x, n.y+f.y, n.z+f.z
+
+			ncol, nindex, ok := w.at(nx, ny, nz)
+			if !ok {
+				continue
+			}
+
+			filter := int(ncol.filter[nindex])
+			if filter < 1 {
+				filter = 1
+			}
+
+			next := value - filter
+			if next <= int(light(ncol)[nindex]) {
+				continue
+			}
+
+			light(ncol)[nindex] = byte(next)
+
+			queue = append(queue, node{x: nx, y: ny, z: nz})
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package mapitem
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/asset"
+	"github.com/beito123/nbt"
+)
+
+const (
+	// Size is the width and height of maps
+	Size = 128
+
+	// MaxScale is the max scale of maps
+	// A pixel is 2^scale blocks
+	MaxScale = 4
+)
+
+// Java dimension names of maps for mcje v1.16 and after
+var javaDimensions = map[level.Dimension]string{
+	level.OverWorld: "minecraft:overworld",
+	level.Nether:    "minecraft:the_nether",
+	level.TheEnd:    "minecraft:the_end",
+}
+
+// Map is a view of map item data
+// Values are read and written to Compound directly
+// For mcje, Compound is the data compound in map_<id>.dat
+type Map struct {
+	*nbt.Compound
+
+	Edition asset.Edition
+
+	// ID is the id of the map
+	ID int64
+}
+
+// Wrap returns a map view of the compound
+// For mcbe, the id is read from mapId
+func Wrap(com *nbt.Compound, edition asset.Edition, id int64) *Map {
+	m := &Map{
+		Compound: com,
+		Edition:  edition,
+		ID:       id,
+	}
+
+	if edition == asset.BedrockEdition && com.Has("mapId") {
+		m.ID, _ = com.GetLong("mapId")
+	}
+
+	return m
+}
+
+// New returns a new empty map centered at the coordinate
+func New(edition asset.Edition, id int64, scale int, dim level.Dimension, x, z int) *Map {
+	com := nbt.NewCompoundTag("", make(map[string]nbt.Tag))
+
+	m := &Map{
+		Compound: com,
+		Edition:  edition,
+		ID:       id,
+	}
+
+	if edition == asset.BedrockEdition {
+		com.Set(nbt.NewLongTag("mapId", id))
+		com.Set(nbt.NewLongTag("parentMapId", -1))
+		com.Set(nbt.NewShortTag("width", Size))
+		com.Set(nbt.NewShortTag("height", Size))
+		com.Set(nbt.NewByteTag("fullyExplored", 0))
+		com.Set(nbt.NewByteTag("unlimitedTracking", 0))
+		com.Set(nbt.NewListTag("decorations", nil, nbt.IDTagCompound))
+		com.Set(nbt.NewByteArrayTag("colors", make([]byte, Size*Size*4)))
+	} else {
+		com.Set(nbt.NewByteTag("trackingPosition", 1))
+		com.Set(nbt.NewByteTag("unlimitedTracking", 0))
+		com.Set(nbt.NewListTag("banners", nil, nbt.IDTagCompound))
+		com.Set(nbt.NewListTag("frames", nil, nbt.IDTagCompound))
+		com.Set(nbt.NewByteArrayTag("colors", make([]byte, Size*Size)))
+	}
+
+	m.SetScale(scale)
+	m.SetDimension(dim)
+	m.SetCenter(x, z)
+	m.SetLocked(false)
+
+	return m
+}
+
+func (m *Map) getInt(name string) int {
+	tag, ok := m.Get(name)
+	if !ok {
+		return 0
+	}
+
+	val, err := tag.ToInt()
+	if err != nil {
+		return 0
+	}
+
+	return val
+}
+
+// Scale returns the scale of the map
+func (m *Map) Scale() int {
+	return m.getInt("scale")
+}
+
+// SetScale sets the scale of the map
+func (m *Map) SetScale(scale int) {
+	m.Set(nbt.NewByteTag("scale", int8(scale)))
+}
+
+// Center returns the center coordinate of the map
+func (m *Map) Center() (x, z int) {
+	return m.getInt("xCenter"), m.getInt("zCenter")
+}
+
+// SetCenter sets the center coordinate of the map
+func (m *Map) SetCenter(x, z int) {
+	m.Set(nbt.NewIntTag("xCenter", int32(x)))
+	m.Set(nbt.NewIntTag("zCenter", int32(z)))
+}
+
+// Dimension returns the dimension of the map
+func (m *Map) Dimension() level.Dimension {
+	tag, ok := m.Get("dimension")
+	if !ok {
+		return level.OverWorld
+	}
+
+	if tag.ID() == nbt.IDTagString {
+		name, _ := tag.ToString()
+
+		for dim, n := range javaDimensions {
+			if n == name {
+				return dim
+			}
+		}
+
+		return level.Unknown
+	}
+
+	val, err := tag.ToInt()
+	if err != nil {
+		return level.Unknown
+	}
+
+	if m.Edition == asset.JavaEdition {
+		// mcje v1.15 and before: -1 is the nether, 1 is the end
+		switch val {
+		case -1:
+			return level.Nether
+		case 1:
+			return level.TheEnd
+		}
+	}
+
+	return level.Dimension(val)
+}
+
+// SetDimension sets the dimension of the map
+func (m *Map) SetDimension(dim level.Dimension) {
+	if m.Edition == asset.BedrockEdition {
+		m.Set(nbt.NewByteTag("dimension", int8(dim)))
+
+		return
+	}
+
+	m.Set(nbt.NewStringTag("dimension", javaDimensions[dim]))
+}
+
+// Locked returns whether the map is locked
+func (m *Map) Locked() bool {
+	if m.Edition == asset.BedrockEdition {
+		return m.getInt("mapLocked") != 0
+	}
+
+	return m.getInt("locked") != 0
+}
+
+// SetLocked sets whether the map is locked
+func (m *Map) SetLocked(locked bool) {
+	var val int8
+	if locked {
+		val = 1
+	}
+
+	if m.Edition == asset.BedrockEdition {
+		m.Set(nbt.NewByteTag("mapLocked", val))
+
+		return
+	}
+
+	m.Set(nbt.NewByteTag("locked", val))
+}
+
+// Bounds returns the area of blocks covered by the map
+func (m *Map) Bounds() image.Rectangle {
+	x, z := m.Center()
+	size := Size << uint(m.Scale())
+
+	return image.Rect(x-size/2, z-size/2, x+size/2, z+size/2)
+}
+
+// Colors returns raw colors of the map
+// They are RGBA for mcbe, color indexes for mcje
+func (m *Map) Colors() ([]byte, error) {
+	colors, err := m.GetByteArray("colors")
+	if err != nil {
+		return nil, err
+	}
+
+	size := Size * Size
+	if m.Edition == asset.BedrockEdition {
+		size *= 4
+	}
+
+	if len(colors) != size {
+		return nil, errors.New("level.mapitem: invaild colors size")
+	}
+
+	return colors, nil
+}
+
+// Image returns an image of the map
+func (m *Map) Image() (image.Image, error) {
+	colors, err := m.Colors()
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Edition == asset.BedrockEdition {
+		img := image.NewNRGBA(image.Rect(0, 0, Size, Size))
+		copy(img.Pix, colors)
+
+		return img, nil
+	}
+
+	img := image.NewPaletted(image.Rect(0, 0, Size, Size), javaPalette)
+	for i, c := range colors {
+		if int(c) >= len(javaPalette) {
+			c = 0
+		}
+
+		img.Pix[i] = c
+	}
+
+	return img, nil
+}
+
+// SetImage sets colors of the map from an image
+// The image is scaled to fit the map by nearest neighbor
+// For mcje, colors are the nearest color on the map palette
+func (m *Map) SetImage(src image.Image) {
+	img := image.NewNRGBA(image.Rect(0, 0, Size, Size))
+
+	bounds := src.Bounds()
+	if bounds.Dx() == Size && bounds.Dy() == Size {
+		draw.Draw(img, img.Bounds(), src, bounds.Min, draw.Src)
+	} else if !bounds.Empty() {
+		for y := 0; y < Size; y++ {
+			for x := 0; x < Size; x++ {
+				sx := bounds.Min.X + x*bounds.Dx()/Size
+				sy := bounds.Min.Y + y*bounds.Dy()/Size
+
+				img.Set(x, y, src.At(sx, sy))
+			}
+		}
+	}
+
+	if m.Edition == asset.BedrockEdition {
+		m.Set(nbt.NewByteArrayTag("colors", img.Pix))
+
+		return
+	}
+
+	colors := make([]byte, Size*Size)
+	for i := range colors {
+		c := color.NRGBA{
+			R: img.Pix[i*4],
+			G: img.Pix[i*4+1],
+			B: img.Pix[i*4+2],
+			A: img.Pix[i*4+3],
+		}
+
+		colors[i] = NearestJavaColor(c)
+	}
+
+	m.Set(nbt.NewByteArrayTag("colors", colors))
+}
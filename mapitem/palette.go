@@ -0,0 +1,127 @@
+package mapitem
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"image/color"
+)
+
+// JavaBaseColors is base colors of maps for mcje v1.17
+// A color index on maps is base*4 + shade
+var JavaBaseColors = []color.RGBA{
+	{0, 0, 0, 0},         // none
+	{127, 178, 56, 255},  // grass
+	{247, 233, 163, 255}, // sand
+	{199, 199, 199, 255}, // wool
+	{255, 0, 0, 255},     // fire
+	{160, 160, 255, 255}, // ice
+	{167, 167, 167, 255}, // metal
+	{0, 124, 0, 255},     // plant
+	{255, 255, 255, 255}, // snow
+	{164, 168, 184, 255}, // clay
+	{151, 109, 77, 255},  // dirt
+	{112, 112, 112, 255}, // stone
+	{64, 64, 255, 255},   // water
+	{143, 119, 72, 255},  // wood
+	{255, 252, 245, 255}, // quartz
+	{216, 127, 51, 255},  // orange
+	{178, 76, 216, 255},  // magenta
+	{102, 153, 216, 255}, // light blue
+	{229, 229, 51, 255},  // yellow
+	{127, 204, 25, 255},  // lime
+	{242, 127, 165, 255}, // pink
+	{76, 76, 76, 255},    // gray
+	{153, 153, 153, 255}, // light gray
+	{76, 127, 153, 255},  // cyan
+	{127, 63, 178, 255},  // purple
+	{51, 76, 178, 255},   // blue
+	{102, 76, 51, 255},   // brown
+	{102, 127, 51, 255},  // green
+	{153, 51, 51, 255},   // red
+	{25, 25, 25, 255},    // black
+	{250, 238, 77, 255},  // gold
+	{92, 219, 213, 255},  // diamond
+	{74, 128, 255, 255},  // lapis
+	{0, 217, 58, 255},    // emerald
+	{129, 86, 49, 255},   // podzol
+	{112, 2, 0, 255},     // nether
+	{209, 177, 161, 255}, // white terracotta
+	{159, 82, 36, 255},   // orange terracotta
+	{149, 87, 108, 255},  // magenta terracotta
+	{112, 108, 138, 255}, // light blue terracotta
+	{186, 133, 36, 255},  // yellow terracotta
+	{103, 117, 53, 255},  // lime terracotta
+	{160, 77, 78, 255},   // pink terracotta
+	{57, 41, 35, 255},    // gray terracotta
+	{135, 107, 98, 255},  // light gray terracotta
+	{87, 92, 92, 255},    // cyan terracotta
+	{122, 73, 88, 255},   // purple terracotta
+	{76, 62, 92, 255},    // blue terracotta
+	{76, 50, 35, 255},    // brown terracotta
+	{76, 82, 42, 255},    // green terracotta
+	{142, 60, 46, 255},   // red terracotta
+	{37, 22, 16, 255},    // black terracotta
+	{189, 48, 49, 255},   // crimson nylium
+	{148, 63, 97, 255},   // crimson stem
+	{92, 25, 29, 255},    // crimson hyphae
+	{22, 126, 134, 255},  // warped nylium
+	{58, 142, 140, 255},  // warped stem
+	{86, 44, 62, 255},    // warped hyphae
+	{20, 180, 133, 255},  // warped wart block
+	{100, 100, 100, 255}, // deepslate
+	{216, 175, 147, 255}, // raw iron
+	{127, 167, 150, 255}, // glow lichen
+}
+
+// JavaShades is multipliers of shades (x/255)
+var JavaShades = [4]int{180, 220, 255, 135}
+
+// JavaColor returns a color from a color index of mcje maps
+// Unknown indexes are transparent
+func JavaColor(index byte) color.RGBA {
+	base := int(index) / 4
+	if base == 0 || base >= len(JavaBaseColors) {
+		return color.RGBA{}
+	}
+
+	c := JavaBaseColors[base]
+	shade := JavaShades[index%4]
+
+	return color.RGBA{
+		R: uint8(int(c.R) * shade / 255),
+		G: uint8(int(c.G) * shade / 255),
+		B: uint8(int(c.B) * shade / 255),
+		A: 255,
+	}
+}
+
+// JavaPalette returns a palette of all color indexes for mcje maps
+func JavaPalette() color.Palette {
+	palette := make(color.Palette, len(JavaBaseColors)*4)
+	for i := range palette {
+		palette[i] = JavaColor(byte(i))
+	}
+
+	return palette
+}
+
+var javaPalette = JavaPalette()
+
+// NearestJavaColor returns a color index nearest the color
+// Transparent colors are returned as 0
+func NearestJavaColor(c color.Color) byte {
+	_, _, _, a := c.RGBA()
+	if a < 0x8000 {
+		return 0
+	}
+
+	// skip indexes of transparent colors
+	return byte(javaPalette[4:].Index(c) + 4)
+}
@@ -0,0 +1,86 @@
+package level
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+)
+
+// Opener is a level format which can be detected and opened by Open
+// Formats in this module are registered by importing the packages (anvil and leveldb)
+type Opener struct {
+	// Name is a name of the format such as "anvil"
+	Name string
+
+	// Detect returns whether the directory is a level of the format
+	Detect func(path string) bool
+
+	// Open opens a level of the format
+	Open func(path string) (Format, error)
+}
+
+var (
+	openers     []*Opener
+	openerMutex = new(sync.RWMutex)
+)
+
+// RegisterFormat registers a level format for Open
+// If the same name is already registered, it's replaced
+// Formats are detected in order of registration
+func RegisterFormat(opener *Opener) {
+	openerMutex.Lock()
+	defer openerMutex.Unlock()
+
+	for i, op := range openers {
+		if op.Name == opener.Name {
+			openers[i] = opener
+			return
+		}
+	}
+
+	openers = append(openers, opener)
+}
+
+// Formats returns registered level formats
+func Formats() []*Opener {
+	openerMutex.RLock()
+	defer openerMutex.RUnlock()
+
+	result := make([]*Opener, len(openers))
+	copy(result, openers)
+
+	return result
+}
+
+// Detect returns a level format of the directory
+// If the format isn't found, returns false for ok
+func Detect(path string) (opener *Opener, ok bool) {
+	path = filepath.Clean(path)
+
+	for _, op := range Formats() {
+		if op.Detect(path) {
+			return op, true
+		}
+	}
+
+	return nil, false
+}
+
+// Open detects the format of the level and opens it
+func Open(path string) (Format, error) {
+	opener, ok := Detect(path)
+	if !ok {
+		return nil, fmt.Errorf("level: unknown format of %s", path)
+	}
+
+	return opener.Open(filepath.Clean(path))
+}
@@ -0,0 +1,202 @@
+package render
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/block"
+	"github.com/beito123/level/heightmap"
+)
+
+// Brightness of faces for ModeIsometric
+const (
+	isoLeftLight  = 204
+	isoRightLight = 166
+)
+
+// isoSize returns the width of a half of the top face of a block in pixels
+func (r *Renderer) isoSize() int {
+	if r.Scale < 2 {
+		return 2
+	}
+
+	return r.Scale
+}
+
+// isoPos returns the pixel coordinate of the top corner of the block at world coordinate
+func (r *Renderer) isoPos(x, y, z int) (int, int) {
+	s := r.isoSize()
+
+	return (x - z) * s, (x+z)*s/2 - y*s
+}
+
+// IsometricBounds returns bounds of the image for chunks from x0, y0 to x1, y1 (exclusive) in ModeIsometric
+func (r *Renderer) IsometricBounds(x0, y0, x1, y1 int) image.Rectangle {
+	s := r.isoSize()
+
+	minX, _ := r.isoPos(x0*ChunkSize, 0, y1*ChunkSize)
+	maxX, _ := r.isoPos(x1*ChunkSize, 0, y0*ChunkSize)
+	_, minY := r.isoPos(x0*ChunkSize, heightmap.Height, y0*ChunkSize)
+	_, maxY := r.isoPos(x1*ChunkSize, 0, y1*ChunkSize)
+
+	return image.Rect(minX-s, minY, maxX+s, maxY+s*2)
+}
+
+// average returns the average color of the texture of the face of the block
+func (r *Renderer) average(state level.BlockState, face Face) (color.RGBA, Tint, bool) {
+	t := r.faceTile(state, face)
+	if !t.ok {
+		return color.RGBA{}, TintNone, false
+	}
+
+	avg := resize(t.img, 1).RGBAAt(0, 0)
+
+	return avg, t.tint, avg.A != 0
+}
+
+// exposed returns whether the block at chunk coordinate can be seen from the view
+// Blocks are seen from above, +x and +z
+func exposed(chunk level.Chunk, x, y, z int) (bool, error) {
+	neighbors := [][3]int{{x, y + 1, z}, {x + 1, y, z}, {x, y, z + 1}}
+	for _, n := range neighbors {
+		if n[0] >= ChunkSize || n[2] >= ChunkSize || n[1] >= heightmap.Height {
+			return true, nil
+		}
+
+		state, err := chunk.GetBlock(n[0], n[1], n[2])
+		if err != nil {
+			return false, err
+		}
+
+		if state == nil || block.IsAir(state.Name()) || block.IsWater(state.Name()) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// renderIsometric renders chunks from x0, y0 to x1, y1 (exclusive) as cubes
+// Chunks are drawn from back to front
+func (r *Renderer) renderIsometric(x0, y0, x1, y1 int) (*image.RGBA, error) {
+	bounds := r.IsometricBounds(x0, y0, x1, y1)
+	img := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+
+	for d := x0 + y0; d <= x1+y1-2; d++ {
+		for cx := x0; cx < x1; cx++ {
+			cy := d - cx
+			if cy < y0 || cy >= y1 {
+				continue
+			}
+
+			_, err := r.withChunk(cx, cy, func(chunk level.Chunk) error {
+				return r.drawIsometricChunk(img, chunk, cx, cy, bounds.Min)
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return img, nil
+}
+
+// drawIsometricChunk draws blocks of the chunk
+// origin is the pixel coordinate of the image at the top left
+func (r *Renderer) drawIsometricChunk(dst *image.RGBA, chunk level.Chunk, cx, cy int, origin image.Point) error {
+	for d := 0; d <= (ChunkSize-1)*2; d++ {
+		for x := 0; x < ChunkSize; x++ {
+			z := d - x
+			if z < 0 || z >= ChunkSize {
+				continue
+			}
+
+			colors := r.Biomes(chunk.Biome(x, z))
+
+			for y := 0; y <= top(chunk, x, z); y++ {
+				state, err := chunk.GetBlock(x, y, z)
+				if err != nil {
+					return err
+				}
+
+				if state == nil || block.IsAir(state.Name()) {
+					continue
+				}
+
+				ok, err := exposed(chunk, x, y, z)
+				if err != nil {
+					return err
+				}
+
+				if !ok {
+					continue
+				}
+
+				c, tint, ok := r.average(state, FaceUp)
+				if !ok {
+					continue
+				}
+
+				c = multiply(c, colors.Color(tint))
+				c.A = 255
+
+				side, sideTint, ok := r.average(state, FaceSide)
+				if ok {
+					side = multiply(side, colors.Color(sideTint))
+					side.A = 255
+				} else {
+					side = c
+				}
+
+				px, py := r.isoPos(cx*ChunkSize+x, y, cy*ChunkSize+z)
+				r.drawCube(dst, px-origin.X, py-origin.Y, c, side)
+			}
+		}
+	}
+
+	return nil
+}
+
+// drawCube draws a cube with the top corner at the pixel coordinate
+// c is the color of the top face, and side is the color of side faces
+func (r *Renderer) drawCube(dst *image.RGBA, px, py int, c, side color.RGBA) {
+	s := r.isoSize()
+
+	left := multiply(side, color.RGBA{isoLeftLight, isoLeftLight, isoLeftLight, 255})
+	right := multiply(side, color.RGBA{isoRightLight, isoRightLight, isoRightLight, 255})
+
+	for dx := -s; dx < s; dx++ {
+		// the width of the top face at dx
+		w := dx + s
+		if dx >= 0 {
+			w = s - dx - 1
+		}
+
+		h := w/2 + 1
+
+		// top face is a diamond
+		for dy := s/2 - h; dy < s/2+h; dy++ {
+			dst.SetRGBA(px+dx, py+dy, c)
+		}
+
+		// side faces below the diamond
+		face := left
+		if dx >= 0 {
+			face = right
+		}
+
+		for dy := s/2 + h; dy < s/2+h+s; dy++ {
+			dst.SetRGBA(px+dx, py+dy, face)
+		}
+	}
+}
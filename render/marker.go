@@ -0,0 +1,446 @@
+package render
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/draw"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/blockentity"
+	"github.com/beito123/level/entity"
+	"github.com/beito123/level/heightmap"
+	"github.com/beito123/nbt"
+)
+
+// MarkerKind is a kind of markers
+type MarkerKind int
+
+const (
+	// MarkerEntity is entities
+	MarkerEntity MarkerKind = iota
+
+	// MarkerPlayer is players
+	MarkerPlayer
+
+	// MarkerSpawn is the default spawn of the level
+	MarkerSpawn
+
+	// MarkerSign is signs with the text
+	MarkerSign
+
+	// MarkerBeacon is beacons
+	MarkerBeacon
+)
+
+// Name returns the name of the kind
+func (kind MarkerKind) Name() string {
+	switch kind {
+	case MarkerEntity:
+		return "entity"
+	case MarkerPlayer:
+		return "player"
+	case MarkerSpawn:
+		return "spawn"
+	case MarkerSign:
+		return "sign"
+	case MarkerBeacon:
+		return "beacon"
+	}
+
+	return "unknown"
+}
+
+// Color returns the color of the kind for markers without icons
+func (kind MarkerKind) Color() color.RGBA {
+	switch kind {
+	case MarkerEntity:
+		return color.RGBA{255, 214, 0, 255}
+	case MarkerPlayer:
+		return color.RGBA{0, 224, 255, 255}
+	case MarkerSpawn:
+		return color.RGBA{255, 32, 32, 255}
+	case MarkerSign:
+		return color.RGBA{240, 220, 170, 255}
+	case MarkerBeacon:
+		return color.RGBA{130, 255, 240, 255}
+	}
+
+	return color.RGBA{255, 255, 255, 255}
+}
+
+// Marker is a point of interest on maps
+type Marker struct {
+	Kind MarkerKind
+
+	// ID is the identifier of the entity, the id of the block entity or the key of the player
+	ID string
+
+	// Label is a text such as the custom name or the text of signs
+	Label string
+
+	// X, Y and Z is world coordinate
+	X float64
+	Y float64
+	Z float64
+}
+
+// PlayerStorage is a level format which has data of players
+type PlayerStorage interface {
+	// Players returns nbt data of all players by key
+	Players() (map[string]*nbt.Compound, error)
+}
+
+// dimensionOf returns the dimension of the player
+// Java has Dimension (int or string), and Bedrock has DimensionId
+func dimensionOf(com *nbt.Compound) level.Dimension {
+	if com.Has("DimensionId") {
+		id, err := com.GetInt("DimensionId")
+		if err != nil {
+			return level.Unknown
+		}
+
+		switch id {
+		case 0:
+			return level.OverWorld
+		case 1:
+			return level.Nether
+		case 2:
+			return level.TheEnd
+		}
+
+		return level.Unknown
+	}
+
+	tag, ok := com.Get("Dimension")
+	if !ok {
+		return level.OverWorld
+	}
+
+	if name, err := tag.ToString(); err == nil && strings.Contains(name, ":") {
+		switch name {
+		case "minecraft:overworld":
+			return level.OverWorld
+		case "minecraft:the_nether":
+			return level.Nether
+		case "minecraft:the_end":
+			return level.TheEnd
+		}
+
+		return level.Unknown
+	}
+
+	id, err := tag.ToInt()
+	if err != nil {
+		return level.Unknown
+	}
+
+	switch id {
+	case 0:
+		return level.OverWorld
+	case -1:
+		return level.Nether
+	case 1:
+		return level.TheEnd
+	}
+
+	return level.Unknown
+}
+
+// inChunks returns whether the coordinate is in chunks from x0, y0 to x1, y1 (exclusive)
+func inChunks(x, z float64, x0, y0, x1, y1 int) bool {
+	cx := int(math.Floor(x)) >> 4
+	cz := int(math.Floor(z)) >> 4
+
+	return cx >= x0 && cx < x1 && cz >= y0 && cz < y1
+}
+
+// ChunkMarkers returns markers of entities, signs and beacons in the chunk
+func ChunkMarkers(chunk level.Chunk) []Marker {
+	var markers []Marker
+
+	for _, com := range chunk.Entities() {
+		en := entity.Wrap(com)
+
+		x, y, z, ok := en.Pos()
+		if !ok {
+			continue
+		}
+
+		markers = append(markers, Marker{
+			Kind:  MarkerEntity,
+			ID:    en.Identifier(),
+			Label: blockentity.PlainText(en.CustomName()),
+			X:     x,
+			Y:     y,
+			Z:     z,
+		})
+	}
+
+	edition := heightmap.EditionOf(chunk)
+
+	for _, com := range chunk.BlockEntities() {
+		pos, ok := blockentity.PosOf(com)
+		if !ok {
+			continue
+		}
+
+		marker := Marker{
+			ID: blockentity.ID(com),
+			X:  float64(pos.X) + 0.5,
+			Y:  float64(pos.Y),
+			Z:  float64(pos.Z) + 0.5,
+		}
+
+		switch blockentity.KindOf(com) {
+		case blockentity.KindSign:
+			sign, _ := blockentity.AsSign(com, edition)
+			lines := sign.Lines()
+
+			marker.Kind = MarkerSign
+			marker.Label = strings.TrimSpace(strings.Join(lines[:], "\n"))
+		case blockentity.KindBeacon:
+			marker.Kind = MarkerBeacon
+		default:
+			continue
+		}
+
+		markers = append(markers, marker)
+	}
+
+	return markers
+}
+
+// CollectMarkers returns markers in chunks from x0, y0 to x1, y1 (exclusive) at chunk coordinate
+// Players are collected if the format implements PlayerStorage
+// Chunks which aren't generated are skipped
+func (r *Renderer) CollectMarkers(x0, y0, x1, y1 int) ([]Marker, error) {
+	var markers []Marker
+
+	if r.Format.Dimension() == level.OverWorld {
+		x, y, z := r.Format.Spawn()
+		if inChunks(float64(x), float64(z), x0, y0, x1, y1) {
+			markers = append(markers, Marker{
+				Kind:  MarkerSpawn,
+				ID:    "spawn",
+				Label: "Spawn",
+				X:     float64(x) + 0.5,
+				Y:     float64(y),
+				Z:     float64(z) + 0.5,
+			})
+		}
+	}
+
+	if storage, ok := r.Format.(PlayerStorage); ok {
+		players, err := storage.Players()
+		if err != nil {
+			return nil, err
+		}
+
+		keys := make([]string, 0, len(players))
+		for key := range players {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			com := players[key]
+			if dimensionOf(com) != r.Format.Dimension() {
+				continue
+			}
+
+			x, y, z, ok := entity.Wrap(com).Pos()
+			if !ok || !inChunks(x, z, x0, y0, x1, y1) {
+				continue
+			}
+
+			markers = append(markers, Marker{
+				Kind:  MarkerPlayer,
+				ID:    key,
+				Label: key,
+				X:     x,
+				Y:     y,
+				Z:     z,
+			})
+		}
+	}
+
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			_, err := r.withChunk(x, y, func(chunk level.Chunk) error {
+				markers = append(markers, ChunkMarkers(chunk)...)
+
+				return nil
+			})
+
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return markers, nil
+}
+
+// MarkerIcons returns an icon of the marker
+// If the marker doesn't have an icon, returns false for ok
+type MarkerIcons func(marker Marker) (icon image.Image, ok bool)
+
+// markerPos returns the pixel coordinate of the marker in the image of chunks from x0, y0 to x1, y1
+func (r *Renderer) markerPos(marker Marker, x0, y0, x1, y1 int) image.Point {
+	if r.Mode == ModeIsometric {
+		origin := r.IsometricBounds(x0, y0, x1, y1).Min
+		px, py := r.isoPos(int(math.Floor(marker.X)), int(math.Floor(marker.Y)), int(math.Floor(marker.Z)))
+
+		// the center of the top face
+		return image.Pt(px-origin.X, py-origin.Y+r.isoSize()/2)
+	}
+
+	return image.Pt(
+		int((marker.X-float64(x0*ChunkSize))*float64(r.Scale)),
+		int((marker.Z-float64(y0*ChunkSize))*float64(r.Scale)),
+	)
+}
+
+// DrawMarkers draws markers over the image rendered by Render for chunks from x0, y0 to x1, y1
+// Icons are drawn at the center of markers, and markers without icons are drawn as dots
+// icons can be nil
+func (r *Renderer) DrawMarkers(dst *image.RGBA, markers []Marker, x0, y0, x1, y1 int, icons MarkerIcons) {
+	radius := r.Scale / 4
+	if radius < 2 {
+		radius = 2
+	}
+
+	for _, marker := range markers {
+		p := r.markerPos(marker, x0, y0, x1, y1)
+
+		if icons != nil {
+			icon, ok := icons(marker)
+			if ok {
+				size := icon.Bounds().Size()
+				rect := image.Rectangle{Min: p.Sub(size.Div(2)), Max: p.Sub(size.Div(2)).Add(size)}
+				draw.Draw(dst, rect, icon, icon.Bounds().Min, draw.Over)
+
+				continue
+			}
+		}
+
+		c := marker.Kind.Color()
+		outline := color.RGBA{0, 0, 0, 255}
+
+		for dy := -radius - 1; dy <= radius+1; dy++ {
+			for dx := -radius - 1; dx <= radius+1; dx++ {
+				d := dx*dx + dy*dy
+				switch {
+				case d <= radius*radius:
+					dst.SetRGBA(p.X+dx, p.Y+dy, c)
+				case d <= (radius+1)*(radius+1):
+					dst.SetRGBA(p.X+dx, p.Y+dy, outline)
+				}
+			}
+		}
+	}
+}
+
+// Icon returns the texture of the id of the marker as an icon
+// It can be used as MarkerIcons
+func (tm *TextureManager) Icon(marker Marker) (image.Image, bool) {
+	if !tm.HasTexture(marker.ID) {
+		return nil, false
+	}
+
+	img, err := tm.GetTexture(marker.ID)
+	if err != nil {
+		return nil, false
+	}
+
+	return img, true
+}
+
+// geoJSON types for markers
+type (
+	geoFeatureCollection struct {
+		Type     string       `json:"type"`
+		Features []geoFeature `json:"features"`
+	}
+
+	geoFeature struct {
+		Type       string                 `json:"type"`
+		Geometry   geoPoint               `json:"geometry"`
+		Properties map[string]interface{} `json:"properties"`
+	}
+
+	geoPoint struct {
+		Type        string     `json:"type"`
+		Coordinates [2]float64 `json:"coordinates"`
+	}
+)
+
+// WriteGeoJSON writes markers as a GeoJSON FeatureCollection of points
+// Coordinates are [x, z] of world coordinate (not longitude and latitude)
+// Properties have kind, id, label and y
+func WriteGeoJSON(w io.Writer, markers []Marker) error {
+	collection := geoFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: make([]geoFeature, 0, len(markers)),
+	}
+
+	for _, marker := range markers {
+		collection.Features = append(collection.Features, geoFeature{
+			Type: "Feature",
+			Geometry: geoPoint{
+				Type:        "Point",
+				Coordinates: [2]float64{marker.X, marker.Z},
+			},
+			Properties: map[string]interface{}{
+				"kind":  marker.Kind.Name(),
+				"id":    marker.ID,
+				"label": marker.Label,
+				"y":     marker.Y,
+			},
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "\t")
+
+	return encoder.Encode(collection)
+}
+
+// WriteMarkers writes markers to TileMarkersFile for the web viewer
+func (tm *TileMap) WriteMarkers(markers []Marker) error {
+	err := os.MkdirAll(tm.Dir, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(filepath.Join(tm.Dir, TileMarkersFile))
+	if err != nil {
+		return err
+	}
+
+	err = WriteGeoJSON(file, markers)
+	if err != nil {
+		file.Close()
+
+		return err
+	}
+
+	return file.Close()
+}
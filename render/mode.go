@@ -0,0 +1,132 @@
+package render
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"github.com/beito123/level"
+	"github.com/beito123/level/block"
+	"github.com/beito123/level/heightmap"
+)
+
+// Mode is a kind of rendering
+type Mode int
+
+const (
+	// ModeTopDown renders top surfaces from above
+	ModeTopDown Mode = iota
+
+	// ModeCave renders the highest floor of caves below the surface
+	// Floors are darker as lower
+	ModeCave
+
+	// ModeSlice renders top surfaces below SliceY from above
+	ModeSlice
+
+	// ModeIsometric renders blocks as cubes from a diagonal view
+	ModeIsometric
+
+	// ModeBiome renders colors of biomes from above
+	ModeBiome
+)
+
+// Name returns the name of the mode
+func (mode Mode) Name() string {
+	switch mode {
+	case ModeTopDown:
+		return "topdown"
+	case ModeCave:
+		return "cave"
+	case ModeSlice:
+		return "slice"
+	case ModeIsometric:
+		return "isometric"
+	case ModeBiome:
+		return "biome"
+	}
+
+	return "unknown"
+}
+
+// ModeByName returns a mode by the name
+func ModeByName(name string) (Mode, bool) {
+	for _, mode := range []Mode{ModeTopDown, ModeCave, ModeSlice, ModeIsometric, ModeBiome} {
+		if mode.Name() == name {
+			return mode, true
+		}
+	}
+
+	return ModeTopDown, false
+}
+
+// top returns the highest y coordinate of the column by the heightmap
+func top(chunk level.Chunk, x, z int) int {
+	height, ok := chunk.Height(x, z, level.WorldSurface)
+	if !ok {
+		return heightmap.Height - 1
+	}
+
+	return int(height) - 1
+}
+
+// startY returns the y coordinate where scanning of the column starts for the mode
+// If the column has nothing to render, returns false for ok
+func (r *Renderer) startY(chunk level.Chunk, x, z int) (int, bool, error) {
+	switch r.Mode {
+	case ModeSlice:
+		y := top(chunk, x, z)
+		if r.SliceY < y {
+			y = r.SliceY
+		}
+
+		return y, y >= 0, nil
+	case ModeCave:
+		return caveY(chunk, x, z)
+	}
+
+	return top(chunk, x, z), true, nil
+}
+
+// caveY returns the y coordinate of the highest air below the surface
+// If the column doesn't have caves, returns false for ok
+func caveY(chunk level.Chunk, x, z int) (int, bool, error) {
+	surface := false
+	for y := top(chunk, x, z); y >= 0; y-- {
+		state, err := chunk.GetBlock(x, y, z)
+		if err != nil {
+			return 0, false, err
+		}
+
+		if !surface {
+			surface = heightmap.BlocksMotion(heightmap.EditionOf(chunk), state)
+
+			continue
+		}
+
+		if state == nil || block.IsAir(state.Name()) {
+			return y, true, nil
+		}
+	}
+
+	return 0, false, nil
+}
+
+// shade returns a brightness (0-255) of the column for the mode
+func (r *Renderer) shade(col Column) int {
+	if r.Mode != ModeCave {
+		return 255
+	}
+
+	light := 96 + col.Y*159/96
+	if light > 255 {
+		light = 255
+	}
+
+	return light
+}
@@ -0,0 +1,728 @@
+package render
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"image"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/asset"
+)
+
+// Face is a face of blocks
+type Face int
+
+const (
+	// FaceUp is the top face
+	FaceUp Face = iota
+
+	// FaceSide is a side face (north)
+	FaceSide
+
+	// FaceDown is the bottom face
+	FaceDown
+)
+
+// Name returns the name of the face in blocks.json
+func (face Face) Name() string {
+	switch face {
+	case FaceUp:
+		return "up"
+	case FaceSide:
+		return "side"
+	case FaceDown:
+		return "down"
+	}
+
+	return "unknown"
+}
+
+// packMarkers are files which are at the root of resource packs
+var packMarkers = []string{
+	"manifest.json",
+	"pack.mcmeta",
+	"blocks.json",
+	"textures/terrain_texture.json",
+	"assets/minecraft/",
+}
+
+// packFiles is files of a resource pack
+type packFiles interface {
+	// Open opens a file by the slash separated path from the root of the pack
+	Open(name string) (io.ReadCloser, error)
+
+	// Exists returns whether the file exists
+	Exists(name string) bool
+
+	Close() error
+}
+
+// dirFiles is files in a directory
+type dirFiles string
+
+func (dir dirFiles) Open(name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(string(dir), filepath.FromSlash(name)))
+}
+
+func (dir dirFiles) Exists(name string) bool {
+	_, err := os.Stat(filepath.Join(string(dir), filepath.FromSlash(name)))
+
+	return err == nil
+}
+
+func (dir dirFiles) Close() error {
+	return nil
+}
+
+// zipFiles is files in a zip file
+// The root of the pack can be a directory in the zip
+type zipFiles struct {
+	reader *zip.ReadCloser
+	files  map[string]*zip.File
+}
+
+func openZipFiles(path string) (*zipFiles, error) {
+	reader, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// finds the shallowest root
+	root := ""
+	depth := -1
+	for _, file := range reader.File {
+		for _, marker := range packMarkers {
+			if !strings.HasSuffix(file.Name, marker) {
+				continue
+			}
+
+			prefix := strings.TrimSuffix(file.Name, marker)
+			if prefix != "" && !strings.HasSuffix(prefix, "/") {
+				continue
+			}
+
+			d := strings.Count(prefix, "/")
+			if depth < 0 || d < depth {
+				root = prefix
+				depth = d
+			}
+		}
+	}
+
+	files := make(map[string]*zip.File)
+	for _, file := range reader.File {
+		if !strings.HasPrefix(file.Name, root) {
+			continue
+		}
+
+		files[strings.TrimPrefix(file.Name, root)] = file
+	}
+
+	return &zipFiles{
+		reader: reader,
+		files:  files,
+	}, nil
+}
+
+func (zf *zipFiles) Open(name string) (io.ReadCloser, error) {
+	file, ok := zf.files[name]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+
+	return file.Open()
+}
+
+func (zf *zipFiles) Exists(name string) bool {
+	_, ok := zf.files[name]
+
+	return ok
+}
+
+func (zf *zipFiles) Close() error {
+	return zf.reader.Close()
+}
+
+// OpenResourcePack opens a resource pack for mcbe or mcje
+// path is a directory, a zip file or a mcpack file
+func OpenResourcePack(path string) (*ResourcePack, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var files packFiles
+	if info.IsDir() {
+		files = dirFiles(path)
+	} else {
+		files, err = openZipFiles(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pack := &ResourcePack{
+		Path:   path,
+		files:  files,
+		models: make(map[string]*javaModel),
+	}
+
+	switch {
+	case files.Exists("blocks.json") || files.Exists("textures/terrain_texture.json"):
+		pack.Edition = asset.BedrockEdition
+
+		err = pack.loadBedrock()
+	case files.Exists("assets/minecraft/blockstates") || files.Exists("pack.mcmeta"):
+		pack.Edition = asset.JavaEdition
+	default:
+		err = errors.New("level.render: couldn't find resources in the pack")
+	}
+
+	if err != nil {
+		files.Close()
+
+		return nil, err
+	}
+
+	return pack, nil
+}
+
+// ResourcePack is a resource pack for mcbe or mcje
+type ResourcePack struct {
+	Path    string
+	Edition asset.Edition
+
+	// Priority is the priority in TextureManager
+	// Packs which have higher priority are used first
+	Priority int
+
+	files packFiles
+
+	// for mcbe
+	blocks  map[string]map[Face]string // block name -> face -> atlas name
+	terrain map[string][]string        // atlas name -> texture paths
+
+	// for mcje
+	models map[string]*javaModel
+	mutex  sync.Mutex
+}
+
+// Close closes the pack
+func (pack *ResourcePack) Close() error {
+	return pack.files.Close()
+}
+
+// readJSON reads a json file with comments
+func (pack *ResourcePack) readJSON(name string, v interface{}) error {
+	file, err := pack.files.Open(name)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	b, err := ioutil.ReadAll(file)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(stripComments(b), v)
+}
+
+// Image returns the image of the slash separated path in the pack
+// A extension is added if it's omitted
+// Animated textures are cropped to the first frame
+func (pack *ResourcePack) Image(name string) (image.Image, error) {
+	if path.Ext(name) == "" {
+		name += ".png"
+	}
+
+	file, err := pack.files.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := img.Bounds()
+	if bounds.Dy() > bounds.Dx() {
+		sub, ok := img.(interface {
+			SubImage(r image.Rectangle) image.Image
+		})
+
+		if ok {
+			img = sub.SubImage(image.Rect(bounds.Min.X, bounds.Min.Y, bounds.Max.X, bounds.Min.Y+bounds.Dx()))
+		}
+	}
+
+	return img, nil
+}
+
+// TexturePath returns the path of the texture of the face of the block in the pack
+// If the pack doesn't have it, returns false for ok
+func (pack *ResourcePack) TexturePath(state level.BlockState, face Face) (string, bool) {
+	if pack.Edition == asset.BedrockEdition {
+		return pack.bedrockPath(state, face)
+	}
+
+	return pack.javaPath(state, face)
+}
+
+// loadBedrock loads blocks.json and terrain_texture.json
+func (pack *ResourcePack) loadBedrock() error {
+	pack.blocks = make(map[string]map[Face]string)
+	pack.terrain = make(map[string][]string)
+
+	if pack.files.Exists("blocks.json") {
+		var data map[string]json.RawMessage
+
+		err := pack.readJSON("blocks.json", &data)
+		if err != nil {
+			return err
+		}
+
+		for name, raw := range data {
+			var d struct {
+				Textures json.RawMessage `json:"textures"`
+			}
+
+			// format_version isn't an object
+			if json.Unmarshal(raw, &d) != nil || d.Textures == nil {
+				continue
+			}
+
+			faces := make(map[Face]string)
+
+			var tname string
+			var tfaces map[string]string
+			if json.Unmarshal(d.Textures, &tname) == nil {
+				faces[FaceUp] = tname
+				faces[FaceSide] = tname
+				faces[FaceDown] = tname
+			} else if json.Unmarshal(d.Textures, &tfaces) == nil {
+				for _, face := range []Face{FaceUp, FaceSide, FaceDown} {
+					for _, key := range []string{face.Name(), "north", "south", "east", "west"} {
+						tname, ok := tfaces[key]
+						if ok {
+							faces[face] = tname
+
+							break
+						}
+					}
+				}
+			} else {
+				continue
+			}
+
+			if !strings.Contains(name, ":") {
+				name = "minecraft:" + name
+			}
+
+			pack.blocks[name] = faces
+		}
+	}
+
+	if pack.files.Exists("textures/terrain_texture.json") {
+		var data struct {
+			TextureData map[string]struct {
+				Textures json.RawMessage `json:"textures"`
+			} `json:"texture_data"`
+		}
+
+		err := pack.readJSON("textures/terrain_texture.json", &data)
+		if err != nil {
+			return err
+		}
+
+		for name, d := range data.TextureData {
+			paths := parseTerrainTextures(d.Textures)
+			if len(paths) > 0 {
+				pack.terrain[name] = paths
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseTerrainTextures returns paths of textures in terrain_texture.json
+// "textures" is a path, an object with a path or variations, or an array of them
+func parseTerrainTextures(raw json.RawMessage) []string {
+	var path string
+	if json.Unmarshal(raw, &path) == nil {
+		return []string{path}
+	}
+
+	var list []json.RawMessage
+	if json.Unmarshal(raw, &list) == nil {
+		var paths []string
+		for _, v := range list {
+			p := parseTerrainTextures(v)
+			if len(p) > 0 {
+				paths = append(paths, p[0])
+			}
+		}
+
+		return paths
+	}
+
+	var obj struct {
+		Path       string            `json:"path"`
+		Variations []json.RawMessage `json:"variations"`
+	}
+
+	if json.Unmarshal(raw, &obj) != nil {
+		return nil
+	}
+
+	if obj.Path != "" {
+		return []string{obj.Path}
+	}
+
+	// uses the first variation for random variations
+	for _, v := range obj.Variations {
+		p := parseTerrainTextures(v)
+		if len(p) > 0 {
+			return p[:1]
+		}
+	}
+
+	return nil
+}
+
+func (pack *ResourcePack) bedrockPath(state level.BlockState, face Face) (string, bool) {
+	faces, ok := pack.blocks[state.Name()]
+	if !ok {
+		return "", false
+	}
+
+	tname, ok := faces[face]
+	if !ok {
+		return "", false
+	}
+
+	paths, ok := pack.terrain[tname]
+	if !ok {
+		// old packs have paths in textures/blocks directly
+		return "textures/blocks/" + tname, true
+	}
+
+	// variations by the data value such as wool
+	index := 0
+	_, meta, ok := state.ToBlockNameMeta()
+	if ok && meta >= 0 && meta < len(paths) {
+		index = meta
+	}
+
+	return paths[index], true
+}
+
+// javaModel is a block model for mcje
+type javaModel struct {
+	Parent   string            `json:"parent"`
+	Textures map[string]string `json:"textures"`
+	Elements []struct {
+		Faces map[string]struct {
+			Texture string `json:"texture"`
+		} `json:"faces"`
+	} `json:"elements"`
+}
+
+// javaVariant is a variant in blockstates
+type javaVariant struct {
+	Model string `json:"model"`
+}
+
+// javaBlockState is a blockstates file
+type javaBlockState struct {
+	Variants  map[string]json.RawMessage `json:"variants"`
+	Multipart []struct {
+		When  json.RawMessage `json:"when"`
+		Apply json.RawMessage `json:"apply"`
+	} `json:"multipart"`
+}
+
+// resourcePath returns the path of the resource location such as minecraft:block/stone
+func resourcePath(location, dir, ext string) string {
+	namespace := "minecraft"
+	if i := strings.Index(location, ":"); i >= 0 {
+		namespace = location[:i]
+		location = location[i+1:]
+	}
+
+	return "assets/" + namespace + "/" + dir + "/" + location + ext
+}
+
+// parseVariant returns a variant which is an object or an array of objects
+func parseVariant(raw json.RawMessage) (javaVariant, bool) {
+	var variant javaVariant
+	if json.Unmarshal(raw, &variant) == nil && variant.Model != "" {
+		return variant, true
+	}
+
+	var list []javaVariant
+	if json.Unmarshal(raw, &list) == nil && len(list) > 0 {
+		return list[0], true
+	}
+
+	return javaVariant{}, false
+}
+
+// matchVariant returns whether the key of variants such as "facing=east,half=top" matches properties
+func matchVariant(key string, properties map[string]string) bool {
+	if key == "" || key == "normal" {
+		return true
+	}
+
+	for _, pair := range strings.Split(key, ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || properties[kv[0]] != kv[1] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// javaModelName returns the model name of the block state
+func (pack *ResourcePack) javaModelName(state level.BlockState) (string, bool) {
+	name, properties, ok := state.ToBlockNameProperties()
+	if !ok {
+		name = state.Name()
+	}
+
+	var bs javaBlockState
+
+	err := pack.readJSON(resourcePath(name, "blockstates", ".json"), &bs)
+	if err != nil {
+		return "", false
+	}
+
+	keys := make([]string, 0, len(bs.Variants))
+	for key := range bs.Variants {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !matchVariant(key, properties) {
+			continue
+		}
+
+		variant, ok := parseVariant(bs.Variants[key])
+		if ok {
+			return variant.Model, true
+		}
+	}
+
+	// fallbacks to the first variant
+	for _, key := range keys {
+		variant, ok := parseVariant(bs.Variants[key])
+		if ok {
+			return variant.Model, true
+		}
+	}
+
+	// uses the first part which is always applied
+	for _, part := range bs.Multipart {
+		if part.When != nil {
+			continue
+		}
+
+		variant, ok := parseVariant(part.Apply)
+		if ok {
+			return variant.Model, true
+		}
+	}
+
+	return "", false
+}
+
+// javaModel returns the model by the name
+func (pack *ResourcePack) javaModel(name string) (*javaModel, error) {
+	if !strings.Contains(name, "/") { // before v1.13
+		name = "block/" + name
+	}
+
+	pack.mutex.Lock()
+	model, ok := pack.models[name]
+	pack.mutex.Unlock()
+
+	if ok {
+		return model, nil
+	}
+
+	model = &javaModel{}
+
+	err := pack.readJSON(resourcePath(name, "models", ".json"), model)
+	if err != nil {
+		return nil, err
+	}
+
+	pack.mutex.Lock()
+	pack.models[name] = model
+	pack.mutex.Unlock()
+
+	return model, nil
+}
+
+// javaPath resolves the texture of the face through the model and the parents
+func (pack *ResourcePack) javaPath(state level.BlockState, face Face) (string, bool) {
+	name, ok := pack.javaModelName(state)
+	if !ok {
+		return "", false
+	}
+
+	textures := make(map[string]string)
+	ref := ""
+
+	// parents are limited for circular references
+	for i := 0; i < 16 && name != ""; i++ {
+		model, err := pack.javaModel(name)
+		if err != nil {
+			break
+		}
+
+		for key, v := range model.Textures {
+			if _, ok := textures[key]; !ok {
+				textures[key] = v
+			}
+		}
+
+		if ref == "" {
+			ref = modelFaceTexture(model, face)
+		}
+
+		name = model.Parent
+	}
+
+	if ref == "" {
+		// models without elements such as builtin models
+		keys := map[Face][]string{
+			FaceUp:   {"top", "end", "all", "texture", "particle"},
+			FaceSide: {"side", "all", "texture", "particle"},
+			FaceDown: {"bottom", "end", "all", "texture", "particle"},
+		}
+
+		for _, key := range keys[face] {
+			if _, ok := textures[key]; ok {
+				ref = "#" + key
+
+				break
+			}
+		}
+	}
+
+	for i := 0; i < 16 && strings.HasPrefix(ref, "#"); i++ {
+		ref = textures[ref[1:]]
+	}
+
+	if ref == "" {
+		return "", false
+	}
+
+	if !strings.Contains(ref, "/") { // before v1.13
+		ref = "blocks/" + ref
+	}
+
+	return resourcePath(ref, "textures", ".png"), true
+}
+
+// modelFaceTexture returns the texture of the face in elements of the model
+func modelFaceTexture(model *javaModel, face Face) string {
+	names := map[Face][]string{
+		FaceUp:   {"up"},
+		FaceSide: {"north", "south", "east", "west"},
+		FaceDown: {"down"},
+	}
+
+	for _, element := range model.Elements {
+		for _, name := range names[face] {
+			f, ok := element.Faces[name]
+			if ok && f.Texture != "" {
+				return f.Texture
+			}
+		}
+	}
+
+	return ""
+}
+
+// stripComments removes comments (// and /* */) out of strings from json
+func stripComments(b []byte) []byte {
+	result := make([]byte, 0, len(b))
+
+	inString := false
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+
+		if inString {
+			result = append(result, c)
+
+			if c == '\\' && i+1 < len(b) {
+				i++
+				result = append(result, b[i])
+			} else if c == '"' {
+				inString = false
+			}
+
+			continue
+		}
+
+		if c == '/' && i+1 < len(b) {
+			switch b[i+1] {
+			case '/':
+				for i < len(b) && b[i] != '\n' {
+					i++
+				}
+
+				if i < len(b) {
+					result = append(result, '\n')
+				}
+
+				continue
+			case '*':
+				i += 2
+				for i+1 < len(b) && !(b[i] == '*' && b[i+1] == '/') {
+					i++
+				}
+
+				i++
+
+				continue
+			}
+		}
+
+		if c == '"' {
+			inString = true
+		}
+
+		result = append(result, c)
+	}
+
+	return result
+}
@@ -0,0 +1,432 @@
+package render
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"image"
+	"image/color"
+	"strings"
+	"sync"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/block"
+)
+
+// BlockColor is a color of the top face of a block
+type BlockColor struct {
+	Color color.RGBA
+
+	// Tint is a kind of tint multiplied to Color by biome
+	// Tinted colors are gray
+	Tint Tint
+}
+
+func plain(v uint32) BlockColor {
+	return BlockColor{Color: hex(v)}
+}
+
+func tinted(v uint32, tint Tint) BlockColor {
+	return BlockColor{Color: hex(v), Tint: tint}
+}
+
+// blockColors is average colors of top textures in the vanilla resource pack
+var blockColors = map[string]BlockColor{
+	"stone":                plain(0x7D7D7D),
+	"granite":              plain(0x956756),
+	"polished_granite":     plain(0x9A6A59),
+	"diorite":              plain(0xBCBCBC),
+	"polished_diorite":     plain(0xC0C0C1),
+	"andesite":             plain(0x888888),
+	"polished_andesite":    plain(0x848685),
+	"grass_block":          tinted(0x939393, TintGrass),
+	"dirt":                 plain(0x866043),
+	"coarse_dirt":          plain(0x77553B),
+	"podzol":               plain(0x5C3F1A),
+	"cobblestone":          plain(0x7F7F7F),
+	"bedrock":              plain(0x555555),
+	"water":                tinted(0xB0B0B0, TintWater),
+	"lava":                 plain(0xD4590E),
+	"sand":                 plain(0xDBCFA3),
+	"red_sand":             plain(0xBE6621),
+	"gravel":               plain(0x837F7E),
+	"gold_ore":             plain(0x8F8C7D),
+	"iron_ore":             plain(0x88817B),
+	"coal_ore":             plain(0x737373),
+	"lapis_ore":            plain(0x637090),
+	"diamond_ore":          plain(0x7D8E8D),
+	"redstone_ore":         plain(0x856B6B),
+	"emerald_ore":          plain(0x75887C),
+	"nether_quartz_ore":    plain(0x75413E),
+	"spruce_leaves":        plain(0x3D5E3D),
+	"birch_leaves":         plain(0x5E7A3A),
+	"sponge":               plain(0xC3C04A),
+	"wet_sponge":           plain(0xABB447),
+	"glass":                plain(0xDAF0F4),
+	"lapis_block":          plain(0x1F438C),
+	"sandstone":            plain(0xD9D0A1),
+	"red_sandstone":        plain(0xB5621F),
+	"gold_block":           plain(0xF6D03D),
+	"iron_block":           plain(0xDCDCDC),
+	"bricks":               plain(0x966153),
+	"tnt":                  plain(0xB4504A),
+	"mossy_cobblestone":    plain(0x667A5B),
+	"obsidian":             plain(0x0F0B19),
+	"torch":                plain(0xFFD800),
+	"fire":                 plain(0xE49A28),
+	"spawner":              plain(0x1C2A37),
+	"chest":                plain(0xA27A30),
+	"diamond_block":        plain(0x62EDE4),
+	"crafting_table":       plain(0x81623A),
+	"wheat":                plain(0x9E9630),
+	"farmland":             plain(0x5B3A1F),
+	"furnace":              plain(0x6E6E6E),
+	"snow":                 plain(0xF9FEFE),
+	"ice":                  plain(0x91B7FD),
+	"snow_block":           plain(0xF9FEFE),
+	"cactus":               plain(0x557F2B),
+	"clay":                 plain(0xA0A6B3),
+	"sugar_cane":           tinted(0x9A9A9A, TintGrass),
+	"pumpkin":              plain(0xC07615),
+	"carved_pumpkin":       plain(0xC07615),
+	"jack_o_lantern":       plain(0xC07615),
+	"melon":                plain(0x72921E),
+	"netherrack":           plain(0x6F3534),
+	"soul_sand":            plain(0x513E32),
+	"glowstone":            plain(0xAB8654),
+	"nether_portal":        plain(0x5A0BC0),
+	"mycelium":             plain(0x6F6265),
+	"lily_pad":             tinted(0x8B8B8B, TintFoliage),
+	"vine":                 tinted(0x6A6A6A, TintFoliage),
+	"nether_bricks":        plain(0x2C161A),
+	"end_stone":            plain(0xDDDFA5),
+	"emerald_block":        plain(0x2ACB58),
+	"redstone_block":       plain(0xAF1805),
+	"quartz_block":         plain(0xECE6DF),
+	"coal_block":           plain(0x101010),
+	"packed_ice":           plain(0x8DB4FA),
+	"prismarine":           plain(0x63A295),
+	"prismarine_bricks":    plain(0x63AC9E),
+	"dark_prismarine":      plain(0x335B4B),
+	"sea_lantern":          plain(0xACC8BE),
+	"hay_block":            plain(0xA68B0C),
+	"terracotta":           plain(0x985E44),
+	"slime_block":          plain(0x6FC05B),
+	"magma_block":          plain(0x8E3F1F),
+	"nether_wart_block":    plain(0x720202),
+	"red_nether_bricks":    plain(0x450709),
+	"bone_block":           plain(0xD1CDB7),
+	"purpur_block":         plain(0xA97DA9),
+	"purpur_pillar":        plain(0xAB81AB),
+	"end_stone_bricks":     plain(0xDBE0A2),
+	"grass_path":           plain(0x947A41),
+	"frosted_ice":          plain(0x8CB4FB),
+	"dried_kelp_block":     plain(0x323B25),
+	"kelp":                 plain(0x57822B),
+	"kelp_plant":           plain(0x57822B),
+	"seagrass":             plain(0x3A7C10),
+	"tall_seagrass":        plain(0x3A7C10),
+	"stone_bricks":         plain(0x7A7A7A),
+	"mossy_stone_bricks":   plain(0x737969),
+	"cracked_stone_bricks": plain(0x767676),
+	"smooth_stone":         plain(0x9E9E9E),
+	"grass":                tinted(0x8F8F8F, TintGrass),
+	"tall_grass":           tinted(0x8F8F8F, TintGrass),
+	"fern":                 tinted(0x7C7C7C, TintGrass),
+	"large_fern":           tinted(0x7C7C7C, TintGrass),
+	"dead_bush":            plain(0x6B4F28),
+	"dandelion":            plain(0xFFEC4F),
+	"poppy":                plain(0xED302C),
+	"blue_orchid":          plain(0x2AAFF4),
+	"allium":               plain(0xB878ED),
+	"azure_bluet":          plain(0xF7F7F7),
+	"red_tulip":            plain(0xD33A17),
+	"orange_tulip":         plain(0xE7711E),
+	"white_tulip":          plain(0xF7F7F7),
+	"pink_tulip":           plain(0xEBC5F5),
+	"oxeye_daisy":          plain(0xF7F7F7),
+	"sunflower":            plain(0xFFD43A),
+	"lilac":                plain(0xD4A3E2),
+	"rose_bush":            plain(0xE32A2A),
+	"peony":                plain(0xEBC5F5),
+	"brown_mushroom":       plain(0x916D55),
+	"red_mushroom":         plain(0xD84A45),
+	"brown_mushroom_block": plain(0x957051),
+	"red_mushroom_block":   plain(0xC82E2D),
+	"cobweb":               plain(0xDCDCDC),
+	"tube_coral_block":     plain(0x3157CF),
+	"brain_coral_block":    plain(0xCF5B9F),
+	"bubble_coral_block":   plain(0xA418A2),
+	"fire_coral_block":     plain(0xA5262F),
+	"horn_coral_block":     plain(0xD8C842),
+}
+
+// invisibleBlocks is blocks which aren't drawn
+var invisibleBlocks = map[string]bool{
+	"air":            true,
+	"cave_air":       true,
+	"void_air":       true,
+	"barrier":        true,
+	"structure_void": true,
+	"moving_piston":  true,
+}
+
+// dyeColors is colors of wool by dye color
+var dyeColors = map[string]uint32{
+	"white":      0xE9ECEC,
+	"orange":     0xF07613,
+	"magenta":    0xBD44B3,
+	"light_blue": 0x3AAFD9,
+	"yellow":     0xF8C527,
+	"lime":       0x70B919,
+	"pink":       0xED8DAC,
+	"gray":       0x3E4447,
+	"light_gray": 0x8E8E86,
+	"cyan":       0x158991,
+	"purple":     0x792AAC,
+	"blue":       0x35399D,
+	"brown":      0x724728,
+	"green":      0x546D1B,
+	"red":        0xA12722,
+	"black":      0x141519,
+}
+
+// dyeKindColors is colors by dye color for kinds which differ from wool
+var dyeKindColors = map[string]map[string]uint32{
+	"concrete": {
+		"white": 0xCFD5D6, "orange": 0xE06100, "magenta": 0xA9309F, "light_blue": 0x2389C6,
+		"yellow": 0xF0AF15, "lime": 0x5EA818, "pink": 0xD5658E, "gray": 0x36393D,
+		"light_gray": 0x7D7D73, "cyan": 0x157788, "purple": 0x64209C, "blue": 0x2C2E8F,
+		"brown": 0x603B1F, "green": 0x495B24, "red": 0x8E2020, "black": 0x080A0F,
+	},
+	"terracotta": {
+		"white": 0xD1B1A1, "orange": 0xA15325, "magenta": 0x95586C, "light_blue": 0x716C89,
+		"yellow": 0xBA8523, "lime": 0x677534, "pink": 0xA04D4E, "gray": 0x392A23,
+		"light_gray": 0x876A61, "cyan": 0x565B5B, "purple": 0x764656, "blue": 0x4A3B5B,
+		"brown": 0x4D3323, "green": 0x4C532A, "red": 0x8F3D2E, "black": 0x251610,
+	},
+}
+
+// dyedKinds is kinds of blocks which have dye colors
+var dyedKinds = map[string]bool{
+	"wool":               true,
+	"carpet":             true,
+	"bed":                true,
+	"banner":             true,
+	"wall_banner":        true,
+	"stained_glass":      true,
+	"stained_glass_pane": true,
+	"shulker_box":        true,
+	"concrete":           true,
+	"concrete_powder":    true,
+	"terracotta":         true,
+	"glazed_terracotta":  true,
+}
+
+// woodColors is colors of planks by wood type
+var woodColors = map[string]uint32{
+	"oak":      0xA2824E,
+	"spruce":   0x72542F,
+	"birch":    0xC0AF79,
+	"jungle":   0xA0734D,
+	"acacia":   0xA85A32,
+	"dark_oak": 0x432B14,
+}
+
+// materialColors is colors for blocks which aren't in the table by material
+var materialColors = map[string]uint32{
+	"rock":  0x7D7D7D,
+	"dirt":  0x866043,
+	"wood":  0xA2824E,
+	"plant": 0x5A8A32,
+	"wool":  0xE9ECEC,
+	"web":   0xDCDCDC,
+}
+
+// shapeSuffixes is suffixes of blocks which have the color of the base block
+var shapeSuffixes = []string{"_stairs", "_slab", "_wall", "_fence_gate", "_fence", "_pressure_plate", "_button"}
+
+// DefaultBlockColors is the color table for v1.13 blocks
+// It's generated from the block list with rules for dye colors, wood types and shapes
+var DefaultBlockColors = generateBlockColors()
+
+func generateBlockColors() map[string]BlockColor {
+	colors := make(map[string]BlockColor)
+
+	list, _ := block.ListV113()
+
+	for key, bl := range list {
+		name := strings.TrimPrefix(key, block.MinecraftPrefix)
+		if invisibleBlocks[name] {
+			continue
+		}
+
+		bc, ok := guessBlockColor(name)
+		if !ok {
+			v, ok := materialColors[bl.Material]
+			if !ok {
+				continue
+			}
+
+			bc = plain(v)
+		}
+
+		colors[block.MinecraftPrefix+name] = bc
+	}
+
+	// blocks which aren't in the list
+	for name, bc := range blockColors {
+		if _, ok := colors[block.MinecraftPrefix+name]; !ok {
+			colors[block.MinecraftPrefix+name] = bc
+		}
+	}
+
+	return colors
+}
+
+// guessBlockColor returns a color of the block by name
+func guessBlockColor(name string) (BlockColor, bool) {
+	bc, ok := blockColors[name]
+	if ok {
+		return bc, true
+	}
+
+	for dye, v := range dyeColors {
+		if !strings.HasPrefix(name, dye+"_") {
+			continue
+		}
+
+		kind := strings.TrimPrefix(name, dye+"_")
+		if !dyedKinds[kind] {
+			continue
+		}
+
+		if colors, ok := dyeKindColors[kind]; ok {
+			return plain(colors[dye]), true
+		}
+
+		return plain(v), true
+	}
+
+	for wood, v := range woodColors {
+		if !strings.HasPrefix(name, wood+"_") && !strings.HasPrefix(name, "stripped_"+wood+"_") {
+			continue
+		}
+
+		kind := strings.TrimPrefix(strings.TrimPrefix(name, "stripped_"), wood+"_")
+		switch kind {
+		case "leaves":
+			return tinted(0x8A8A8A, TintFoliage), true
+		case "sapling":
+			return plain(0x4B7A2A), true
+		}
+
+		return plain(v), true
+	}
+
+	if strings.HasPrefix(name, "dead_") && strings.Contains(name, "coral") {
+		return plain(0x82796F), true
+	}
+
+	for _, suffix := range shapeSuffixes {
+		if !strings.HasSuffix(name, suffix) {
+			continue
+		}
+
+		base := strings.TrimSuffix(name, suffix)
+		for _, n := range []string{base, base + "s", base + "_block", base + "_planks"} {
+			bc, ok := blockColors[n]
+			if ok {
+				return bc, true
+			}
+		}
+	}
+
+	if strings.HasPrefix(name, "potted_") || strings.HasPrefix(name, "infested_") {
+		return guessBlockColor(name[strings.Index(name, "_")+1:])
+	}
+
+	return BlockColor{}, false
+}
+
+// NewColorSource returns new ColorSource with DefaultBlockColors
+func NewColorSource() *ColorSource {
+	colors := make(map[string]BlockColor, len(DefaultBlockColors))
+	for name, bc := range DefaultBlockColors {
+		colors[name] = bc
+	}
+
+	return &ColorSource{
+		Colors: colors,
+		images: make(map[BlockColor]image.Image),
+	}
+}
+
+// ColorSource is a Source of 1x1 images from the color table
+// It doesn't need resource packs
+type ColorSource struct {
+	// Colors is colors by block name
+	Colors map[string]BlockColor
+
+	images map[BlockColor]image.Image
+	mutex  sync.Mutex
+}
+
+// Color returns the color of the block
+// Legacy blocks (id and meta) are converted to v1.13 names
+func (cs *ColorSource) Color(state level.BlockState) (BlockColor, bool) {
+	name := state.Name()
+
+	oldName, meta, ok := state.ToBlockNameMeta()
+	if ok {
+		name = block.GetV112ToV113(oldName, meta)
+	}
+
+	if !strings.Contains(name, ":") {
+		name = block.MinecraftPrefix + name
+	}
+
+	bc, ok := cs.Colors[name]
+	if !ok && name != state.Name() {
+		bc, ok = cs.Colors[state.Name()]
+	}
+
+	return bc, ok
+}
+
+// Texture returns a 1x1 image of the color of the block
+// It implements Source
+func (cs *ColorSource) Texture(state level.BlockState) (image.Image, Tint, bool) {
+	bc, ok := cs.Color(state)
+	if !ok {
+		return nil, TintNone, false
+	}
+
+	cs.mutex.Lock()
+	defer cs.mutex.Unlock()
+
+	img, ok := cs.images[bc]
+	if !ok {
+		rgba := image.NewRGBA(image.Rect(0, 0, 1, 1))
+		rgba.SetRGBA(0, 0, bc.Color)
+
+		img = rgba
+		cs.images[bc] = img
+	}
+
+	return img, bc.Tint, true
+}
+
+// NewColorRenderer returns new Renderer drawing a pixel per block with the color table
+func NewColorRenderer(format level.Format) *Renderer {
+	r := NewRenderer(format, NewColorSource())
+	r.Scale = 1
+
+	return r
+}
@@ -0,0 +1,500 @@
+package render
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"image"
+	"image/color"
+	"sync"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/asset"
+	"github.com/beito123/level/biome"
+	"github.com/beito123/level/block"
+	"github.com/beito123/level/heightmap"
+)
+
+const (
+	// DefaultScale is pixels per block
+	DefaultScale = 16
+
+	// DefaultWorkers is the number of goroutines for rendering
+	DefaultWorkers = 4
+
+	// ChunkSize is the width of a chunk in blocks
+	ChunkSize = 16
+)
+
+// Source provides images of blocks
+type Source interface {
+	// Texture returns the image of the top face of the block and the kind of tint
+	// If the block doesn't have an image, returns false for ok
+	Texture(state level.BlockState) (img image.Image, tint Tint, ok bool)
+}
+
+// FaceSource is a Source which provides images of each face of blocks
+// ModeIsometric uses side faces if the source implements it
+type FaceSource interface {
+	Source
+
+	// FaceTexture returns the image of the face of the block and the kind of tint
+	// If the block doesn't have an image, returns false for ok
+	FaceTexture(state level.BlockState, face Face) (img image.Image, tint Tint, ok bool)
+}
+
+// Column is the top surface of a column of a chunk
+type Column struct {
+	// Block is the top block which has an image
+	// It's nil if the column has only air or water
+	Block level.BlockState
+
+	// Y is the y coordinate of Block
+	Y int
+
+	// Water is the depth of water above Block
+	Water int
+
+	// Biome is the biome id of the column
+	Biome byte
+}
+
+// DefaultBiomeOverlay is the alpha of BiomeOverlay for overlays
+const DefaultBiomeOverlay = 96
+
+// editioner is a format which has a edition
+type editioner interface {
+	Edition() asset.Edition
+}
+
+// NewRenderer returns new Renderer with default options
+// BiomeRegistry is chosen by the edition of the format
+func NewRenderer(format level.Format, source Source) *Renderer {
+	registry := biome.Java
+	if f, ok := format.(editioner); ok {
+		registry = biome.Of(f.Edition())
+	}
+
+	return &Renderer{
+		Format:        format,
+		Source:        source,
+		Scale:         DefaultScale,
+		WaterShading:  true,
+		Biomes:        BiomeColorsOf,
+		BiomeRegistry: registry,
+		Workers:       DefaultWorkers,
+		SliceY:        heightmap.Height - 1,
+		tiles:         make(map[string]*tile),
+	}
+}
+
+// Renderer renders top-down images of chunks
+type Renderer struct {
+	Format level.Format
+	Source Source
+
+	// Scale is pixels per block
+	// Textures are resized, or averaged for 1
+	Scale int
+
+	// WaterShading draws water as a translucent layer darkened by depth
+	// If it's false, water is drawn as a block
+	WaterShading bool
+
+	// Biomes returns colors for tinting by biome id
+	Biomes func(biome byte) BiomeColors
+
+	// BiomeRegistry provides colors of biomes for ModeBiome and BiomeOverlay
+	BiomeRegistry *biome.Registry
+
+	// BiomeOverlay is the alpha (0-255) of colors of biomes drawn over top-down images
+	// It's disabled for 0
+	BiomeOverlay int
+
+	// Workers is the number of goroutines for Render
+	Workers int
+
+	// Mode is a kind of rendering
+	Mode Mode
+
+	// SliceY is the highest y coordinate for ModeSlice
+	SliceY int
+
+	tiles map[string]*tile
+	mutex sync.RWMutex
+}
+
+// tile is a texture resized for the scale
+type tile struct {
+	img  *image.RGBA
+	tint Tint
+	ok   bool
+}
+
+// tile returns a texture of the top face of the block resized for the scale
+func (r *Renderer) tile(state level.BlockState) *tile {
+	return r.faceTile(state, FaceUp)
+}
+
+// faceTile returns a texture of the face of the block resized for the scale
+// If Source isn't FaceSource, it returns the top face
+func (r *Renderer) faceTile(state level.BlockState, face Face) *tile {
+	fs, ok := r.Source.(FaceSource)
+	if !ok {
+		face = FaceUp
+	}
+
+	name := state.Name()
+	if face != FaceUp {
+		name += "#" + face.Name()
+	}
+
+	r.mutex.RLock()
+	t, ok := r.tiles[name]
+	r.mutex.RUnlock()
+
+	if ok {
+		return t
+	}
+
+	t = &tile{}
+
+	var img image.Image
+	var tint Tint
+	if face == FaceUp {
+		img, tint, ok = r.Source.Texture(state)
+	} else {
+		img, tint, ok = fs.FaceTexture(state, face)
+	}
+
+	if ok && !img.Bounds().Empty() {
+		t.img = resize(img, r.Scale)
+		t.tint = tint
+		t.ok = true
+	}
+
+	r.mutex.Lock()
+	r.tiles[name] = t
+	r.mutex.Unlock()
+
+	return t
+}
+
+// resize returns the image resized to size x size
+// For size 1, it returns the average color of opaque pixels
+func resize(src image.Image, size int) *image.RGBA {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+
+	if size == 1 {
+		var r, g, b, n int
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				c := color.RGBAModel.Convert(src.At(x, y)).(color.RGBA)
+				if c.A == 0 {
+					continue
+				}
+
+				r += int(c.R)
+				g += int(c.G)
+				b += int(c.B)
+				n++
+			}
+		}
+
+		if n > 0 {
+			dst.SetRGBA(0, 0, color.RGBA{uint8(r / n), uint8(g / n), uint8(b / n), 255})
+		}
+
+		return dst
+	}
+
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			dst.Set(x, y, src.At(bounds.Min.X+x*bounds.Dx()/size, bounds.Min.Y+y*bounds.Dy()/size))
+		}
+	}
+
+	return dst
+}
+
+// isWater returns whether the block at chunk coordinate is water or waterlogged
+func isWater(chunk level.Chunk, state level.BlockState, x, y, z int) (bool, error) {
+	if block.IsWater(state.Name()) {
+		return true, nil
+	}
+
+	liquid, err := chunk.GetBlockAtLayer(x, y, z, level.LayerLiquid)
+	if err != nil {
+		return false, err
+	}
+
+	return liquid != nil && block.IsWater(liquid.Name()), nil
+}
+
+// Surface returns the top surface of a column at chunk coordinate x, z for the mode
+// It starts from the heightmap and skips blocks which don't have an image
+func (r *Renderer) Surface(chunk level.Chunk, x, z int) (Column, error) {
+	col := Column{
+		Biome: chunk.Biome(x, z),
+	}
+
+	start, ok, err := r.startY(chunk, x, z)
+	if err != nil || !ok {
+		return col, err
+	}
+
+	for y := start; y >= 0; y-- {
+		state, err := chunk.GetBlock(x, y, z)
+		if err != nil {
+			return col, err
+		}
+
+		if state == nil || block.IsAir(state.Name()) {
+			continue
+		}
+
+		if r.WaterShading {
+			water, err := isWater(chunk, state, x, y, z)
+			if err != nil {
+				return col, err
+			}
+
+			// waterlogged plants such as seagrass are a part of water
+			if water && (block.IsWater(state.Name()) || !r.tile(state).ok) {
+				col.Water++
+
+				continue
+			}
+		}
+
+		if !r.tile(state).ok {
+			continue
+		}
+
+		col.Block = state
+		col.Y = y
+
+		break
+	}
+
+	return col, nil
+}
+
+// drawColumn draws the column to dst at the pixel coordinate
+func (r *Renderer) drawColumn(dst *image.RGBA, col Column, px, py int) {
+	colors := r.Biomes(col.Biome)
+
+	var t *tile
+	if col.Block != nil {
+		t = r.tile(col.Block)
+	}
+
+	// water is darker as deeper
+	alpha := 0
+	if col.Water > 0 {
+		alpha = 140 + col.Water*8
+		if alpha > 230 {
+			alpha = 230
+		}
+
+		if t == nil {
+			alpha = 255
+		}
+	}
+
+	light := uint8(r.shade(col))
+	shade := color.RGBA{light, light, light, 255}
+
+	for y := 0; y < r.Scale; y++ {
+		for x := 0; x < r.Scale; x++ {
+			var c color.RGBA
+			if t != nil {
+				c = t.img.RGBAAt(x, y)
+				if c.A == 0 {
+					continue
+				}
+
+				c = multiply(c, colors.Color(t.tint))
+				c.A = 255
+			}
+
+			if alpha > 0 {
+				c = blend(c, colors.Water, alpha)
+			}
+
+			c = multiply(c, shade)
+
+			dst.SetRGBA(px+x, py+y, c)
+		}
+	}
+}
+
+// fill blends the color over a block at the pixel coordinate with the alpha (0-255)
+// Transparent pixels are filled for ModeBiome only
+func (r *Renderer) fill(dst *image.RGBA, c color.RGBA, px, py, alpha int) {
+	for y := 0; y < r.Scale; y++ {
+		for x := 0; x < r.Scale; x++ {
+			old := dst.RGBAAt(px+x, py+y)
+			if old.A == 0 && r.Mode != ModeBiome {
+				continue
+			}
+
+			dst.SetRGBA(px+x, py+y, blend(old, c, alpha))
+		}
+	}
+}
+
+// RenderChunk renders an image of the chunk at chunk coordinate
+// If the chunk isn't generated, returns false for ok
+func (r *Renderer) RenderChunk(x, y int) (img *image.RGBA, ok bool, err error) {
+	if r.Mode == ModeIsometric {
+		img, err = r.renderIsometric(x, y, x+1, y+1)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return img, !isEmpty(img), nil
+	}
+
+	img = image.NewRGBA(image.Rect(0, 0, ChunkSize*r.Scale, ChunkSize*r.Scale))
+
+	ok, err = r.renderChunk(img, x, y, 0, 0)
+	if err != nil || !ok {
+		return nil, ok, err
+	}
+
+	return img, true, nil
+}
+
+// withChunk calls fn with the chunk at chunk coordinate
+// Chunks loaded by it are unloaded after fn
+// If the chunk isn't generated, returns false for ok
+func (r *Renderer) withChunk(x, y int, fn func(chunk level.Chunk) error) (bool, error) {
+	loaded := r.Format.IsLoadedChunk(x, y)
+	if !loaded {
+		exist, err := r.Format.HasGeneratedChunk(x, y)
+		if err != nil || !exist {
+			return false, err
+		}
+	}
+
+	chunk, err := r.Format.Chunk(x, y)
+	if err != nil {
+		return false, err
+	}
+
+	err = fn(chunk)
+	if err != nil {
+		return false, err
+	}
+
+	if !loaded { // keeps chunks which were loaded by others
+		err = r.Format.UnloadChunk(x, y)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// renderChunk renders the chunk to dst at the pixel coordinate
+func (r *Renderer) renderChunk(dst *image.RGBA, x, y, px, py int) (bool, error) {
+	return r.withChunk(x, y, func(chunk level.Chunk) error {
+		for z := 0; z < ChunkSize; z++ {
+			for x := 0; x < ChunkSize; x++ {
+				if r.Mode == ModeBiome {
+					r.fill(dst, r.BiomeRegistry.Color(chunk.Biome(x, z)), px+x*r.Scale, py+z*r.Scale, 255)
+
+					continue
+				}
+
+				col, err := r.Surface(chunk, x, z)
+				if err != nil {
+					return err
+				}
+
+				r.drawColumn(dst, col, px+x*r.Scale, py+z*r.Scale)
+
+				if r.BiomeOverlay > 0 {
+					r.fill(dst, r.BiomeRegistry.Color(col.Biome), px+x*r.Scale, py+z*r.Scale, r.BiomeOverlay)
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// Render renders an image of chunks from x0, y0 to x1, y1 (exclusive) at chunk coordinate
+// Chunks which aren't generated are transparent
+// For ModeIsometric, the size of the image is IsometricBounds
+func (r *Renderer) Render(x0, y0, x1, y1 int) (*image.RGBA, error) {
+	if r.Mode == ModeIsometric {
+		return r.renderIsometric(x0, y0, x1, y1)
+	}
+
+	size := ChunkSize * r.Scale
+	img := image.NewRGBA(image.Rect(0, 0, (x1-x0)*size, (y1-y0)*size))
+
+	workers := r.Workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	type job struct {
+		x, y int
+	}
+
+	jobs := make(chan job)
+	errs := make(chan error, workers)
+
+	wg := new(sync.WaitGroup)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := range jobs {
+				_, err := r.renderChunk(img, j.x, j.y, (j.x-x0)*size, (j.y-y0)*size)
+				if err != nil {
+					errs <- err
+
+					// drain jobs for stopping
+					for range jobs {
+					}
+
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+
+		for y := y0; y < y1; y++ {
+			for x := x0; x < x1; x++ {
+				jobs <- job{x, y}
+			}
+		}
+	}()
+
+	wg.Wait()
+	close(errs)
+
+	err, ok := <-errs
+	if ok {
+		return nil, err
+	}
+
+	return img, nil
+}
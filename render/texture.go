@@ -0,0 +1,383 @@
+package render
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"errors"
+	"image"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+
+	// image formats for textures
+	_ "image/png"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/block"
+	"github.com/beito123/level/util"
+)
+
+// NewTextureManager returns new TextureManager
+func NewTextureManager() *TextureManager {
+	return &TextureManager{
+		PathList:       make(map[string]string),
+		Aliases:        make(map[string][]string),
+		preparedImages: make(map[string]image.Image),
+		faceImages:     make(map[string]*faceImage),
+	}
+}
+
+// TextureManager control textures for blocks
+// Textures are found from PathList, then resource packs
+type TextureManager struct {
+	// PathList is paths of image files by block name
+	PathList map[string]string
+	Aliases  map[string][]string
+
+	preparedImages map[string]image.Image
+
+	packs      []*ResourcePack
+	faceImages map[string]*faceImage
+
+	mutex sync.RWMutex
+}
+
+func (tm *TextureManager) getBlockName(name string) (string, bool) {
+	tm.mutex.RLock()
+	defer tm.mutex.RUnlock()
+
+	_, ok := tm.PathList[name]
+	if ok {
+		return name, true
+	}
+
+	for n, v := range tm.Aliases {
+		for _, c := range v {
+			if c == name {
+				return n, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// AddAlias adds aliases for the block name
+func (tm *TextureManager) AddAlias(name string, aliases ...string) {
+	tm.mutex.Lock()
+	tm.Aliases[name] = append(tm.Aliases[name], aliases...)
+	tm.mutex.Unlock()
+}
+
+// HasTexture returns whether the texture of the block exists in PathList or resource packs
+func (tm *TextureManager) HasTexture(name string) bool {
+	if tm.hasPath(name) {
+		return true
+	}
+
+	_, ok := tm.packTexture(nameState(name), FaceUp)
+
+	return ok
+}
+
+// hasPath returns whether the texture file of the block in PathList exists
+func (tm *TextureManager) hasPath(name string) bool {
+	name, ok := tm.getBlockName(name)
+	if !ok {
+		return false
+	}
+
+	tm.mutex.RLock()
+	path, ok := tm.PathList[name]
+	tm.mutex.RUnlock()
+
+	if !ok {
+		return false
+	}
+
+	return util.ExistFile(path)
+}
+
+// GetTexture returns the texture of the block
+// The texture is loaded if it's not prepared
+// If PathList doesn't have the block, the top face in resource packs is returned
+func (tm *TextureManager) GetTexture(name string) (image.Image, error) {
+	if !tm.hasPath(name) {
+		img, ok := tm.packTexture(nameState(name), FaceUp)
+		if ok {
+			return img, nil
+		}
+	}
+
+	if !tm.HasPrepared(name) {
+		err := tm.Prepare(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	name, _ = tm.getBlockName(name)
+
+	tm.mutex.RLock()
+	result := tm.preparedImages[name]
+	tm.mutex.RUnlock()
+
+	return result, nil
+}
+
+// HasPrepared returns whether the texture of the block is loaded
+func (tm *TextureManager) HasPrepared(name string) bool {
+	name, ok := tm.getBlockName(name)
+	if !ok {
+		return false
+	}
+
+	tm.mutex.RLock()
+	_, ok = tm.preparedImages[name]
+	tm.mutex.RUnlock()
+
+	return ok
+}
+
+// Prepare loads the texture of the block
+func (tm *TextureManager) Prepare(name string) error {
+	name, ok := tm.getBlockName(name)
+	if !ok {
+		return errors.New("level.render: couldn't find a block")
+	}
+
+	tm.mutex.RLock()
+	path, ok := tm.PathList[name]
+	tm.mutex.RUnlock()
+
+	if !ok {
+		return errors.New("level.render: couldn't find a path for the block")
+	}
+
+	if !util.ExistFile(path) {
+		return errors.New("level.render: couldn't find a image file")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return err
+	}
+
+	tm.mutex.Lock()
+	tm.preparedImages[name] = img
+	tm.mutex.Unlock()
+
+	return nil
+}
+
+// Load adds paths of textures by block name
+func (tm *TextureManager) Load(list map[string]string) {
+	tm.mutex.Lock()
+	for n, v := range list {
+		tm.PathList[n] = v
+	}
+	tm.mutex.Unlock()
+}
+
+// AddResourcePack adds the pack to the stack of packs
+// Packs are sorted by Priority, and the latter is used first for the same priority
+func (tm *TextureManager) AddResourcePack(pack *ResourcePack) {
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+
+	// inserts before packs which have the same or lower priority
+	i := sort.Search(len(tm.packs), func(i int) bool {
+		return tm.packs[i].Priority <= pack.Priority
+	})
+
+	tm.packs = append(tm.packs, nil)
+	copy(tm.packs[i+1:], tm.packs[i:])
+	tm.packs[i] = pack
+
+	// textures may be overridden
+	tm.faceImages = make(map[string]*faceImage)
+}
+
+// ResourcePacks returns packs in order of use
+func (tm *TextureManager) ResourcePacks() []*ResourcePack {
+	tm.mutex.RLock()
+	defer tm.mutex.RUnlock()
+
+	return append([]*ResourcePack(nil), tm.packs...)
+}
+
+// LoadResourcePack loads textures from a resource pack for mcbe or mcje (you can download from https://www.minecraft.net/en-us/)
+// path is a directory, a zip file or a mcpack file
+// Packs loaded later are used first
+func (tm *TextureManager) LoadResourcePack(path string) error {
+	pack, err := OpenResourcePack(path)
+	if err != nil {
+		return err
+	}
+
+	tm.mutex.RLock()
+	pack.Priority = len(tm.packs)
+	tm.mutex.RUnlock()
+
+	tm.AddResourcePack(pack)
+
+	return nil
+}
+
+// Close closes all resource packs
+func (tm *TextureManager) Close() error {
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+
+	var result error
+	for _, pack := range tm.packs {
+		err := pack.Close()
+		if err != nil && result == nil {
+			result = err
+		}
+	}
+
+	tm.packs = nil
+
+	return result
+}
+
+// faceImage is a cached texture of a face
+type faceImage struct {
+	img image.Image
+	ok  bool
+}
+
+// stateKey returns a key of the block state for caches
+func stateKey(state level.BlockState) string {
+	key := state.Name()
+
+	name, properties, ok := state.ToBlockNameProperties()
+	if ok {
+		key = block.FormatState(name, properties)
+	}
+
+	_, meta, ok := state.ToBlockNameMeta()
+	if ok {
+		key += ":" + strconv.Itoa(meta)
+	}
+
+	return key
+}
+
+// packTexture returns the texture of the face from resource packs
+func (tm *TextureManager) packTexture(state level.BlockState, face Face) (image.Image, bool) {
+	key := stateKey(state) + "#" + face.Name()
+
+	tm.mutex.RLock()
+	cached, ok := tm.faceImages[key]
+	packs := tm.packs
+	tm.mutex.RUnlock()
+
+	if ok {
+		return cached.img, cached.ok
+	}
+
+	cached = &faceImage{}
+	for _, pack := range packs {
+		path, ok := pack.TexturePath(state, face)
+		if !ok {
+			continue
+		}
+
+		img, err := pack.Image(path)
+		if err != nil {
+			continue
+		}
+
+		cached.img = img
+		cached.ok = true
+
+		break
+	}
+
+	tm.mutex.Lock()
+	tm.faceImages[key] = cached
+	tm.mutex.Unlock()
+
+	return cached.img, cached.ok
+}
+
+// FaceTexture returns the texture of the face of the block
+// Paths in PathList are used for the top face before resource packs
+// It implements FaceSource
+func (tm *TextureManager) FaceTexture(state level.BlockState, face Face) (image.Image, Tint, bool) {
+	names := []string{state.Name()}
+
+	// For compatible with v1.12 names
+	list, err := block.ListV112()
+	if err == nil {
+		bl, ok := list.Get(state.Name())
+		if ok && bl.Name != state.Name() {
+			names = append(names, bl.Name)
+		}
+	}
+
+	for _, name := range names {
+		if face == FaceUp && tm.hasPath(name) {
+			img, err := tm.GetTexture(name)
+			if err != nil {
+				return nil, TintNone, false
+			}
+
+			return img, TintOf(name), true
+		}
+
+		st := state
+		if name != state.Name() {
+			st = nameState(name)
+		}
+
+		img, ok := tm.packTexture(st, face)
+		if ok {
+			return img, TintOf(name), true
+		}
+	}
+
+	return nil, TintNone, false
+}
+
+// Texture returns the texture of the top face of the block
+// It implements Source
+func (tm *TextureManager) Texture(state level.BlockState) (image.Image, Tint, bool) {
+	return tm.FaceTexture(state, FaceUp)
+}
+
+// nameState is a block state which has only the name
+type nameState string
+
+func (name nameState) Name() string {
+	return string(name)
+}
+
+func (name nameState) ToBlockNameProperties() (string, map[string]string, bool) {
+	return string(name), nil, true
+}
+
+func (name nameState) ToBlockNameMeta() (string, int, bool) {
+	return string(name), 0, true
+}
+
+func (name nameState) ToBlockIDMeta() (int, int, bool) {
+	return 0, 0, false
+}
@@ -0,0 +1,398 @@
+package render
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"encoding/json"
+	"errors"
+	"image"
+	"image/draw"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	// DefaultTileSize is the width of tiles in pixels
+	DefaultTileSize = 256
+
+	// DefaultZooms is the number of zoom levels
+	DefaultZooms = 5
+
+	// TileStateFile is a file of stamps of rendered chunks
+	TileStateFile = "state.json"
+
+	// TileViewerFile is a file of the web viewer
+	TileViewerFile = "index.html"
+
+	// TileMarkersFile is a GeoJSON file of markers for the web viewer
+	TileMarkersFile = "markers.geojson"
+)
+
+// ChunkStamper is a level format which can report stamps of chunks
+// The stamp is changed when the chunk is changed
+type ChunkStamper interface {
+	// ChunkStamp returns the stamp of the chunk
+	// If the chunk isn't generated, returns false for ok
+	ChunkStamp(x, y int) (stamp uint64, ok bool, err error)
+}
+
+// TileCoord is a coordinate of a tile
+type TileCoord struct {
+	Zoom int
+	X    int
+	Y    int
+}
+
+// Parent returns the coordinate of the tile at the lower zoom level
+func (tc TileCoord) Parent() TileCoord {
+	return TileCoord{
+		Zoom: tc.Zoom - 1,
+		X:    tc.X >> 1,
+		Y:    tc.Y >> 1,
+	}
+}
+
+// NewTileMap returns new TileMap writing tiles to the directory
+func NewTileMap(renderer *Renderer, dir string) *TileMap {
+	return &TileMap{
+		Renderer: renderer,
+		Dir:      filepath.Clean(dir),
+		TileSize: DefaultTileSize,
+		Zooms:    DefaultZooms,
+	}
+}
+
+// TileMap writes tiles of z/x/y.png for web maps
+// Zoom Zooms-1 is rendered by Renderer, lower zooms are downsampled
+type TileMap struct {
+	Renderer *Renderer
+
+	// Dir is a output directory
+	Dir string
+
+	// TileSize is the width of tiles in pixels
+	// It needs to be a multiple of the width of chunks in pixels
+	TileSize int
+
+	// Zooms is the number of zoom levels
+	Zooms int
+
+	// Force re-renders all chunks in the range
+	Force bool
+}
+
+// tileState is stamps of rendered chunks
+type tileState struct {
+	Scale    int               `json:"scale"`
+	TileSize int               `json:"tileSize"`
+	Zooms    int               `json:"zooms"`
+	Chunks   map[string]uint64 `json:"chunks"`
+}
+
+func chunkKey(x, y int) string {
+	return strconv.Itoa(x) + "," + strconv.Itoa(y)
+}
+
+// Path returns the path of the tile
+func (tm *TileMap) Path(tc TileCoord) string {
+	return filepath.Join(tm.Dir, strconv.Itoa(tc.Zoom), strconv.Itoa(tc.X), strconv.Itoa(tc.Y)+".png")
+}
+
+// chunksPerTile returns the width of tiles in chunks at the max zoom
+func (tm *TileMap) chunksPerTile() (int, error) {
+	size := ChunkSize * tm.Renderer.Scale
+	if tm.TileSize < size || tm.TileSize%size != 0 {
+		return 0, errors.New("level.render: the tile size needs to be a multiple of the chunk size")
+	}
+
+	return tm.TileSize / size, nil
+}
+
+func (tm *TileMap) loadState() *tileState {
+	state := &tileState{
+		Chunks: make(map[string]uint64),
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(tm.Dir, TileStateFile))
+	if err != nil {
+		return state
+	}
+
+	var old tileState
+	if json.Unmarshal(b, &old) != nil || old.Chunks == nil {
+		return state
+	}
+
+	// options are changed, so all tiles need to be rendered
+	if old.Scale != tm.Renderer.Scale || old.TileSize != tm.TileSize || old.Zooms != tm.Zooms {
+		return state
+	}
+
+	return &old
+}
+
+func (tm *TileMap) saveState(state *tileState) error {
+	state.Scale = tm.Renderer.Scale
+	state.TileSize = tm.TileSize
+	state.Zooms = tm.Zooms
+
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(tm.Dir, TileStateFile), b, os.ModePerm)
+}
+
+// Render renders tiles for chunks from x0, y0 to x1, y1 (exclusive) at chunk coordinate
+// Only tiles which have changed chunks are rendered if the format implements ChunkStamper
+// It returns the number of rendered tiles at the max zoom
+func (tm *TileMap) Render(x0, y0, x1, y1 int) (int, error) {
+	if tm.Renderer.Mode == ModeIsometric {
+		return 0, errors.New("level.render: tiles don't support the isometric mode")
+	}
+
+	if tm.Zooms < 1 {
+		return 0, errors.New("level.render: zooms needs to be 1 or more")
+	}
+
+	n, err := tm.chunksPerTile()
+	if err != nil {
+		return 0, err
+	}
+
+	err = os.MkdirAll(tm.Dir, os.ModePerm)
+	if err != nil {
+		return 0, err
+	}
+
+	state := tm.loadState()
+	if tm.Force {
+		state.Chunks = make(map[string]uint64)
+	}
+
+	stamper, _ := tm.Renderer.Format.(ChunkStamper)
+
+	maxZoom := tm.Zooms - 1
+	dirty := make(map[TileCoord]bool)
+
+	for ty := floorDiv(y0, n); ty <= floorDiv(y1-1, n); ty++ {
+		for tx := floorDiv(x0, n); tx <= floorDiv(x1-1, n); tx++ {
+			tc := TileCoord{Zoom: maxZoom, X: tx, Y: ty}
+
+			changed, err := tm.updateStamps(state, stamper, tx*n, ty*n, n)
+			if err != nil {
+				return 0, err
+			}
+
+			_, err = os.Stat(tm.Path(tc))
+			if !changed && err == nil {
+				continue
+			}
+
+			err = tm.renderTile(tc, n)
+			if err != nil {
+				return 0, err
+			}
+
+			dirty[tc] = true
+		}
+	}
+
+	count := len(dirty)
+
+	for zoom := maxZoom; zoom > 0; zoom-- {
+		parents := make(map[TileCoord]bool)
+		for tc := range dirty {
+			parents[tc.Parent()] = true
+		}
+
+		for tc := range parents {
+			err := tm.downsample(tc)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		dirty = parents
+	}
+
+	err = tm.saveState(state)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tm.WriteViewer()
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// updateStamps updates stamps of n x n chunks from x, y
+// It returns whether any chunk is changed
+// Without stamper, chunks are always changed
+func (tm *TileMap) updateStamps(state *tileState, stamper ChunkStamper, x, y, n int) (bool, error) {
+	if stamper == nil {
+		return true, nil
+	}
+
+	changed := false
+	for cy := y; cy < y+n; cy++ {
+		for cx := x; cx < x+n; cx++ {
+			key := chunkKey(cx, cy)
+
+			stamp, ok, err := stamper.ChunkStamp(cx, cy)
+			if err != nil {
+				return false, err
+			}
+
+			old, had := state.Chunks[key]
+			if !ok {
+				if had { // the chunk is removed
+					delete(state.Chunks, key)
+					changed = true
+				}
+
+				continue
+			}
+
+			if !had || old != stamp {
+				state.Chunks[key] = stamp
+				changed = true
+			}
+		}
+	}
+
+	return changed, nil
+}
+
+// renderTile renders a tile at the max zoom
+// If the tile has no chunk, the tile file is removed
+func (tm *TileMap) renderTile(tc TileCoord, n int) error {
+	img, err := tm.Renderer.Render(tc.X*n, tc.Y*n, tc.X*n+n, tc.Y*n+n)
+	if err != nil {
+		return err
+	}
+
+	return tm.writeTile(tc, img)
+}
+
+// downsample makes a tile from 4 tiles at the higher zoom
+func (tm *TileMap) downsample(tc TileCoord) error {
+	size := tm.TileSize
+	img := image.NewRGBA(image.Rect(0, 0, size*2, size*2))
+
+	for i := 0; i < 4; i++ {
+		child := TileCoord{
+			Zoom: tc.Zoom + 1,
+			X:    tc.X*2 + i%2,
+			Y:    tc.Y*2 + i/2,
+		}
+
+		file, err := os.Open(tm.Path(child))
+		if os.IsNotExist(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		src, err := png.Decode(file)
+		file.Close()
+		if err != nil {
+			return err
+		}
+
+		at := image.Pt(i%2*size, i/2*size)
+		draw.Draw(img, image.Rectangle{Min: at, Max: at.Add(image.Pt(size, size))}, src, src.Bounds().Min, draw.Src)
+	}
+
+	return tm.writeTile(tc, half(img))
+}
+
+// writeTile writes a tile
+// If the tile is empty, the tile file is removed
+func (tm *TileMap) writeTile(tc TileCoord, img *image.RGBA) error {
+	path := tm.Path(tc)
+
+	if isEmpty(img) {
+		err := os.Remove(path)
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	return png.Encode(file, img)
+}
+
+// half returns the image scaled to 1/2 by averaging 2x2 pixels
+func half(src *image.RGBA) *image.RGBA {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx()/2, bounds.Dy()/2))
+
+	for y := 0; y < dst.Rect.Dy(); y++ {
+		for x := 0; x < dst.Rect.Dx(); x++ {
+			var sum [4]int
+			for i := 0; i < 4; i++ {
+				off := src.PixOffset(bounds.Min.X+x*2+i%2, bounds.Min.Y+y*2+i/2)
+				for j := 0; j < 4; j++ {
+					sum[j] += int(src.Pix[off+j])
+				}
+			}
+
+			off := dst.PixOffset(x, y)
+			for j := 0; j < 4; j++ {
+				dst.Pix[off+j] = uint8(sum[j] / 4)
+			}
+		}
+	}
+
+	return dst
+}
+
+// isEmpty returns whether the image is fully transparent
+func isEmpty(img *image.RGBA) bool {
+	for i := 3; i < len(img.Pix); i += 4 {
+		if img.Pix[i] != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// floorDiv returns a / b rounded down
+func floorDiv(a, b int) int {
+	if a < 0 {
+		return -((-a + b - 1) / b)
+	}
+
+	return a / b
+}
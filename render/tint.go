@@ -0,0 +1,182 @@
+package render
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"image/color"
+	"strings"
+)
+
+// Tint is a kind of colors multiplied to textures by biome
+type Tint int
+
+const (
+	// TintNone is not tinted
+	TintNone Tint = iota
+
+	// TintGrass is tinted by grass colors
+	TintGrass
+
+	// TintFoliage is tinted by foliage colors
+	TintFoliage
+
+	// TintWater is tinted by water colors
+	TintWater
+)
+
+// TintOf returns a kind of tint for the block name
+func TintOf(name string) Tint {
+	name = strings.TrimPrefix(name, "minecraft:")
+
+	switch name {
+	case "grass_block", "grass", "tall_grass", "fern", "large_fern", "tallgrass", "double_plant", "sugar_cane", "reeds":
+		return TintGrass
+	case "vine", "waterlily", "lily_pad", "oak_leaves", "jungle_leaves", "acacia_leaves", "dark_oak_leaves", "leaves", "leaves2":
+		return TintFoliage
+	case "water", "flowing_water", "cauldron":
+		return TintWater
+	}
+
+	return TintNone
+}
+
+// BiomeColors is colors for tinting of a biome
+type BiomeColors struct {
+	Grass   color.RGBA
+	Foliage color.RGBA
+	Water   color.RGBA
+}
+
+// Color returns a color for the kind of tint
+// It returns white for TintNone
+func (bc BiomeColors) Color(tint Tint) color.RGBA {
+	switch tint {
+	case TintGrass:
+		return bc.Grass
+	case TintFoliage:
+		return bc.Foliage
+	case TintWater:
+		return bc.Water
+	}
+
+	return color.RGBA{255, 255, 255, 255}
+}
+
+func hex(v uint32) color.RGBA {
+	return color.RGBA{
+		R: uint8(v >> 16),
+		G: uint8(v >> 8),
+		B: uint8(v),
+		A: 255,
+	}
+}
+
+func biomeColors(grass, foliage, water uint32) BiomeColors {
+	return BiomeColors{
+		Grass:   hex(grass),
+		Foliage: hex(foliage),
+		Water:   hex(water),
+	}
+}
+
+const defaultWater = 0x3F76E4
+
+// DefaultBiomeColors is colors of plains
+var DefaultBiomeColors = biomeColors(0x91BD59, 0x77AB2F, defaultWater)
+
+// biomes is colors by biome id
+var biomes = map[byte]BiomeColors{
+	0:  biomeColors(0x8EB971, 0x71A74D, defaultWater), // ocean
+	1:  DefaultBiomeColors,                            // plains
+	2:  biomeColors(0xBFB755, 0xAEA42A, defaultWater), // desert
+	3:  biomeColors(0x8AB689, 0x6DA36B, defaultWater), // mountains
+	4:  biomeColors(0x79C05A, 0x59AE30, defaultWater), // forest
+	5:  biomeColors(0x86B783, 0x68A464, defaultWater), // taiga
+	6:  biomeColors(0x6A7039, 0x6A7039, 0x617B64),     // swamp
+	7:  biomeColors(0x8EB971, 0x71A74D, defaultWater), // river
+	8:  biomeColors(0xBFB755, 0xAEA42A, defaultWater), // nether
+	9:  biomeColors(0x8EB971, 0x71A74D, defaultWater), // the end
+	10: biomeColors(0x80B497, 0x60A17B, 0x3938C9),     // frozen ocean
+	11: biomeColors(0x80B497, 0x60A17B, 0x3938C9),     // frozen river
+	12: biomeColors(0x80B497, 0x60A17B, defaultWater), // snowy tundra
+	13: biomeColors(0x80B497, 0x60A17B, defaultWater), // snowy mountains
+	14: biomeColors(0x55C93F, 0x2BBB0F, defaultWater), // mushroom fields
+	15: biomeColors(0x55C93F, 0x2BBB0F, defaultWater), // mushroom field shore
+	16: DefaultBiomeColors,                            // beach
+	17: biomeColors(0xBFB755, 0xAEA42A, defaultWater), // desert hills
+	18: biomeColors(0x79C05A, 0x59AE30, defaultWater), // wooded hills
+	19: biomeColors(0x86B783, 0x68A464, defaultWater), // taiga hills
+	20: biomeColors(0x8AB689, 0x6DA36B, defaultWater), // mountain edge
+	21: biomeColors(0x59C93C, 0x30BB0B, defaultWater), // jungle
+	22: biomeColors(0x59C93C, 0x30BB0B, defaultWater), // jungle hills
+	23: biomeColors(0x64C73F, 0x3EB80F, defaultWater), // jungle edge
+	24: biomeColors(0x8EB971, 0x71A74D, defaultWater), // deep ocean
+	25: biomeColors(0x8AB689, 0x6DA36B, defaultWater), // stone shore
+	26: biomeColors(0x83B593, 0x64A278, defaultWater), // snowy beach
+	27: biomeColors(0x88BB67, 0x6BA941, defaultWater), // birch forest
+	28: biomeColors(0x88BB67, 0x6BA941, defaultWater), // birch forest hills
+	29: biomeColors(0x507A32, 0x59AE30, defaultWater), // dark forest
+	30: biomeColors(0x80B497, 0x60A17B, 0x3D57D6),     // snowy taiga
+	31: biomeColors(0x80B497, 0x60A17B, 0x3D57D6),     // snowy taiga hills
+	32: biomeColors(0x86B87F, 0x68A55F, defaultWater), // giant tree taiga
+	33: biomeColors(0x86B87F, 0x68A55F, defaultWater), // giant tree taiga hills
+	34: biomeColors(0x8AB689, 0x6DA36B, defaultWater), // wooded mountains
+	35: biomeColors(0xBFB755, 0xAEA42A, defaultWater), // savanna
+	36: biomeColors(0xBFB755, 0xAEA42A, defaultWater), // savanna plateau
+	37: biomeColors(0x90814D, 0x9E814D, defaultWater), // badlands
+	38: biomeColors(0x90814D, 0x9E814D, defaultWater), // wooded badlands plateau
+	39: biomeColors(0x90814D, 0x9E814D, defaultWater), // badlands plateau
+	44: biomeColors(0x8EB971, 0x71A74D, 0x43D5EE),     // warm ocean
+	45: biomeColors(0x8EB971, 0x71A74D, 0x45ADF2),     // lukewarm ocean
+	46: biomeColors(0x8EB971, 0x71A74D, 0x3D57D6),     // cold ocean
+	47: biomeColors(0x8EB971, 0x71A74D, 0x43D5EE),     // deep warm ocean
+	48: biomeColors(0x8EB971, 0x71A74D, 0x45ADF2),     // deep lukewarm ocean
+	49: biomeColors(0x8EB971, 0x71A74D, 0x3D57D6),     // deep cold ocean
+	50: biomeColors(0x8EB971, 0x71A74D, 0x3938C9),     // deep frozen ocean
+}
+
+// BiomeColorsOf returns colors for tinting of the biome id
+// Mutated biomes (128 and after) use colors of the base biome
+// Unknown biomes use colors of plains
+func BiomeColorsOf(biome byte) BiomeColors {
+	bc, ok := biomes[biome]
+	if ok {
+		return bc
+	}
+
+	if biome >= 128 {
+		bc, ok = biomes[biome-128]
+		if ok {
+			return bc
+		}
+	}
+
+	return DefaultBiomeColors
+}
+
+// multiply returns the color multiplied by the tint color
+func multiply(c color.RGBA, tint color.RGBA) color.RGBA {
+	return color.RGBA{
+		R: uint8(int(c.R) * int(tint.R) / 255),
+		G: uint8(int(c.G) * int(tint.G) / 255),
+		B: uint8(int(c.B) * int(tint.B) / 255),
+		A: c.A,
+	}
+}
+
+// blend returns a color blended src over dst with the alpha (0-255)
+func blend(dst color.RGBA, src color.RGBA, alpha int) color.RGBA {
+	return color.RGBA{
+		R: uint8((int(src.R)*alpha + int(dst.R)*(255-alpha)) / 255),
+		G: uint8((int(src.G)*alpha + int(dst.G)*(255-alpha)) / 255),
+		B: uint8((int(src.B)*alpha + int(dst.B)*(255-alpha)) / 255),
+		A: 255,
+	}
+}
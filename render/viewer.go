@@ -0,0 +1,133 @@
+package render
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// WriteViewer writes a static html viewer for tiles to the directory
+func (tm *TileMap) WriteViewer() error {
+	html := strings.NewReplacer(
+		"{{tileSize}}", strconv.Itoa(tm.TileSize),
+		"{{maxZoom}}", strconv.Itoa(tm.Zooms-1),
+		"{{scale}}", strconv.Itoa(tm.Renderer.Scale),
+		"{{markersFile}}", TileMarkersFile,
+	).Replace(viewerHTML)
+
+	return ioutil.WriteFile(filepath.Join(tm.Dir, TileViewerFile), []byte(html), os.ModePerm)
+}
+
+// viewerHTML is a web viewer without external libraries
+// Drag to move, wheel to zoom
+const viewerHTML = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>level map</title>
+<style>
+html, body { margin: 0; height: 100%; overflow: hidden; background: #111; }
+#map { position: absolute; inset: 0; cursor: grab; }
+#map img { position: absolute; image-rendering: pixelated; user-select: none; -webkit-user-drag: none; }
+.marker { position: absolute; width: 8px; height: 8px; margin: -5px 0 0 -5px; border: 1px solid #000; border-radius: 50%; }
+.marker.entity { background: #ffd600; } .marker.player { background: #00e0ff; } .marker.spawn { background: #ff2020; }
+.marker.sign { background: #f0dcaa; } .marker.beacon { background: #82fff0; }
+#pos { position: absolute; left: 8px; bottom: 8px; padding: 2px 6px; color: #fff; background: rgba(0, 0, 0, 0.6); font: 12px monospace; }
+</style>
+</head>
+<body>
+<div id="map"></div>
+<div id="pos"></div>
+<script>
+var tileSize = {{tileSize}}, maxZoom = {{maxZoom}}, scale = {{scale}};
+var map = document.getElementById("map"), pos = document.getElementById("pos");
+var params = new URLSearchParams(location.hash.slice(1));
+var view = { x: +params.get("x") || 0, z: +params.get("z") || 0, zoom: params.has("zoom") ? +params.get("zoom") : maxZoom };
+var tiles = {};
+var markers = [];
+
+// markers are loaded from markers.geojson if it exists
+fetch("{{markersFile}}").then(function (res) { return res.ok ? res.json() : { features: [] }; }).then(function (data) {
+	data.features.forEach(function (f) {
+		var el = document.createElement("div"), p = f.properties;
+		el.className = "marker " + p.kind;
+		el.title = (p.label ? p.label + "\n" : "") + p.id + " (" + Math.floor(f.geometry.coordinates[0]) + ", " + Math.floor(p.y) + ", " + Math.floor(f.geometry.coordinates[1]) + ")";
+		map.appendChild(el);
+		markers.push({ el: el, x: f.geometry.coordinates[0], z: f.geometry.coordinates[1] });
+	});
+	draw();
+}).catch(function () {});
+
+// blocks per pixel at the zoom
+function bpp(zoom) { return Math.pow(2, maxZoom - zoom) / scale; }
+
+function draw() {
+	var w = map.clientWidth, h = map.clientHeight, b = bpp(view.zoom), span = tileSize * b;
+	var x0 = Math.floor((view.x - w / 2 * b) / span), x1 = Math.floor((view.x + w / 2 * b) / span);
+	var y0 = Math.floor((view.z - h / 2 * b) / span), y1 = Math.floor((view.z + h / 2 * b) / span);
+	var used = {};
+	for (var ty = y0; ty <= y1; ty++) {
+		for (var tx = x0; tx <= x1; tx++) {
+			var key = view.zoom + "/" + tx + "/" + ty;
+			used[key] = true;
+			var img = tiles[key];
+			if (!img) {
+				img = tiles[key] = document.createElement("img");
+				img.onerror = function () { this.style.visibility = "hidden"; };
+				img.src = key + ".png";
+				map.appendChild(img);
+			}
+			img.style.left = Math.round(w / 2 + (tx * span - view.x) / b) + "px";
+			img.style.top = Math.round(h / 2 + (ty * span - view.z) / b) + "px";
+		}
+	}
+	markers.forEach(function (m) {
+		m.el.style.left = Math.round(w / 2 + (m.x - view.x) / b) + "px";
+		m.el.style.top = Math.round(h / 2 + (m.z - view.z) / b) + "px";
+	});
+	for (var k in tiles) {
+		if (!used[k]) { map.removeChild(tiles[k]); delete tiles[k]; }
+	}
+	history.replaceState(null, "", "#x=" + Math.round(view.x) + "&z=" + Math.round(view.z) + "&zoom=" + view.zoom);
+}
+
+var drag = null;
+map.onmousedown = function (e) { drag = { x: e.clientX, y: e.clientY }; map.style.cursor = "grabbing"; };
+window.onmouseup = function () { drag = null; map.style.cursor = "grab"; };
+window.onmousemove = function (e) {
+	var b = bpp(view.zoom);
+	pos.textContent = "x: " + Math.floor(view.x + (e.clientX - map.clientWidth / 2) * b) + ", z: " + Math.floor(view.z + (e.clientY - map.clientHeight / 2) * b);
+	if (!drag) return;
+	view.x -= (e.clientX - drag.x) * b;
+	view.z -= (e.clientY - drag.y) * b;
+	drag = { x: e.clientX, y: e.clientY };
+	draw();
+};
+map.onwheel = function (e) {
+	e.preventDefault();
+	var zoom = Math.max(0, Math.min(maxZoom, view.zoom + (e.deltaY < 0 ? 1 : -1)));
+	if (zoom == view.zoom) return;
+	// keep the block under the cursor
+	var dx = e.clientX - map.clientWidth / 2, dy = e.clientY - map.clientHeight / 2;
+	view.x += dx * (bpp(view.zoom) - bpp(zoom));
+	view.z += dy * (bpp(view.zoom) - bpp(zoom));
+	view.zoom = zoom;
+	draw();
+};
+window.onresize = draw;
+draw();
+</script>
+</body>
+</html>
+`
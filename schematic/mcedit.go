@@ -0,0 +1,123 @@
+package schematic
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/anvil"
+	"github.com/beito123/level/block"
+	"github.com/beito123/level/util"
+	"github.com/beito123/nbt"
+)
+
+// MaterialsAlpha is the materials of MCEdit schematics for pc edition
+const MaterialsAlpha = "Alpha"
+
+// readMCEdit reads MCEdit schematic
+// Blocks are read as old blocks with id and meta, block ids over 255 (AddBlocks) aren't supported
+func readMCEdit(com *nbt.Compound) (*Schematic, error) {
+	width, height, length, err := readSize(com)
+	if err != nil {
+		return nil, err
+	}
+
+	schem := New(width, height, length)
+
+	blocks, err := com.GetByteArray("Blocks")
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := com.GetByteArray("Data")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(blocks) < len(schem.Blocks) || len(data) < len(schem.Blocks) {
+		return nil, fmt.Errorf("level.schematic: not enough block data")
+	}
+
+	states := make(map[uint16]level.BlockState)
+	for i := range schem.Blocks {
+		key := uint16(blocks[i])<<8 | uint16(data[i]&0x0f)
+
+		bs, ok := states[key]
+		if !ok {
+			bs = anvil.NewLegacyBlockState(blocks[i], data[i]&0x0f)
+			states[key] = bs
+		}
+
+		schem.Blocks[i] = bs
+	}
+
+	schem.BlockEntities, err = util.ReadCompounds(com, "TileEntities")
+	if err != nil {
+		return nil, err
+	}
+
+	schem.Entities, err = util.ReadCompounds(com, "Entities")
+	if err != nil {
+		return nil, err
+	}
+
+	return schem, nil
+}
+
+// ToLegacy returns old block id and meta of a block state
+// nil is returned as air, if a block can't be converted, returns an error
+func ToLegacy(state level.BlockState) (id byte, meta byte, err error) {
+	if state == nil {
+		return 0, 0, nil
+	}
+
+	if i, m, ok := state.ToBlockIDMeta(); ok {
+		return byte(i), byte(m), nil
+	}
+
+	bs, err := anvil.FromBlockState(state)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	id, meta, ok := bs.ToLegacy()
+	if !ok {
+		return 0, 0, fmt.Errorf("level.schematic: couldn't convert %s to a old block id", block.FormatState(bs.Name(), bs.Properties()))
+	}
+
+	return id, meta, nil
+}
+
+func (schem *Schematic) writeMCEdit() (*nbt.Compound, error) {
+	root := nbt.NewCompoundTag("Schematic", make(map[string]nbt.Tag))
+
+	schem.writeSize(root)
+
+	root.Set(nbt.NewStringTag("Materials", MaterialsAlpha))
+
+	blocks := make([]byte, len(schem.Blocks))
+	data := make([]byte, len(schem.Blocks))
+	for i, bs := range schem.Blocks {
+		id, meta, err := ToLegacy(bs)
+		if err != nil {
+			return nil, err
+		}
+
+		blocks[i], data[i] = id, meta
+	}
+
+	root.Set(nbt.NewByteArrayTag("Blocks", blocks))
+	root.Set(nbt.NewByteArrayTag("Data", data))
+	root.Set(util.WriteCompounds("TileEntities", schem.BlockEntities))
+	root.Set(util.WriteCompounds("Entities", schem.Entities))
+
+	return root, nil
+}
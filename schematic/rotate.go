@@ -0,0 +1,262 @@
+package schematic
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/anvil"
+	"github.com/beito123/level/leveldb"
+	"github.com/beito123/nbt"
+)
+
+// Rotation is a clockwise rotation around y axis seen from above
+type Rotation int
+
+const (
+	// Rotate0 doesn't rotate
+	Rotate0 Rotation = iota
+
+	// Rotate90 rotates 90 degrees clockwise
+	Rotate90
+
+	// Rotate180 rotates 180 degrees
+	Rotate180
+
+	// Rotate270 rotates 270 degrees clockwise (90 degrees counterclockwise)
+	Rotate270
+)
+
+// RotationFromDegrees returns Rotation from degrees
+// degrees needs to be a multiple of 90
+func RotationFromDegrees(degrees int) (Rotation, bool) {
+	if degrees%90 != 0 {
+		return Rotate0, false
+	}
+
+	return Rotation(((degrees/90)%4 + 4) % 4), true
+}
+
+// Steps returns the number of 90 degrees steps
+func (rot Rotation) Steps() int {
+	return ((int(rot) % 4) + 4) % 4
+}
+
+// directions is horizontal directions in clockwise order
+var directions = []string{"north", "east", "south", "west"}
+
+func directionIndex(dir string) int {
+	for i, d := range directions {
+		if d == dir {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// rotateDirection rotates a horizontal direction
+// Other values are returned as it is
+func rotateDirection(dir string, steps int) string {
+	i := directionIndex(dir)
+	if i == -1 {
+		return dir
+	}
+
+	return directions[(i+steps)%4]
+}
+
+// rotateShape rotates a shape of rails such as "north_east" or "ascending_west"
+func rotateShape(shape string, steps int) string {
+	words := strings.Split(shape, "_")
+	if len(words) != 2 {
+		return shape
+	}
+
+	if words[0] == "ascending" {
+		return "ascending_" + rotateDirection(words[1], steps)
+	}
+
+	a, b := rotateDirection(words[0], steps), rotateDirection(words[1], steps)
+	ai, bi := directionIndex(a), directionIndex(b)
+	if ai == -1 || bi == -1 {
+		return shape
+	}
+
+	switch {
+	case ai%2 == 0 && bi%2 == 0: // north and south
+		return "north_south"
+	case ai%2 == 1 && bi%2 == 1: // east and west
+		return "east_west"
+	case ai%2 == 1: // north or south is first
+		a, b = b, a
+	}
+
+	return a + "_" + b
+}
+
+// RotateProperties returns properties of a block rotated by rot
+// It supports facing, axis, rotation, rail shapes and connections to sides
+func RotateProperties(properties map[string]string, rot Rotation) map[string]string {
+	steps := rot.Steps()
+
+	result := make(map[string]string, len(properties))
+	for key, val := range properties {
+		switch {
+		case key == "facing":
+			val = rotateDirection(val, steps)
+		case key == "axis":
+			if steps%2 == 1 {
+				switch val {
+				case "x":
+					val = "z"
+				case "z":
+					val = "x"
+				}
+			}
+		case key == "rotation":
+			r, err := strconv.Atoi(val)
+			if err == nil {
+				val = strconv.Itoa((r + steps*4) % 16)
+			}
+		case key == "shape":
+			val = rotateShape(val, steps)
+		case directionIndex(key) != -1: // connections such as fences
+			key = rotateDirection(key, steps)
+		}
+
+		result[key] = val
+	}
+
+	return result
+}
+
+// RotateBlockState returns a block state rotated by rot
+// Block states for mcbe are rotated by their states, old blocks with id and meta aren't rotated
+func RotateBlockState(state level.BlockState, rot Rotation) level.BlockState {
+	if state == nil || rot.Steps() == 0 {
+		return state
+	}
+
+	if rbs, ok := state.(*leveldb.RawBlockState); ok {
+		if !rbs.HasStates() {
+			return state
+		}
+
+		return leveldb.NewRawBlockStateWithStates(rbs.Name(), RotateStates(rbs.States(), rot), rbs.Version())
+	}
+
+	if _, _, ok := state.ToBlockIDMeta(); ok {
+		return state
+	}
+
+	name, properties, ok := state.ToBlockNameProperties()
+	if !ok || len(properties) == 0 {
+		return state
+	}
+
+	return anvil.NewBlockState(name, RotateProperties(properties, rot))
+}
+
+// facingDirections is values of facing_direction for mcbe
+var facingDirections = []string{"down", "up", "north", "south", "west", "east"}
+
+// weirdoDirections is values of weirdo_direction for mcbe
+var weirdoDirections = []string{"east", "west", "south", "north"}
+
+// railDirections is values of rail_direction for mcbe
+var railDirections = []string{
+	"north_south", "east_west", "ascending_east", "ascending_west", "ascending_north",
+	"ascending_south", "south_east", "south_west", "north_west", "north_east",
+}
+
+// RotateStates returns states of a block for mcbe rotated by rot
+// It supports directions, axis, sign rotations and rails, types of tags are kept
+func RotateStates(states *nbt.Compound, rot Rotation) *nbt.Compound {
+	steps := rot.Steps()
+
+	result := nbt.NewCompoundTag(states.Name(), make(map[string]nbt.Tag, len(states.Value)))
+	for key, tag := range states.Value {
+		result.Value[key] = tag
+
+		if s, err := tag.ToString(); err == nil && tag.ID() == nbt.IDTagString {
+			switch key {
+			case "torch_facing_direction":
+				result.Value[key] = nbt.NewStringTag(key, rotateDirection(s, steps))
+			case "pillar_axis", "portal_axis":
+				if steps%2 == 1 {
+					switch s {
+					case "x":
+						result.Value[key] = nbt.NewStringTag(key, "z")
+					case "z":
+						result.Value[key] = nbt.NewStringTag(key, "x")
+					}
+				}
+			}
+
+			continue
+		}
+
+		n, err := tag.ToInt()
+		if err != nil {
+			continue
+		}
+
+		switch key {
+		case "facing_direction":
+			n = rotateIndex(facingDirections, n, func(dir string) string { return rotateDirection(dir, steps) })
+		case "direction":
+			n = (n + steps) % 4
+		case "weirdo_direction":
+			n = rotateIndex(weirdoDirections, n, func(dir string) string { return rotateDirection(dir, steps) })
+		case "ground_sign_direction":
+			n = (n + steps*4) % 16
+		case "rail_direction":
+			n = rotateIndex(railDirections, n, func(shape string) string { return rotateShape(shape, steps) })
+		default:
+			continue
+		}
+
+		result.Value[key] = intTag(tag, n)
+	}
+
+	return result
+}
+
+// rotateIndex rotates a value which is an index of values
+// Values out of range are returned as it is
+func rotateIndex(values []string, n int, rotate func(val string) string) int {
+	if n < 0 || n >= len(values) {
+		return n
+	}
+
+	val := rotate(values[n])
+	for i, v := range values {
+		if v == val {
+			return i
+		}
+	}
+
+	return n
+}
+
+// intTag returns a tag which has the same type as tag with n
+func intTag(tag nbt.Tag, n int) nbt.Tag {
+	switch tag.ID() {
+	case nbt.IDTagByte:
+		return nbt.NewByteTag(tag.Name(), int8(n))
+	case nbt.IDTagShort:
+		return nbt.NewShortTag(tag.Name(), int16(n))
+	}
+
+	return nbt.NewIntTag(tag.Name(), int32(n))
+}
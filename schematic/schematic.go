@@ -0,0 +1,390 @@
+package schematic
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/anvil"
+	"github.com/beito123/level/asset"
+	"github.com/beito123/level/block"
+	"github.com/beito123/level/blockentity"
+	"github.com/beito123/level/edit"
+	"github.com/beito123/level/leveldb"
+	"github.com/beito123/level/util"
+	"github.com/beito123/nbt"
+)
+
+// Format is a file format of schematics
+type Format int
+
+const (
+	// FormatSpongeV2 is Sponge Schematic v2 (.schem)
+	FormatSpongeV2 Format = iota
+
+	// FormatSpongeV3 is Sponge Schematic v3 (.schem)
+	FormatSpongeV3
+
+	// FormatMCEdit is MCEdit schematic (.schematic) with old block ids
+	FormatMCEdit
+)
+
+// Name returns the name of the format
+func (format Format) Name() string {
+	switch format {
+	case FormatSpongeV2:
+		return "sponge_v2"
+	case FormatSpongeV3:
+		return "sponge_v3"
+	case FormatMCEdit:
+		return "mcedit"
+	}
+
+	return "unknown"
+}
+
+// New returns new Schematic filled with nil blocks
+func New(width, height, length int) *Schematic {
+	return &Schematic{
+		Clipboard:   edit.NewClipboard(width, height, length),
+		DataVersion: anvil.DefaultDataVersion,
+	}
+}
+
+// Schematic is a structure which can be saved as a schematic file
+// nil blocks are saved as air
+type Schematic struct {
+	*edit.Clipboard
+
+	// Offset is a offset from the origin for pasting (Sponge)
+	Offset [3]int
+
+	// DataVersion is a data version of blocks (Sponge)
+	DataVersion int
+
+	// Entities is entities in the file
+	// They are kept when the schematic is read and written, but not pasted
+	Entities []*nbt.Compound
+
+	// Metadata is optional metadata (Sponge)
+	Metadata *nbt.Compound
+}
+
+// Export copies blocks and block entities in the box as a Schematic
+// Blocks for mcbe are converted to blocks for mcje
+func Export(e *edit.Editor, box edit.Box) (*Schematic, error) {
+	clip, err := e.Copy(box)
+	if err != nil {
+		return nil, err
+	}
+
+	converted := make(map[level.BlockState]level.BlockState)
+	for i, bs := range clip.Blocks {
+		if bs == nil {
+			continue
+		}
+
+		result, ok := converted[bs]
+		if !ok {
+			result, err = ToJavaState(bs)
+			if err != nil {
+				return nil, err
+			}
+
+			converted[bs] = result
+		}
+
+		clip.Blocks[i] = result
+	}
+
+	schem := &Schematic{
+		Clipboard:   clip,
+		DataVersion: anvil.DefaultDataVersion,
+	}
+
+	if tag, ok := e.Format.Property(anvil.TagDataVersion); ok {
+		ver, err := tag.ToInt()
+		if err == nil {
+			schem.DataVersion = ver
+		}
+	}
+
+	return schem, nil
+}
+
+// Paste pastes blocks and block entities at world coordinate x, y, z added Offset as the minimum corner
+// The schematic is rotated by rot before pasting, returns the number of set blocks
+// Blocks are converted for the edition of the world, and if a block can't be converted, returns an error before pasting
+func (schem *Schematic) Paste(e *edit.Editor, x, y, z int, rot Rotation) (int, error) {
+	if editionOf(e.Format) == asset.BedrockEdition {
+		checked := make(map[level.BlockState]bool)
+		for _, bs := range schem.Blocks {
+			if bs == nil || checked[bs] {
+				continue
+			}
+
+			_, _, _, err := leveldb.SplitRawBlockState(bs)
+			if err != nil {
+				return 0, err
+			}
+
+			checked[bs] = true
+		}
+	}
+
+	rotated := schem.Rotate(rot)
+
+	return e.Paste(rotated.Clipboard, x+schem.Offset[0], y+schem.Offset[1], z+schem.Offset[2])
+}
+
+// Rotate returns new Schematic rotated by rot
+// Block states and coordinates of block entities are rotated, but Offset isn't changed
+func (schem *Schematic) Rotate(rot Rotation) *Schematic {
+	steps := rot.Steps()
+
+	width, length := schem.Width, schem.Length
+	if steps%2 == 1 {
+		width, length = length, width
+	}
+
+	result := New(width, schem.Height, length)
+	result.Offset = schem.Offset
+	result.DataVersion = schem.DataVersion
+	result.Entities = schem.Entities
+	result.Metadata = schem.Metadata
+
+	for y := 0; y < schem.Height; y++ {
+		for z := 0; z < schem.Length; z++ {
+			for x := 0; x < schem.Width; x++ {
+				rx, rz := schem.rotatePos(x, z, steps)
+
+				result.Set(rx, y, rz, RotateBlockState(schem.Get(x, y, z), rot))
+			}
+		}
+	}
+
+	for _, com := range schem.BlockEntities {
+		pos, ok := blockentity.PosOf(com)
+		if !ok {
+			continue
+		}
+
+		rx, rz := schem.rotatePos(pos.X, pos.Z, steps)
+
+		result.BlockEntities = append(result.BlockEntities, util.WithPos(com, rx, pos.Y, rz))
+	}
+
+	return result
+}
+
+// rotatePos rotates a coordinate in the schematic clockwise
+func (schem *Schematic) rotatePos(x, z int, steps int) (int, int) {
+	width, length := schem.Width, schem.Length
+	for i := 0; i < steps; i++ {
+		x, z = length-1-z, x
+		width, length = length, width
+	}
+
+	return x, z
+}
+
+// Read reads a schematic, the format is detected
+func Read(reader io.Reader) (*Schematic, Format, error) {
+	b, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	stream, err := nbt.FromBytes(b, nbt.BigEndian)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	tag, err := stream.ReadTag()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	root, ok := tag.(*nbt.Compound)
+	if !ok {
+		return nil, 0, errors.New("unexpected " + nbt.GetTagName(tag.ID()) + "Tag, expected CompoundTag")
+	}
+
+	switch {
+	case root.Has("Schematic"):
+		com, err := root.GetCompound("Schematic")
+		if err != nil {
+			return nil, 0, err
+		}
+
+		schem, err := readSpongeV3(com)
+
+		return schem, FormatSpongeV3, err
+	case root.Has("Version"):
+		schem, err := readSpongeV2(root)
+
+		return schem, FormatSpongeV2, err
+	case root.Has("Blocks"):
+		schem, err := readMCEdit(root)
+
+		return schem, FormatMCEdit, err
+	}
+
+	return nil, 0, fmt.Errorf("level.schematic: unknown schematic format")
+}
+
+// ReadFile reads a schematic file
+func ReadFile(path string) (*Schematic, Format, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer file.Close()
+
+	return Read(file)
+}
+
+// Write writes the schematic with the format, it's compressed with gzip
+func (schem *Schematic) Write(writer io.Writer, format Format) error {
+	var root *nbt.Compound
+	var err error
+
+	switch format {
+	case FormatSpongeV2:
+		root, err = schem.writeSpongeV2()
+	case FormatSpongeV3:
+		root, err = schem.writeSpongeV3()
+	case FormatMCEdit:
+		root, err = schem.writeMCEdit()
+	default:
+		return fmt.Errorf("level.schematic: unknown schematic format")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	stream := nbt.NewStream(nbt.BigEndian)
+
+	err = stream.WriteTag(util.FixArrays(root))
+	if err != nil {
+		return err
+	}
+
+	b, err := nbt.Compress(stream, nbt.CompressGZip, nbt.DefaultCompressionLevel)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(writer, bytes.NewReader(b))
+
+	return err
+}
+
+// WriteFile writes the schematic to a file with the format
+func (schem *Schematic) WriteFile(path string, format Format) error {
+	buf := new(bytes.Buffer)
+
+	err := schem.Write(buf, format)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, buf.Bytes(), os.ModePerm)
+}
+
+// StateString returns a block state string for a palette
+// nil is treated as air
+func StateString(state level.BlockState) string {
+	if state == nil {
+		return "minecraft:air"
+	}
+
+	name, properties, ok := state.ToBlockNameProperties()
+	if !ok {
+		return state.Name()
+	}
+
+	return block.FormatState(name, properties)
+}
+
+// ToJavaState converts a block state to a block state for mcje v1.13
+// Block states for mcje are returned as it is
+func ToJavaState(state level.BlockState) (level.BlockState, error) {
+	rbs, ok := state.(*leveldb.RawBlockState)
+	if !ok {
+		return state, nil
+	}
+
+	name, properties, err := leveldb.JavaBlockState(rbs)
+	if err != nil {
+		return nil, err
+	}
+
+	return anvil.NewBlockState(name, properties), nil
+}
+
+// editioner is a format which has a edition
+type editioner interface {
+	Edition() asset.Edition
+}
+
+// editionOf returns the edition of the format
+func editionOf(format level.Format) asset.Edition {
+	if f, ok := format.(editioner); ok {
+		return f.Edition()
+	}
+
+	return asset.JavaEdition
+}
+
+// ParseStateString returns a block state from a block state string
+func ParseStateString(s string) (level.BlockState, error) {
+	name, properties, err := block.ParseState(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return anvil.NewBlockState(name, properties), nil
+}
+
+// readSize reads Width, Height and Length
+func readSize(com *nbt.Compound) (width, height, length int, err error) {
+	w, err := com.GetShort("Width")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	h, err := com.GetShort("Height")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	l, err := com.GetShort("Length")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return int(uint16(w)), int(uint16(h)), int(uint16(l)), nil
+}
+
+// writeSize writes Width, Height and Length
+func (schem *Schematic) writeSize(com *nbt.Compound) {
+	com.Set(nbt.NewShortTag("Width", int16(schem.Width)))
+	com.Set(nbt.NewShortTag("Height", int16(schem.Height)))
+	com.Set(nbt.NewShortTag("Length", int16(schem.Length)))
+}
@@ -0,0 +1,394 @@
+package schematic
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/blockentity"
+	"github.com/beito123/level/util"
+	"github.com/beito123/nbt"
+)
+
+// readSpongeV2 reads Sponge Schematic v1 and v2
+func readSpongeV2(com *nbt.Compound) (*Schematic, error) {
+	schem, err := readSpongeHeader(com)
+	if err != nil {
+		return nil, err
+	}
+
+	palette, err := com.GetCompound("Palette")
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := com.GetByteArray("BlockData")
+	if err != nil {
+		return nil, err
+	}
+
+	err = schem.readBlocks(palette, data)
+	if err != nil {
+		return nil, err
+	}
+
+	name := "BlockEntities"
+	if !com.Has(name) {
+		name = "TileEntities" // v1
+	}
+
+	entities, err := util.ReadCompounds(com, name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, be := range entities {
+		result, err := readSpongeBlockEntity(be, be)
+		if err != nil {
+			return nil, err
+		}
+
+		schem.BlockEntities = append(schem.BlockEntities, result)
+	}
+
+	schem.Entities, err = util.ReadCompounds(com, "Entities")
+	if err != nil {
+		return nil, err
+	}
+
+	return schem, nil
+}
+
+// readSpongeV3 reads Sponge Schematic v3
+func readSpongeV3(com *nbt.Compound) (*Schematic, error) {
+	schem, err := readSpongeHeader(com)
+	if err != nil {
+		return nil, err
+	}
+
+	if com.Has("Blocks") {
+		blocks, err := com.GetCompound("Blocks")
+		if err != nil {
+			return nil, err
+		}
+
+		palette, err := blocks.GetCompound("Palette")
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := blocks.GetByteArray("Data")
+		if err != nil {
+			return nil, err
+		}
+
+		err = schem.readBlocks(palette, data)
+		if err != nil {
+			return nil, err
+		}
+
+		entities, err := util.ReadCompounds(blocks, "BlockEntities")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, be := range entities {
+			data := be
+			if be.Has("Data") {
+				data, err = be.GetCompound("Data")
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			result, err := readSpongeBlockEntity(be, data)
+			if err != nil {
+				return nil, err
+			}
+
+			schem.BlockEntities = append(schem.BlockEntities, result)
+		}
+	}
+
+	schem.Entities, err = util.ReadCompounds(com, "Entities")
+	if err != nil {
+		return nil, err
+	}
+
+	return schem, nil
+}
+
+// readSpongeHeader reads common values of Sponge Schematic
+func readSpongeHeader(com *nbt.Compound) (*Schematic, error) {
+	width, height, length, err := readSize(com)
+	if err != nil {
+		return nil, err
+	}
+
+	schem := New(width, height, length)
+
+	if com.Has("DataVersion") {
+		ver, err := com.GetInt("DataVersion")
+		if err != nil {
+			return nil, err
+		}
+
+		schem.DataVersion = int(ver)
+	}
+
+	if com.Has("Offset") {
+		offset, err := com.GetIntArray("Offset")
+		if err != nil {
+			return nil, err
+		}
+
+		for i := 0; i < len(offset) && i < len(schem.Offset); i++ {
+			schem.Offset[i] = int(offset[i])
+		}
+	}
+
+	if com.Has("Metadata") {
+		schem.Metadata, err = com.GetCompound("Metadata")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return schem, nil
+}
+
+// readBlocks reads a palette and block data encoded as varints
+func (schem *Schematic) readBlocks(palette *nbt.Compound, data []byte) error {
+	states := make(map[int]level.BlockState, len(palette.Value))
+	for key, tag := range palette.Value {
+		index, err := tag.ToInt()
+		if err != nil {
+			return err
+		}
+
+		states[index], err = ParseStateString(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	indexes, err := ReadVarInts(data, len(schem.Blocks))
+	if err != nil {
+		return err
+	}
+
+	for i, index := range indexes {
+		bs, ok := states[index]
+		if !ok {
+			return fmt.Errorf("level.schematic: couldn't find a palette for the block (%d)", index)
+		}
+
+		schem.Blocks[i] = bs
+	}
+
+	return nil
+}
+
+// readSpongeBlockEntity returns a block entity with x, y, z and id from a Sponge block entity
+// data is the compound which has values of the block entity
+func readSpongeBlockEntity(be *nbt.Compound, data *nbt.Compound) (*nbt.Compound, error) {
+	pos, err := be.GetIntArray("Pos")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pos) < 3 {
+		return nil, fmt.Errorf("level.schematic: invaild position of a block entity")
+	}
+
+	id, err := be.GetString("Id")
+	if err != nil {
+		return nil, err
+	}
+
+	result := nbt.NewCompoundTag("", make(map[string]nbt.Tag))
+	for name, tag := range data.Value {
+		if data == be && (name == "Pos" || name == "Id") {
+			continue
+		}
+
+		result.Value[name] = tag
+	}
+
+	result.Set(nbt.NewStringTag("id", id))
+
+	return util.WithPos(result, int(pos[0]), int(pos[1]), int(pos[2])), nil
+}
+
+// spongeBlockEntity returns Pos, Id and the other values of a block entity
+func spongeBlockEntity(com *nbt.Compound) (pos *nbt.IntArray, id *nbt.String, data *nbt.Compound) {
+	at, _ := blockentity.PosOf(com)
+
+	data = nbt.NewCompoundTag("Data", make(map[string]nbt.Tag))
+	for name, tag := range com.Value {
+		switch name {
+		case "x", "y", "z", "id":
+			continue
+		}
+
+		data.Value[name] = tag
+	}
+
+	name, _ := com.GetString("id")
+
+	return nbt.NewIntArrayTag("Pos", []int32{int32(at.X), int32(at.Y), int32(at.Z)}), nbt.NewStringTag("Id", name), data
+}
+
+// writePalette returns a palette and block data encoded as varints
+func (schem *Schematic) writePalette() (*nbt.Compound, []byte, int) {
+	palette := nbt.NewCompoundTag("Palette", make(map[string]nbt.Tag))
+	indexes := make(map[string]int)
+
+	var data []byte
+	for _, bs := range schem.Blocks {
+		key := StateString(bs)
+
+		index, ok := indexes[key]
+		if !ok {
+			index = len(indexes)
+			indexes[key] = index
+
+			palette.Set(nbt.NewIntTag(key, int32(index)))
+		}
+
+		data = PutVarInt(data, index)
+	}
+
+	return palette, data, len(indexes)
+}
+
+// writeSpongeHeader writes common values of Sponge Schematic
+func (schem *Schematic) writeSpongeHeader(com *nbt.Compound, version int) {
+	com.Set(nbt.NewIntTag("Version", int32(version)))
+	com.Set(nbt.NewIntTag("DataVersion", int32(schem.DataVersion)))
+
+	schem.writeSize(com)
+
+	com.Set(nbt.NewIntArrayTag("Offset", []int32{
+		int32(schem.Offset[0]), int32(schem.Offset[1]), int32(schem.Offset[2]),
+	}))
+
+	if schem.Metadata != nil {
+		metadata := *schem.Metadata
+		metadata.SetName("Metadata")
+
+		com.Set(&metadata)
+	}
+
+	if len(schem.Entities) > 0 {
+		com.Set(util.WriteCompounds("Entities", schem.Entities))
+	}
+}
+
+func (schem *Schematic) writeSpongeV2() (*nbt.Compound, error) {
+	root := nbt.NewCompoundTag("Schematic", make(map[string]nbt.Tag))
+
+	schem.writeSpongeHeader(root, 2)
+
+	palette, data, max := schem.writePalette()
+
+	root.Set(nbt.NewIntTag("PaletteMax", int32(max)))
+	root.Set(palette)
+	root.Set(nbt.NewByteArrayTag("BlockData", data))
+
+	entities := make([]*nbt.Compound, len(schem.BlockEntities))
+	for i, be := range schem.BlockEntities {
+		pos, id, com := spongeBlockEntity(be)
+
+		com.SetName("")
+		com.Set(pos)
+		com.Set(id)
+
+		entities[i] = com
+	}
+
+	root.Set(util.WriteCompounds("BlockEntities", entities))
+
+	return root, nil
+}
+
+func (schem *Schematic) writeSpongeV3() (*nbt.Compound, error) {
+	com := nbt.NewCompoundTag("Schematic", make(map[string]nbt.Tag))
+
+	schem.writeSpongeHeader(com, 3)
+
+	palette, data, _ := schem.writePalette()
+
+	blocks := nbt.NewCompoundTag("Blocks", make(map[string]nbt.Tag))
+	blocks.Set(palette)
+	blocks.Set(nbt.NewByteArrayTag("Data", data))
+
+	entities := make([]*nbt.Compound, len(schem.BlockEntities))
+	for i, be := range schem.BlockEntities {
+		pos, id, data := spongeBlockEntity(be)
+
+		entities[i] = nbt.NewCompoundTag("", map[string]nbt.Tag{
+			"Pos":  pos,
+			"Id":   id,
+			"Data": data,
+		})
+	}
+
+	blocks.Set(util.WriteCompounds("BlockEntities", entities))
+
+	com.Set(blocks)
+
+	return nbt.NewCompoundTag("", map[string]nbt.Tag{
+		"Schematic": com,
+	}), nil
+}
+
+// ReadVarInts reads count varints from bytes
+func ReadVarInts(b []byte, count int) ([]int, error) {
+	result := make([]int, 0, count)
+
+	var value, shift int
+	for _, v := range b {
+		value |= int(v&0x7f) << uint(shift)
+		if v&0x80 != 0 {
+			shift += 7
+			if shift > 35 {
+				return nil, fmt.Errorf("level.schematic: too big varint")
+			}
+
+			continue
+		}
+
+		result = append(result, value)
+		value, shift = 0, 0
+
+		if len(result) == count {
+			break
+		}
+	}
+
+	if len(result) != count {
+		return nil, fmt.Errorf("level.schematic: not enough block data")
+	}
+
+	return result, nil
+}
+
+// PutVarInt appends a varint to b
+func PutVarInt(b []byte, value int) []byte {
+	v := uint32(value)
+	for v >= 0x80 {
+		b = append(b, byte(v)|0x80)
+		v >>= 7
+	}
+
+	return append(b, byte(v))
+}
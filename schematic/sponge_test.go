@@ -0,0 +1,134 @@
+package schematic
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"bytes"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/beito123/level/anvil"
+	"github.com/beito123/level/asset"
+	"github.com/beito123/level/blockentity"
+	"github.com/beito123/nbt"
+)
+
+func TestVarInts(t *testing.T) {
+	tests := []struct {
+		value int
+		size  int
+	}{
+		{value: 0, size: 1},
+		{value: 127, size: 1},
+		{value: 128, size: 2},
+		{value: 300, size: 2},
+		{value: 16383, size: 2},
+		{value: 16384, size: 3},
+		{value: 1 << 28, size: 5},
+	}
+
+	var b []byte
+	values := make([]int, len(tests))
+	for i, test := range tests {
+		n := len(b)
+		b = PutVarInt(b, test.value)
+
+		if len(b)-n != test.size {
+			t.Errorf("%d: expected %d bytes, but got %d", test.value, test.size, len(b)-n)
+		}
+
+		values[i] = test.value
+	}
+
+	read, err := ReadVarInts(b, len(values))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(read, values) {
+		t.Errorf("expected %v, but got %v", values, read)
+	}
+
+	_, err = ReadVarInts(b[:len(b)-1], len(values))
+	if err == nil {
+		t.Errorf("expected an error for truncated data")
+	}
+}
+
+// newTestSchematic returns a schematic which has over 128 palettes, indexes need 2 bytes varints
+func newTestSchematic() *Schematic {
+	schem := New(20, 3, 5)
+	schem.Offset = [3]int{-1, 2, -3}
+
+	for i := range schem.Blocks {
+		if i%7 == 0 {
+			continue // nil is air
+		}
+
+		schem.Blocks[i] = anvil.NewBlockState("minecraft:stone", map[string]string{
+			"test": strconv.Itoa(i),
+		})
+	}
+
+	chest := blockentity.NewChest(asset.JavaEdition, 1, 2, 3)
+	chest.SetCustomName("test")
+
+	schem.BlockEntities = []*nbt.Compound{chest.Compound}
+
+	return schem
+}
+
+func TestSpongeReadWrite(t *testing.T) {
+	for _, format := range []Format{FormatSpongeV2, FormatSpongeV3} {
+		schem := newTestSchematic()
+
+		buf := new(bytes.Buffer)
+
+		err := schem.Write(buf, format)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		read, readFormat, err := Read(buf)
+		if err != nil {
+			t.Fatalf("%s: %s", format.Name(), err)
+		}
+
+		if readFormat != format {
+			t.Errorf("%s: detected as %s", format.Name(), readFormat.Name())
+		}
+
+		if read.Width != schem.Width || read.Height != schem.Height || read.Length != schem.Length {
+			t.Fatalf("%s: expected size %dx%dx%d, but got %dx%dx%d", format.Name(),
+				schem.Width, schem.Height, schem.Length, read.Width, read.Height, read.Length)
+		}
+
+		if read.Offset != schem.Offset || read.DataVersion != schem.DataVersion {
+			t.Errorf("%s: offset or data version is different", format.Name())
+		}
+
+		for i, bs := range schem.Blocks {
+			if StateString(read.Blocks[i]) != StateString(bs) {
+				t.Errorf("%s: block %d: expected %s, but got %s", format.Name(), i, StateString(bs), StateString(read.Blocks[i]))
+			}
+		}
+
+		if len(read.BlockEntities) != 1 {
+			t.Fatalf("%s: expected a block entity, but got %d", format.Name(), len(read.BlockEntities))
+		}
+
+		pos, _ := blockentity.PosOf(read.BlockEntities[0])
+		chest, ok := blockentity.AsChest(read.BlockEntities[0], asset.JavaEdition)
+		if !ok || pos != (blockentity.Pos{X: 1, Y: 2, Z: 3}) || chest.CustomName() != "test" {
+			t.Errorf("%s: the block entity is different", format.Name())
+		}
+	}
+}
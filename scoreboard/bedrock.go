@@ -0,0 +1,185 @@
+package scoreboard
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"errors"
+
+	"github.com/beito123/nbt"
+)
+
+// readBedrock reads a scoreboard of mcbe
+func readBedrock(sb *Scoreboard, com *nbt.Compound) error {
+	if com.Has("LastUniqueID") {
+		var err error
+		sb.lastID, err = com.GetLong("LastUniqueID")
+		if err != nil {
+			return err
+		}
+	}
+
+	entries, err := getCompounds(com, "Entries")
+	if err != nil {
+		return err
+	}
+
+	ids := make(map[int64]*Participant, len(entries))
+	for _, entry := range entries {
+		p := &Participant{
+			Type: ParticipantType(getInt(entry, "IdentityType")),
+		}
+
+		p.ScoreboardID, err = entry.GetLong("ScoreboardId")
+		if err != nil {
+			return err
+		}
+
+		switch p.Type {
+		case ParticipantPlayer:
+			p.ID, err = entry.GetLong("PlayerId")
+		case ParticipantEntity:
+			p.ID, err = entry.GetLong("EntityId")
+		case ParticipantFakePlayer:
+			p.Name, err = entry.GetString("FakePlayerName")
+		default:
+			err = errors.New("level.scoreboard: unknown identity type")
+		}
+
+		if err != nil {
+			return err
+		}
+
+		ids[p.ScoreboardID] = p
+		sb.Participants = append(sb.Participants, p)
+	}
+
+	objectives, err := getCompounds(com, "Objectives")
+	if err != nil {
+		return err
+	}
+
+	for _, o := range objectives {
+		obj := &Objective{
+			Name:        getString(o, "Name"),
+			DisplayName: getString(o, "DisplayName"),
+			Criteria:    getString(o, "Criteria"),
+		}
+
+		scores, err := getCompounds(o, "Scores")
+		if err != nil {
+			return err
+		}
+
+		for _, s := range scores {
+			id, err := s.GetLong("ScoreboardId")
+			if err != nil {
+				return err
+			}
+
+			p, ok := ids[id]
+			if !ok { // broken score
+				continue
+			}
+
+			obj.Scores = append(obj.Scores, &Score{
+				Participant: p,
+				Value:       getInt(s, "Score"),
+			})
+		}
+
+		sb.Objectives = append(sb.Objectives, obj)
+	}
+
+	displays, err := getCompounds(com, "DisplayObjectives")
+	if err != nil {
+		return err
+	}
+
+	for _, display := range displays {
+		slot := getString(display, "Name")
+
+		sb.DisplaySlots[slot] = getString(display, "ObjectiveName")
+		sb.sortOrders[slot] = int8(getInt(display, "SortOrder"))
+	}
+
+	return nil
+}
+
+// writeBedrock writes a scoreboard of mcbe to the compound
+func writeBedrock(sb *Scoreboard, com *nbt.Compound) {
+	entries := make([]*nbt.Compound, 0, len(sb.Participants))
+	for _, p := range sb.Participants {
+		if p.ScoreboardID == 0 {
+			p.ScoreboardID = sb.newID()
+		}
+
+		if p.ScoreboardID > sb.lastID {
+			sb.lastID = p.ScoreboardID
+		}
+
+		entry := nbt.NewCompoundTag("", map[string]nbt.Tag{
+			"IdentityType": nbt.NewByteTag("IdentityType", int8(p.Type)),
+			"ScoreboardId": nbt.NewLongTag("ScoreboardId", p.ScoreboardID),
+		})
+
+		switch p.Type {
+		case ParticipantPlayer:
+			entry.Set(nbt.NewLongTag("PlayerId", p.ID))
+		case ParticipantEntity:
+			entry.Set(nbt.NewLongTag("EntityId", p.ID))
+		default:
+			entry.Set(nbt.NewByteTag("IdentityType", int8(ParticipantFakePlayer)))
+			entry.Set(nbt.NewStringTag("FakePlayerName", p.Name))
+		}
+
+		entries = append(entries, entry)
+	}
+
+	objectives := make([]*nbt.Compound, len(sb.Objectives))
+	for i, obj := range sb.Objectives {
+		scores := make([]*nbt.Compound, len(obj.Scores))
+		for j, s := range obj.Scores {
+			scores[j] = nbt.NewCompoundTag("", map[string]nbt.Tag{
+				"Score":        nbt.NewIntTag("Score", int32(s.Value)),
+				"ScoreboardId": nbt.NewLongTag("ScoreboardId", s.Participant.ScoreboardID),
+			})
+		}
+
+		objectives[i] = nbt.NewCompoundTag("", map[string]nbt.Tag{
+			"Name":        nbt.NewStringTag("Name", obj.Name),
+			"DisplayName": nbt.NewStringTag("DisplayName", obj.DisplayName),
+			"Criteria":    nbt.NewStringTag("Criteria", obj.Criteria),
+			"Scores":      toList("Scores", scores),
+		})
+	}
+
+	displays := make([]*nbt.Compound, 0, len(sb.DisplaySlots))
+	for _, slot := range []string{SlotList, SlotSidebar, SlotBelowName} {
+		name, ok := sb.DisplaySlots[slot]
+		if !ok {
+			continue
+		}
+
+		displays = append(displays, nbt.NewCompoundTag("", map[string]nbt.Tag{
+			"Name":          nbt.NewStringTag("Name", slot),
+			"ObjectiveName": nbt.NewStringTag("ObjectiveName", name),
+			"SortOrder":     nbt.NewByteTag("SortOrder", sb.sortOrders[slot]),
+		}))
+	}
+
+	com.Set(toList("Entries", entries))
+	com.Set(toList("Objectives", objectives))
+	com.Set(toList("DisplayObjectives", displays))
+	com.Set(nbt.NewLongTag("LastUniqueID", sb.lastID))
+
+	if !com.Has("Criteria") {
+		com.Set(nbt.NewListTag("Criteria", nil, nbt.IDTagCompound))
+	}
+}
@@ -0,0 +1,180 @@
+package scoreboard
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/beito123/nbt"
+)
+
+// javaSlots is display slot names of mcje
+var javaSlots = map[string]string{
+	"slot_0": SlotList,
+	"slot_1": SlotSidebar,
+	"slot_2": SlotBelowName,
+}
+
+// readJava reads a scoreboard of mcje
+func readJava(sb *Scoreboard, com *nbt.Compound) error {
+	objectives, err := getCompounds(com, "Objectives")
+	if err != nil {
+		return err
+	}
+
+	for _, o := range objectives {
+		raw := getString(o, "DisplayName")
+
+		sb.Objectives = append(sb.Objectives, &Objective{
+			Name:           getString(o, "Name"),
+			DisplayName:    fromJSONText(raw),
+			Criteria:       getString(o, "CriteriaName"),
+			RenderType:     getString(o, "RenderType"),
+			rawDisplayName: raw,
+		})
+	}
+
+	scores, err := getCompounds(com, "PlayerScores")
+	if err != nil {
+		return err
+	}
+
+	for _, s := range scores {
+		obj, ok := sb.Objective(getString(s, "Objective"))
+		if !ok { // broken score
+			continue
+		}
+
+		obj.Scores = append(obj.Scores, &Score{
+			Participant: sb.AddParticipant(getString(s, "Name")),
+			Value:       getInt(s, "Score"),
+			Locked:      getInt(s, "Locked") != 0,
+		})
+	}
+
+	sb.Teams, err = getCompounds(com, "Teams")
+	if err != nil {
+		return err
+	}
+
+	if com.Has("DisplaySlots") {
+		slots, err := com.GetCompound("DisplaySlots")
+		if err != nil {
+			return err
+		}
+
+		for key, tag := range slots.Value {
+			name, err := tag.ToString()
+			if err != nil {
+				return err
+			}
+
+			slot, ok := javaSlots[key]
+			if !ok {
+				slot = key
+			}
+
+			sb.DisplaySlots[slot] = name
+		}
+	}
+
+	return nil
+}
+
+// writeJava writes a scoreboard of mcje to the compound
+func writeJava(sb *Scoreboard, com *nbt.Compound) {
+	objectives := make([]*nbt.Compound, len(sb.Objectives))
+	scores := make([]*nbt.Compound, 0)
+	for i, obj := range sb.Objectives {
+		renderType := obj.RenderType
+		if renderType == "" {
+			renderType = "integer"
+		}
+
+		// keep styles of the json text if the display name isn't changed
+		displayName := obj.rawDisplayName
+		if fromJSONText(displayName) != obj.DisplayName || displayName == "" {
+			displayName = toJSONText(obj.DisplayName)
+		}
+
+		objectives[i] = nbt.NewCompoundTag("", map[string]nbt.Tag{
+			"Name":         nbt.NewStringTag("Name", obj.Name),
+			"DisplayName":  nbt.NewStringTag("DisplayName", displayName),
+			"CriteriaName": nbt.NewStringTag("CriteriaName", obj.Criteria),
+			"RenderType":   nbt.NewStringTag("RenderType", renderType),
+		})
+
+		for _, s := range obj.Scores {
+			var locked int8
+			if s.Locked {
+				locked = 1
+			}
+
+			scores = append(scores, nbt.NewCompoundTag("", map[string]nbt.Tag{
+				"Name":      nbt.NewStringTag("Name", s.Participant.Name),
+				"Objective": nbt.NewStringTag("Objective", obj.Name),
+				"Score":     nbt.NewIntTag("Score", int32(s.Value)),
+				"Locked":    nbt.NewByteTag("Locked", locked),
+			}))
+		}
+	}
+
+	slots := nbt.NewCompoundTag("DisplaySlots", make(map[string]nbt.Tag))
+
+	keys := make([]string, 0, len(sb.DisplaySlots))
+	for slot := range sb.DisplaySlots {
+		keys = append(keys, slot)
+	}
+
+	sort.Strings(keys)
+
+	for _, slot := range keys {
+		key := slot
+		for k, v := range javaSlots {
+			if v == slot {
+				key = k
+			}
+		}
+
+		slots.Set(nbt.NewStringTag(key, sb.DisplaySlots[slot]))
+	}
+
+	com.Set(toList("Objectives", objectives))
+	com.Set(toList("PlayerScores", scores))
+	com.Set(toList("Teams", sb.Teams))
+	com.Set(slots)
+}
+
+// fromJSONText returns a plain text from a json text
+// If it's not a json text, returns as it is
+func fromJSONText(text string) string {
+	var str string
+	if json.Unmarshal([]byte(text), &str) == nil {
+		return str
+	}
+
+	var obj struct {
+		Text *string `json:"text"`
+	}
+
+	if json.Unmarshal([]byte(text), &obj) == nil && obj.Text != nil {
+		return *obj.Text
+	}
+
+	return text
+}
+
+// toJSONText returns a json text of the plain text
+func toJSONText(text string) string {
+	b, _ := json.Marshal(map[string]string{"text": text})
+
+	return string(b)
+}
@@ -0,0 +1,429 @@
+package scoreboard
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"errors"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/asset"
+	"github.com/beito123/nbt"
+)
+
+// Display slots
+const (
+	SlotList      = "list"
+	SlotSidebar   = "sidebar"
+	SlotBelowName = "belowname"
+)
+
+// CriteriaDummy is a criteria changed only by commands
+const CriteriaDummy = "dummy"
+
+// Storage is a level format which stores a scoreboard
+type Storage interface {
+	// Edition returns the edition of minecraft using the level format
+	Edition() asset.Edition
+
+	// Scoreboard returns the raw scoreboard compound
+	// If the world doesn't have it, returns false for ok
+	Scoreboard() (com *nbt.Compound, ok bool, err error)
+
+	// SetScoreboard sets the raw scoreboard compound
+	SetScoreboard(com *nbt.Compound) error
+}
+
+// ParticipantType is a kind of participants
+type ParticipantType int
+
+const (
+	// ParticipantName is a participant identified by name (mcje)
+	// It's a player name, an uuid of entities or a fake player
+	ParticipantName ParticipantType = iota
+
+	// ParticipantPlayer is a player (mcbe)
+	ParticipantPlayer
+
+	// ParticipantEntity is an entity (mcbe)
+	ParticipantEntity
+
+	// ParticipantFakePlayer is a fake player named by commands (mcbe)
+	ParticipantFakePlayer
+)
+
+// Participant is a holder of scores
+type Participant struct {
+	Type ParticipantType
+
+	// Name is a name of the participant
+	// It's empty for players and entities of mcbe
+	Name string
+
+	// ID is a unique id of the player or the entity (mcbe)
+	ID int64
+
+	// ScoreboardID is an id used on the scoreboard (mcbe)
+	ScoreboardID int64
+}
+
+// Score is a score of a participant
+type Score struct {
+	Participant *Participant
+	Value       int
+
+	// Locked is whether the trigger score is locked (mcje)
+	Locked bool
+}
+
+// Objective is an objective of scores
+type Objective struct {
+	Name        string
+	DisplayName string
+	Criteria    string
+
+	// RenderType is a type for display such as integer and hearts (mcje)
+	RenderType string
+
+	Scores []*Score
+
+	// rawDisplayName is the read json text of the display name (mcje)
+	rawDisplayName string
+}
+
+// Score returns a score of the participant
+// If the participant doesn't have score, returns false for ok
+func (obj *Objective) Score(p *Participant) (score int, ok bool) {
+	for _, s := range obj.Scores {
+		if s.Participant == p {
+			return s.Value, true
+		}
+	}
+
+	return 0, false
+}
+
+// SetScore sets a score of the participant
+func (obj *Objective) SetScore(p *Participant, value int) {
+	for _, s := range obj.Scores {
+		if s.Participant == p {
+			s.Value = value
+
+			return
+		}
+	}
+
+	obj.Scores = append(obj.Scores, &Score{
+		Participant: p,
+		Value:       value,
+	})
+}
+
+// RemoveScore removes a score of the participant
+func (obj *Objective) RemoveScore(p *Participant) {
+	for i, s := range obj.Scores {
+		if s.Participant == p {
+			obj.Scores = append(obj.Scores[:i], obj.Scores[i+1:]...)
+
+			return
+		}
+	}
+}
+
+// Reset removes all scores of the objective
+func (obj *Objective) Reset() {
+	obj.Scores = nil
+}
+
+// Scoreboard is objectives and scores of a level
+type Scoreboard struct {
+	Edition asset.Edition
+
+	Objectives   []*Objective
+	Participants []*Participant
+
+	// DisplaySlots is objective names by display slot
+	DisplaySlots map[string]string
+
+	// Teams is raw compounds of teams (mcje)
+	Teams []*nbt.Compound
+
+	data *nbt.Compound
+
+	// lastID is the last scoreboard id (mcbe)
+	lastID int64
+
+	// sortOrders is sort orders of display slots (mcbe)
+	sortOrders map[string]int8
+}
+
+// New returns a new empty scoreboard
+func New(edition asset.Edition) *Scoreboard {
+	return &Scoreboard{
+		Edition:      edition,
+		DisplaySlots: make(map[string]string),
+		data:         nbt.NewCompoundTag("", make(map[string]nbt.Tag)),
+		sortOrders:   make(map[string]int8),
+	}
+}
+
+// Read reads a scoreboard from a raw scoreboard compound
+func Read(com *nbt.Compound, edition asset.Edition) (*Scoreboard, error) {
+	sb := New(edition)
+	sb.data = com
+
+	if edition == asset.BedrockEdition {
+		return sb, readBedrock(sb, com)
+	}
+
+	return sb, readJava(sb, com)
+}
+
+// Write returns a raw scoreboard compound
+// Unknown values of the read compound are kept
+func (sb *Scoreboard) Write() *nbt.Compound {
+	if sb.Edition == asset.BedrockEdition {
+		writeBedrock(sb, sb.data)
+	} else {
+		writeJava(sb, sb.data)
+	}
+
+	return sb.data
+}
+
+// Load loads a scoreboard of the level
+// If the level doesn't have it, returns a new scoreboard
+func Load(format level.Format) (*Scoreboard, error) {
+	storage, ok := format.(Storage)
+	if !ok {
+		return nil, errors.New("level.scoreboard: the format doesn't support scoreboards")
+	}
+
+	com, ok, err := storage.Scoreboard()
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return New(storage.Edition()), nil
+	}
+
+	return Read(com, storage.Edition())
+}
+
+// Save saves the scoreboard to the level
+func Save(format level.Format, sb *Scoreboard) error {
+	storage, ok := format.(Storage)
+	if !ok {
+		return errors.New("level.scoreboard: the format doesn't support scoreboards")
+	}
+
+	if storage.Edition() != sb.Edition {
+		return errors.New("level.scoreboard: the edition of the scoreboard doesn't match the level")
+	}
+
+	return storage.SetScoreboard(sb.Write())
+}
+
+// Objective returns an objective by name
+func (sb *Scoreboard) Objective(name string) (*Objective, bool) {
+	for _, obj := range sb.Objectives {
+		if obj.Name == name {
+			return obj, true
+		}
+	}
+
+	return nil, false
+}
+
+// AddObjective adds an objective
+// If the objective already exists, returns it
+func (sb *Scoreboard) AddObjective(name, criteria, displayName string) *Objective {
+	obj, ok := sb.Objective(name)
+	if ok {
+		return obj
+	}
+
+	obj = &Objective{
+		Name:        name,
+		DisplayName: displayName,
+		Criteria:    criteria,
+		RenderType:  "integer",
+	}
+
+	sb.Objectives = append(sb.Objectives, obj)
+
+	return obj
+}
+
+// RemoveObjective removes an objective and display slots of it
+func (sb *Scoreboard) RemoveObjective(name string) {
+	for i, obj := range sb.Objectives {
+		if obj.Name == name {
+			sb.Objectives = append(sb.Objectives[:i], sb.Objectives[i+1:]...)
+
+			break
+		}
+	}
+
+	for slot, obj := range sb.DisplaySlots {
+		if obj == name {
+			delete(sb.DisplaySlots, slot)
+		}
+	}
+}
+
+// Participant returns a participant by name
+func (sb *Scoreboard) Participant(name string) (*Participant, bool) {
+	for _, p := range sb.Participants {
+		if p.Name == name && p.Name != "" {
+			return p, true
+		}
+	}
+
+	return nil, false
+}
+
+// ParticipantByID returns a player or an entity by unique id (mcbe)
+func (sb *Scoreboard) ParticipantByID(id int64) (*Participant, bool) {
+	for _, p := range sb.Participants {
+		if (p.Type == ParticipantPlayer || p.Type == ParticipantEntity) && p.ID == id {
+			return p, true
+		}
+	}
+
+	return nil, false
+}
+
+// AddParticipant adds a named participant
+// It's a fake player for mcbe
+// If the participant already exists, returns it
+func (sb *Scoreboard) AddParticipant(name string) *Participant {
+	p, ok := sb.Participant(name)
+	if ok {
+		return p
+	}
+
+	p = &Participant{
+		Type: ParticipantName,
+		Name: name,
+	}
+
+	if sb.Edition == asset.BedrockEdition {
+		p.Type = ParticipantFakePlayer
+		p.ScoreboardID = sb.newID()
+	}
+
+	sb.Participants = append(sb.Participants, p)
+
+	return p
+}
+
+// newID returns a new scoreboard id
+func (sb *Scoreboard) newID() int64 {
+	sb.lastID++
+
+	return sb.lastID
+}
+
+// RemoveParticipant removes a participant and all scores of it
+func (sb *Scoreboard) RemoveParticipant(p *Participant) {
+	sb.ResetScores(p)
+
+	for i, v := range sb.Participants {
+		if v == p {
+			sb.Participants = append(sb.Participants[:i], sb.Participants[i+1:]...)
+
+			return
+		}
+	}
+}
+
+// ResetScores removes all scores of the participant
+func (sb *Scoreboard) ResetScores(p *Participant) {
+	for _, obj := range sb.Objectives {
+		obj.RemoveScore(p)
+	}
+}
+
+// Reset removes all scores of all objectives
+// Objectives and display slots are kept
+func (sb *Scoreboard) Reset() {
+	for _, obj := range sb.Objectives {
+		obj.Reset()
+	}
+}
+
+// Scores returns scores of the participant by objective name
+func (sb *Scoreboard) Scores(p *Participant) map[string]int {
+	scores := make(map[string]int)
+	for _, obj := range sb.Objectives {
+		score, ok := obj.Score(p)
+		if ok {
+			scores[obj.Name] = score
+		}
+	}
+
+	return scores
+}
+
+func getInt(com *nbt.Compound, name string) int {
+	tag, ok := com.Get(name)
+	if !ok {
+		return 0
+	}
+
+	val, err := tag.ToInt()
+	if err != nil {
+		return 0
+	}
+
+	return val
+}
+
+func getString(com *nbt.Compound, name string) string {
+	val, err := com.GetString(name)
+	if err != nil {
+		return ""
+	}
+
+	return val
+}
+
+func getCompounds(com *nbt.Compound, name string) ([]*nbt.Compound, error) {
+	if !com.Has(name) {
+		return nil, nil
+	}
+
+	list, err := com.GetList(name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*nbt.Compound, 0, len(list))
+	for _, tag := range list {
+		c, ok := tag.(*nbt.Compound)
+		if !ok {
+			return nil, errors.New("level.scoreboard: unexpected " + nbt.GetTagName(tag.ID()) + "Tag, expected CompoundTag")
+		}
+
+		result = append(result, c)
+	}
+
+	return result, nil
+}
+
+func toList(name string, coms []*nbt.Compound) *nbt.List {
+	list := make([]nbt.Tag, len(coms))
+	for i, com := range coms {
+		list[i] = com
+	}
+
+	return nbt.NewListTag(name, list, nbt.IDTagCompound)
+}
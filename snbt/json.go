@@ -0,0 +1,353 @@
+package snbt
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/beito123/nbt"
+)
+
+// type names of tags in json
+const (
+	TypeByte      = "byte"
+	TypeShort     = "short"
+	TypeInt       = "int"
+	TypeLong      = "long"
+	TypeFloat     = "float"
+	TypeDouble    = "double"
+	TypeByteArray = "byte_array"
+	TypeString    = "string"
+	TypeList      = "list"
+	TypeCompound  = "compound"
+	TypeIntArray  = "int_array"
+	TypeLongArray = "long_array"
+)
+
+// typeNames is type names of tags by tag id
+var typeNames = map[byte]string{
+	nbt.IDTagEnd:       "end",
+	nbt.IDTagByte:      TypeByte,
+	nbt.IDTagShort:     TypeShort,
+	nbt.IDTagInt:       TypeInt,
+	nbt.IDTagLong:      TypeLong,
+	nbt.IDTagFloat:     TypeFloat,
+	nbt.IDTagDouble:    TypeDouble,
+	nbt.IDTagByteArray: TypeByteArray,
+	nbt.IDTagString:    TypeString,
+	nbt.IDTagList:      TypeList,
+	nbt.IDTagCompound:  TypeCompound,
+	nbt.IDTagIntArray:  TypeIntArray,
+	nbt.IDTagLongArray: TypeLongArray,
+}
+
+// typeIDs is tag ids by type name
+var typeIDs = func() map[string]byte {
+	ids := make(map[string]byte, len(typeNames))
+	for id, name := range typeNames {
+		ids[name] = id
+	}
+
+	return ids
+}()
+
+// JSON is a tag mapped to json with the type such as {"type":"int","value":20}
+// Values of compounds are objects of tags by key, and values of lists are
+// {"type":<type of elements>,"value":[<values of elements>]}
+// Bytes of byte arrays are signed, and NaN and infinities are "NaN", "Infinity" and "-Infinity"
+type JSON struct {
+	Tag nbt.Tag
+}
+
+// jsonTag is a tag in json
+type jsonTag struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// MarshalJSON returns the tag in json
+func (j JSON) MarshalJSON() ([]byte, error) {
+	value, err := jsonValue(j.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(jsonTag{
+		Type:  typeNames[j.Tag.ID()],
+		Value: value,
+	})
+}
+
+// UnmarshalJSON reads the tag from json
+func (j *JSON) UnmarshalJSON(b []byte) error {
+	var jt jsonTag
+
+	err := json.Unmarshal(b, &jt)
+	if err != nil {
+		return err
+	}
+
+	id, ok := typeIDs[jt.Type]
+	if !ok || id == nbt.IDTagEnd {
+		return fmt.Errorf("level.snbt: unknown type %s", jt.Type)
+	}
+
+	j.Tag, err = tagOf(id, "", jt.Value)
+
+	return err
+}
+
+// MarshalJSON returns the tag in json, the name of the tag is dropped
+func MarshalJSON(tag nbt.Tag) ([]byte, error) {
+	return json.Marshal(JSON{Tag: tag})
+}
+
+// MarshalJSONIndent is like MarshalJSON but the json is indented
+func MarshalJSONIndent(tag nbt.Tag, prefix, indent string) ([]byte, error) {
+	return json.MarshalIndent(JSON{Tag: tag}, prefix, indent)
+}
+
+// UnmarshalJSON reads a tag from json written by MarshalJSON
+func UnmarshalJSON(b []byte) (nbt.Tag, error) {
+	var j JSON
+
+	err := json.Unmarshal(b, &j)
+	if err != nil {
+		return nil, err
+	}
+
+	return j.Tag, nil
+}
+
+// jsonList is a value of lists in json
+type jsonList struct {
+	Type  string            `json:"type"`
+	Value []json.RawMessage `json:"value"`
+}
+
+// jsonValue returns the value of the tag in json
+func jsonValue(tag nbt.Tag) (json.RawMessage, error) {
+	switch t := tag.(type) {
+	case *nbt.Byte:
+		return json.Marshal(t.Value)
+	case *nbt.Short:
+		return json.Marshal(t.Value)
+	case *nbt.Int:
+		return json.Marshal(t.Value)
+	case *nbt.Long:
+		return json.Marshal(t.Value)
+	case *nbt.Float:
+		return jsonFloat(float64(t.Value), 32)
+	case *nbt.Double:
+		return jsonFloat(t.Value, 64)
+	case *nbt.String:
+		return json.Marshal(t.Value)
+	case *nbt.ByteArray:
+		return jsonBytes(t.Value)
+	case *nbt.IntArray:
+		return json.Marshal(nonNil(t.Value))
+	case *nbt.LongArray:
+		return json.Marshal(nonNilLongs(t.Value))
+	case *nbt.List:
+		list := jsonList{
+			Type:  typeNames[t.ListType],
+			Value: make([]json.RawMessage, 0, len(t.Value)),
+		}
+
+		for _, child := range t.Value {
+			value, err := jsonValue(child)
+			if err != nil {
+				return nil, err
+			}
+
+			list.Value = append(list.Value, value)
+		}
+
+		return json.Marshal(list)
+	case *nbt.Compound:
+		values := make(map[string]JSON, len(t.Value))
+		for key, child := range t.Value {
+			values[key] = JSON{Tag: child}
+		}
+
+		return json.Marshal(values)
+	}
+
+	return nil, fmt.Errorf("level.snbt: unsupported %sTag", nbt.GetTagName(tag.ID()))
+}
+
+// jsonFloat returns a float in json, NaN and infinities are strings
+func jsonFloat(v float64, bitSize int) (json.RawMessage, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return json.Marshal(formatFloat(v, bitSize))
+	}
+
+	if bitSize == 32 {
+		return json.Marshal(float32(v))
+	}
+
+	return json.Marshal(v)
+}
+
+// jsonBytes returns bytes as signed numbers in json
+func jsonBytes(b []byte) (json.RawMessage, error) {
+	values := make([]int8, len(b))
+	for i, v := range b {
+		values[i] = int8(v)
+	}
+
+	return json.Marshal(values)
+}
+
+func nonNil(values []int32) []int32 {
+	if values == nil {
+		return []int32{}
+	}
+
+	return values
+}
+
+func nonNilLongs(values []int64) []int64 {
+	if values == nil {
+		return []int64{}
+	}
+
+	return values
+}
+
+// tagOf returns a tag of the type from the value in json
+func tagOf(id byte, name string, value json.RawMessage) (nbt.Tag, error) {
+	switch id {
+	case nbt.IDTagByte:
+		var v int8
+		err := json.Unmarshal(value, &v)
+
+		return nbt.NewByteTag(name, v), err
+	case nbt.IDTagShort:
+		var v int16
+		err := json.Unmarshal(value, &v)
+
+		return nbt.NewShortTag(name, v), err
+	case nbt.IDTagInt:
+		var v int32
+		err := json.Unmarshal(value, &v)
+
+		return nbt.NewIntTag(name, v), err
+	case nbt.IDTagLong:
+		var v int64
+		err := json.Unmarshal(value, &v)
+
+		return nbt.NewLongTag(name, v), err
+	case nbt.IDTagFloat:
+		v, err := floatOf(value)
+
+		return nbt.NewFloatTag(name, float32(v)), err
+	case nbt.IDTagDouble:
+		v, err := floatOf(value)
+
+		return nbt.NewDoubleTag(name, v), err
+	case nbt.IDTagString:
+		var v string
+		err := json.Unmarshal(value, &v)
+
+		return nbt.NewStringTag(name, v), err
+	case nbt.IDTagByteArray:
+		var v []int8
+		err := json.Unmarshal(value, &v)
+
+		b := make([]byte, len(v))
+		for i, n := range v {
+			b[i] = byte(n)
+		}
+
+		return nbt.NewByteArrayTag(name, b), err
+	case nbt.IDTagIntArray:
+		var v []int32
+		err := json.Unmarshal(value, &v)
+
+		return nbt.NewIntArrayTag(name, v), err
+	case nbt.IDTagLongArray:
+		var v []int64
+		err := json.Unmarshal(value, &v)
+
+		return nbt.NewLongArrayTag(name, v), err
+	case nbt.IDTagList:
+		var list jsonList
+
+		err := json.Unmarshal(value, &list)
+		if err != nil {
+			return nil, err
+		}
+
+		elem, ok := typeIDs[list.Type]
+		if !ok {
+			return nil, fmt.Errorf("level.snbt: unknown type %s", list.Type)
+		}
+
+		if elem == nbt.IDTagEnd && len(list.Value) > 0 {
+			return nil, fmt.Errorf("level.snbt: a list of end has values")
+		}
+
+		values := make([]nbt.Tag, len(list.Value))
+		for i, raw := range list.Value {
+			values[i], err = tagOf(elem, "", raw)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return nbt.NewListTag(name, values, elem), nil
+	case nbt.IDTagCompound:
+		var values map[string]JSON
+
+		err := json.Unmarshal(value, &values)
+		if err != nil {
+			return nil, err
+		}
+
+		com := nbt.NewCompoundTag(name, make(map[string]nbt.Tag, len(values)))
+		for key, j := range values {
+			if j.Tag == nil {
+				return nil, fmt.Errorf("level.snbt: %s doesn't have a value", key)
+			}
+
+			j.Tag.SetName(key)
+			com.Set(j.Tag)
+		}
+
+		return com, nil
+	}
+
+	return nil, fmt.Errorf("level.snbt: unsupported %sTag", nbt.GetTagName(id))
+}
+
+// floatOf reads a float in json, NaN and infinities are strings
+func floatOf(value json.RawMessage) (float64, error) {
+	var s string
+	if json.Unmarshal(value, &s) == nil {
+		switch s {
+		case "NaN":
+			return math.NaN(), nil
+		case "Infinity":
+			return math.Inf(1), nil
+		case "-Infinity":
+			return math.Inf(-1), nil
+		}
+
+		return 0, fmt.Errorf("level.snbt: invaild float %s", s)
+	}
+
+	var v float64
+	err := json.Unmarshal(value, &v)
+
+	return v, err
+}
@@ -0,0 +1,437 @@
+package snbt
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/beito123/nbt"
+)
+
+// patterns of numbers without quotes, they're same as mcje
+var (
+	patternByte   = regexp.MustCompile(`(?i)^[-+]?(?:0|[1-9][0-9]*)b$`)
+	patternShort  = regexp.MustCompile(`(?i)^[-+]?(?:0|[1-9][0-9]*)s$`)
+	patternInt    = regexp.MustCompile(`^[-+]?(?:0|[1-9][0-9]*)$`)
+	patternLong   = regexp.MustCompile(`(?i)^[-+]?(?:0|[1-9][0-9]*)l$`)
+	patternFloat  = regexp.MustCompile(`(?i)^[-+]?(?:[0-9]+[.]?|[0-9]*[.][0-9]+)(?:e[-+]?[0-9]+)?f$`)
+	patternDouble = regexp.MustCompile(`(?i)^[-+]?(?:[0-9]+[.]?|[0-9]*[.][0-9]+)(?:e[-+]?[0-9]+)?d$`)
+
+	// patternDecimal is doubles without the suffix, they need a dot or an exponent
+	patternDecimal = regexp.MustCompile(`(?i)^[-+]?(?:[0-9]+[.]|[0-9]*[.][0-9]+)(?:e[-+]?[0-9]+)?$`)
+)
+
+// Unmarshal parses a SNBT (stringified nbt) string
+// Numbers without quotes are typed by the suffix (b, s, L, f or d), true and false are bytes
+func Unmarshal(s string) (nbt.Tag, error) {
+	p := &parser{s: s}
+
+	tag, err := p.value("")
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaces()
+
+	if p.pos < len(p.s) {
+		return nil, p.errorf("unexpected %q after the value", p.s[p.pos])
+	}
+
+	return tag, nil
+}
+
+// UnmarshalCompound parses a SNBT string of a compound
+func UnmarshalCompound(s string) (*nbt.Compound, error) {
+	tag, err := Unmarshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	com, ok := tag.(*nbt.Compound)
+	if !ok {
+		return nil, fmt.Errorf("level.snbt: unexpected %sTag, expected CompoundTag", nbt.GetTagName(tag.ID()))
+	}
+
+	return com, nil
+}
+
+// parser is a SNBT parser
+type parser struct {
+	s   string
+	pos int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("level.snbt: %s at %d", fmt.Sprintf(format, args...), p.pos)
+}
+
+func (p *parser) skipSpaces() {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.pos]) != -1 {
+		p.pos++
+	}
+}
+
+// peek returns the next character after spaces, it returns 0 at the end
+func (p *parser) peek() byte {
+	p.skipSpaces()
+
+	if p.pos >= len(p.s) {
+		return 0
+	}
+
+	return p.s[p.pos]
+}
+
+// expect skips the character, or returns an error
+func (p *parser) expect(c byte) error {
+	if p.peek() != c {
+		if p.pos >= len(p.s) {
+			return p.errorf("expected %q but reached the end", c)
+		}
+
+		return p.errorf("expected %q but found %q", c, p.s[p.pos])
+	}
+
+	p.pos++
+
+	return nil
+}
+
+// value parses a tag with the name
+func (p *parser) value(name string) (nbt.Tag, error) {
+	switch p.peek() {
+	case 0:
+		return nil, p.errorf("expected a value but reached the end")
+	case '{':
+		return p.compound(name)
+	case '[':
+		if len(p.s) > p.pos+2 && p.s[p.pos+2] == ';' {
+			switch p.s[p.pos+1] {
+			case 'B', 'I', 'L':
+				return p.array(name)
+			}
+		}
+
+		return p.list(name)
+	case '"', '\'':
+		s, err := p.quoted()
+		if err != nil {
+			return nil, err
+		}
+
+		return nbt.NewStringTag(name, s), nil
+	}
+
+	token := p.token()
+	if token == "" {
+		return nil, p.errorf("unexpected %q", p.s[p.pos])
+	}
+
+	return typed(name, token), nil
+}
+
+// token reads a string without quotes
+func (p *parser) token() string {
+	start := p.pos
+	for p.pos < len(p.s) && isUnquoted(p.s[p.pos]) {
+		p.pos++
+	}
+
+	return p.s[start:p.pos]
+}
+
+// isUnquoted returns whether the character can be used in strings without quotes
+func isUnquoted(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') ||
+		c == '_' || c == '-' || c == '.' || c == '+'
+}
+
+// quoted reads a string with double or single quotes
+func (p *parser) quoted() (string, error) {
+	quote := p.s[p.pos]
+	p.pos++
+
+	var b strings.Builder
+	for p.pos < len(p.s) {
+		c := p.s[p.pos]
+		p.pos++
+
+		switch c {
+		case quote:
+			return b.String(), nil
+		case '\\':
+			if p.pos >= len(p.s) {
+				return "", p.errorf("unexpected the end in a string")
+			}
+
+			esc := p.s[p.pos]
+			p.pos++
+
+			switch esc {
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case '"', '\'', '\\':
+				b.WriteByte(esc)
+			default:
+				return "", p.errorf("invaild escape \\%c", esc)
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return "", p.errorf("unclosed string")
+}
+
+// key reads a key of compounds
+func (p *parser) key() (string, error) {
+	switch p.peek() {
+	case '"', '\'':
+		return p.quoted()
+	}
+
+	key := p.token()
+	if key == "" {
+		if p.pos >= len(p.s) {
+			return "", p.errorf("expected a key but reached the end")
+		}
+
+		return "", p.errorf("expected a key but found %q", p.s[p.pos])
+	}
+
+	return key, nil
+}
+
+func (p *parser) compound(name string) (nbt.Tag, error) {
+	p.pos++ // {
+
+	com := nbt.NewCompoundTag(name, make(map[string]nbt.Tag))
+
+	for p.peek() != '}' {
+		key, err := p.key()
+		if err != nil {
+			return nil, err
+		}
+
+		err = p.expect(':')
+		if err != nil {
+			return nil, err
+		}
+
+		tag, err := p.value(key)
+		if err != nil {
+			return nil, err
+		}
+
+		com.Set(tag)
+
+		if p.peek() != ',' {
+			break
+		}
+
+		p.pos++
+	}
+
+	err := p.expect('}')
+	if err != nil {
+		return nil, err
+	}
+
+	return com, nil
+}
+
+func (p *parser) list(name string) (nbt.Tag, error) {
+	p.pos++ // [
+
+	var values []nbt.Tag
+	var typ byte = nbt.IDTagEnd
+
+	for p.peek() != ']' {
+		start := p.pos
+
+		tag, err := p.value("")
+		if err != nil {
+			return nil, err
+		}
+
+		if typ == nbt.IDTagEnd {
+			typ = tag.ID()
+		} else if tag.ID() != typ {
+			p.pos = start
+
+			return nil, p.errorf("unexpected %sTag in a list of %sTag", nbt.GetTagName(tag.ID()), nbt.GetTagName(typ))
+		}
+
+		values = append(values, tag)
+
+		if p.peek() != ',' {
+			break
+		}
+
+		p.pos++
+	}
+
+	err := p.expect(']')
+	if err != nil {
+		return nil, err
+	}
+
+	return nbt.NewListTag(name, values, typ), nil
+}
+
+// array parses arrays such as [B;1b,2b], [I;1,2] and [L;1L,2L]
+func (p *parser) array(name string) (nbt.Tag, error) {
+	kind := p.s[p.pos+1]
+	p.pos += 3 // [X;
+
+	var elem byte
+	switch kind {
+	case 'B':
+		elem = nbt.IDTagByte
+	case 'I':
+		elem = nbt.IDTagInt
+	default:
+		elem = nbt.IDTagLong
+	}
+
+	var values []nbt.Tag
+	for p.peek() != ']' {
+		start := p.pos
+
+		tag, err := p.value("")
+		if err != nil {
+			return nil, err
+		}
+
+		if tag.ID() != elem {
+			p.pos = start
+
+			return nil, p.errorf("unexpected %sTag in an array of %sTag", nbt.GetTagName(tag.ID()), nbt.GetTagName(elem))
+		}
+
+		values = append(values, tag)
+
+		if p.peek() != ',' {
+			break
+		}
+
+		p.pos++
+	}
+
+	err := p.expect(']')
+	if err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case 'B':
+		result := make([]byte, len(values))
+		for i, tag := range values {
+			result[i] = byte(tag.(*nbt.Byte).Value)
+		}
+
+		return nbt.NewByteArrayTag(name, result), nil
+	case 'I':
+		result := make([]int32, len(values))
+		for i, tag := range values {
+			result[i] = tag.(*nbt.Int).Value
+		}
+
+		return nbt.NewIntArrayTag(name, result), nil
+	}
+
+	result := make([]int64, len(values))
+	for i, tag := range values {
+		result[i] = tag.(*nbt.Long).Value
+	}
+
+	return nbt.NewLongArrayTag(name, result), nil
+}
+
+// typed returns a tag of the string without quotes
+// If it isn't a number, returns a string tag
+func typed(name string, s string) nbt.Tag {
+	switch {
+	case s == "true":
+		return nbt.NewByteTag(name, 1)
+	case s == "false":
+		return nbt.NewByteTag(name, 0)
+	case patternByte.MatchString(s):
+		if v, err := strconv.ParseInt(s[:len(s)-1], 10, 8); err == nil {
+			return nbt.NewByteTag(name, int8(v))
+		}
+	case patternShort.MatchString(s):
+		if v, err := strconv.ParseInt(s[:len(s)-1], 10, 16); err == nil {
+			return nbt.NewShortTag(name, int16(v))
+		}
+	case patternInt.MatchString(s):
+		if v, err := strconv.ParseInt(s, 10, 32); err == nil {
+			return nbt.NewIntTag(name, int32(v))
+		}
+	case patternLong.MatchString(s):
+		if v, err := strconv.ParseInt(s[:len(s)-1], 10, 64); err == nil {
+			return nbt.NewLongTag(name, v)
+		}
+	case patternFloat.MatchString(s):
+		if v, err := strconv.ParseFloat(s[:len(s)-1], 32); err == nil {
+			return nbt.NewFloatTag(name, float32(v))
+		}
+	case patternDouble.MatchString(s):
+		if v, err := strconv.ParseFloat(s[:len(s)-1], 64); err == nil {
+			return nbt.NewDoubleTag(name, v)
+		}
+	case patternDecimal.MatchString(s):
+		if v, err := strconv.ParseFloat(s, 64); err == nil {
+			return nbt.NewDoubleTag(name, v)
+		}
+	}
+
+	if v, ok := special(s); ok {
+		return v(name)
+	}
+
+	return nbt.NewStringTag(name, s)
+}
+
+// special returns a constructor of NaN and infinities written by Marshal such as NaNd and -Infinityf
+func special(s string) (func(name string) nbt.Tag, bool) {
+	if len(s) < 2 {
+		return nil, false
+	}
+
+	var v float64
+	switch s[:len(s)-1] {
+	case "NaN":
+		v = math.NaN()
+	case "Infinity", "+Infinity":
+		v = math.Inf(1)
+	case "-Infinity":
+		v = math.Inf(-1)
+	default:
+		return nil, false
+	}
+
+	switch s[len(s)-1] {
+	case 'f', 'F':
+		return func(name string) nbt.Tag { return nbt.NewFloatTag(name, float32(v)) }, true
+	case 'd', 'D':
+		return func(name string) nbt.Tag { return nbt.NewDoubleTag(name, v) }, true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,135 @@
+package snbt
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"math"
+	"testing"
+
+	"github.com/beito123/nbt"
+)
+
+func TestUnmarshalTypes(t *testing.T) {
+	tests := []struct {
+		s  string
+		id byte
+	}{
+		{s: "1b", id: nbt.IDTagByte},
+		{s: "true", id: nbt.IDTagByte},
+		{s: "-2S", id: nbt.IDTagShort},
+		{s: "3", id: nbt.IDTagInt},
+		{s: "4L", id: nbt.IDTagLong},
+		{s: "1.5f", id: nbt.IDTagFloat},
+		{s: "1.5d", id: nbt.IDTagDouble},
+		{s: "1.5", id: nbt.IDTagDouble},
+		{s: "3000000000", id: nbt.IDTagString}, // over int
+		{s: "stone", id: nbt.IDTagString},
+		{s: `"1b"`, id: nbt.IDTagString},
+		{s: "[B;1b,2b]", id: nbt.IDTagByteArray},
+		{s: "[I;1,2]", id: nbt.IDTagIntArray},
+		{s: "[L;1L,2L]", id: nbt.IDTagLongArray},
+		{s: "[1,2]", id: nbt.IDTagList},
+		{s: "{}", id: nbt.IDTagCompound},
+	}
+
+	for _, test := range tests {
+		tag, err := Unmarshal(test.s)
+		if err != nil {
+			t.Errorf("%s: %s", test.s, err)
+
+			continue
+		}
+
+		if tag.ID() != test.id {
+			t.Errorf("%s: expected %sTag, but got %sTag", test.s, nbt.GetTagName(test.id), nbt.GetTagName(tag.ID()))
+		}
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	tests := []string{
+		`{}`,
+		`{Count:1b,Damage:0s,id:"minecraft:diamond_sword"}`,
+		`{Health:20.0f,Motion:[0.0d,-0.5d,1.0E-4d],Pos:[1.5d,64.0d,-3.5d]}`,
+		`{Items:[{Slot:0b,id:"minecraft:stone"},{Slot:1b,id:"minecraft:dirt"}]}`,
+		`{a:[B;-1b,2b],b:[I;1,-2,3],c:[L;4L,5L]}`,
+		`{"key with spaces":"a \"quoted\" \\ value",time:1234567890123L}`,
+		`{nan:NaNd,inf:Infinityf,ninf:-Infinityd}`,
+		`{empty:[],nested:[[1,2],[3]]}`,
+	}
+
+	for _, s := range tests {
+		tag, err := Unmarshal(s)
+		if err != nil {
+			t.Errorf("%s: %s", s, err)
+
+			continue
+		}
+
+		again, err := Unmarshal(Marshal(tag))
+		if err != nil {
+			t.Errorf("%s: couldn't parse marshaled %s: %s", s, Marshal(tag), err)
+
+			continue
+		}
+
+		if Marshal(again) != Marshal(tag) {
+			t.Errorf("%s: expected %s, but got %s", s, Marshal(tag), Marshal(again))
+		}
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	com := nbt.NewCompoundTag("", map[string]nbt.Tag{
+		"id":    nbt.NewStringTag("id", "minecraft:pig"),
+		"Count": nbt.NewByteTag("Count", 1),
+		"Pos": nbt.NewListTag("Pos", []nbt.Tag{
+			nbt.NewDoubleTag("", 1),
+			nbt.NewDoubleTag("", 2.5),
+		}, nbt.IDTagDouble),
+	})
+
+	expected := `{Count:1b,Pos:[1.0d,2.5d],id:"minecraft:pig"}`
+	if s := Marshal(com); s != expected {
+		t.Errorf("expected %s, but got %s", expected, s)
+	}
+}
+
+func TestUnmarshalSpecial(t *testing.T) {
+	tag, err := Unmarshal("NaNf")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, ok := tag.(*nbt.Float)
+	if !ok || !math.IsNaN(float64(f.Value)) {
+		t.Errorf("expected NaN float, but got %s", Marshal(tag))
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	tests := []string{
+		``,
+		`{`,
+		`{a 1}`,
+		`[1,2`,
+		`[1,"a"]`, // mixed types in a list
+		`[B;1,2]`, // ints in a byte array
+		`"unclosed`,
+		`{a:1}b`,
+	}
+
+	for _, s := range tests {
+		_, err := Unmarshal(s)
+		if err == nil {
+			t.Errorf("%q: expected an error", s)
+		}
+	}
+}
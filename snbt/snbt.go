@@ -0,0 +1,261 @@
+package snbt
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/beito123/nbt"
+)
+
+// unquoted is a pattern of strings which can be written without quotes
+var unquoted = regexp.MustCompile(`^[0-9A-Za-z_\-.+]+$`)
+
+// Marshal returns a SNBT (stringified nbt) string of the tag such as {Health:20.0f,id:"minecraft:pig"}
+// Keys of compounds are sorted, and the name of the tag is dropped
+func Marshal(tag nbt.Tag) string {
+	e := &encoder{}
+	e.tag(tag)
+
+	return e.String()
+}
+
+// MarshalIndent is like Marshal but each entry of compounds and lists of compounds or lists
+// is written on a new line with the indent
+func MarshalIndent(tag nbt.Tag, indent string) string {
+	e := &encoder{indent: indent}
+	e.tag(tag)
+
+	return e.String()
+}
+
+// encoder writes SNBT strings
+type encoder struct {
+	strings.Builder
+
+	indent string
+	depth  int
+}
+
+// newline writes a new line and the indent of the depth
+func (e *encoder) newline() {
+	e.WriteByte('\n')
+	e.WriteString(strings.Repeat(e.indent, e.depth))
+}
+
+// separator writes a separator between entries
+func (e *encoder) separator(multiline bool) {
+	e.WriteByte(',')
+
+	if multiline {
+		e.newline()
+	} else if e.indent != "" {
+		e.WriteByte(' ')
+	}
+}
+
+func (e *encoder) tag(tag nbt.Tag) {
+	switch t := tag.(type) {
+	case *nbt.Byte:
+		e.WriteString(strconv.FormatInt(int64(t.Value), 10) + "b")
+	case *nbt.Short:
+		e.WriteString(strconv.FormatInt(int64(t.Value), 10) + "s")
+	case *nbt.Int:
+		e.WriteString(strconv.FormatInt(int64(t.Value), 10))
+	case *nbt.Long:
+		e.WriteString(strconv.FormatInt(t.Value, 10) + "L")
+	case *nbt.Float:
+		e.WriteString(formatFloat(float64(t.Value), 32) + "f")
+	case *nbt.Double:
+		e.WriteString(formatFloat(t.Value, 64) + "d")
+	case *nbt.String:
+		e.WriteString(Quote(t.Value))
+	case *nbt.ByteArray:
+		e.byteArray(t.Value)
+	case *nbt.IntArray:
+		values := make([]string, len(t.Value))
+		for i, v := range t.Value {
+			values[i] = strconv.FormatInt(int64(v), 10)
+		}
+
+		e.array("I", values)
+	case *nbt.LongArray:
+		e.longArray(t.Value)
+	case *nbt.List:
+		e.list(t)
+	case *nbt.Compound:
+		e.compound(t)
+	default:
+		e.WriteString(Quote(""))
+	}
+}
+
+func (e *encoder) byteArray(value []byte) {
+	values := make([]string, len(value))
+	for i, v := range value {
+		values[i] = strconv.FormatInt(int64(int8(v)), 10) + "b"
+	}
+
+	e.array("B", values)
+}
+
+func (e *encoder) longArray(value []int64) {
+	values := make([]string, len(value))
+	for i, v := range value {
+		values[i] = strconv.FormatInt(v, 10) + "L"
+	}
+
+	e.array("L", values)
+}
+
+// array writes an array such as [I;1,2,3]
+func (e *encoder) array(prefix string, values []string) {
+	e.WriteString("[" + prefix + ";")
+
+	for i, v := range values {
+		if i > 0 {
+			e.separator(false)
+		} else if e.indent != "" {
+			e.WriteByte(' ')
+		}
+
+		e.WriteString(v)
+	}
+
+	e.WriteByte(']')
+}
+
+func (e *encoder) list(list *nbt.List) {
+	multiline := e.indent != "" && len(list.Value) > 0 &&
+		(list.ListType == nbt.IDTagCompound || list.ListType == nbt.IDTagList)
+
+	e.WriteByte('[')
+	e.depth++
+
+	if multiline {
+		e.newline()
+	}
+
+	for i, child := range list.Value {
+		if i > 0 {
+			e.separator(multiline)
+		}
+
+		e.tag(child)
+	}
+
+	e.depth--
+
+	if multiline {
+		e.newline()
+	}
+
+	e.WriteByte(']')
+}
+
+func (e *encoder) compound(com *nbt.Compound) {
+	keys := make([]string, 0, len(com.Value))
+	for key := range com.Value {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	multiline := e.indent != "" && len(keys) > 0
+
+	e.WriteByte('{')
+	e.depth++
+
+	if multiline {
+		e.newline()
+	}
+
+	for i, key := range keys {
+		if i > 0 {
+			e.separator(multiline)
+		}
+
+		e.WriteString(QuoteKey(key))
+		e.WriteByte(':')
+
+		if e.indent != "" {
+			e.WriteByte(' ')
+		}
+
+		e.tag(com.Value[key])
+	}
+
+	e.depth--
+
+	if multiline {
+		e.newline()
+	}
+
+	e.WriteByte('}')
+}
+
+// formatFloat returns the shortest string of the float, NaN and infinities are NaN, Infinity and -Infinity
+func formatFloat(v float64, bitSize int) string {
+	switch {
+	case math.IsNaN(v):
+		return "NaN"
+	case math.IsInf(v, 1):
+		return "Infinity"
+	case math.IsInf(v, -1):
+		return "-Infinity"
+	}
+
+	s := strconv.FormatFloat(v, 'g', -1, bitSize)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+
+	return s
+}
+
+// Quote returns a double-quoted string with escapes for SNBT
+func Quote(s string) string {
+	var b strings.Builder
+
+	b.WriteByte('"')
+
+	for _, r := range s {
+		switch r {
+		case '"', '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	b.WriteByte('"')
+
+	return b.String()
+}
+
+// QuoteKey returns the key of compounds, it's quoted if it has characters which need quotes
+func QuoteKey(key string) string {
+	if unquoted.MatchString(key) {
+		return key
+	}
+
+	return Quote(key)
+}
@@ -0,0 +1,275 @@
+package structure
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/blockentity"
+	"github.com/beito123/level/leveldb"
+	"github.com/beito123/level/util"
+	"github.com/beito123/nbt"
+)
+
+// BedrockFormatVersion is format_version of mcstructure
+const BedrockFormatVersion = 1
+
+// readBedrock reads mcstructure
+func readBedrock(root *nbt.Compound) (*Structure, error) {
+	size, err := readInts(root, "size", 3)
+	if err != nil {
+		return nil, err
+	}
+
+	st := New(size[0], size[1], size[2])
+
+	if root.Has("structure_world_origin") {
+		origin, err := readInts(root, "structure_world_origin", 3)
+		if err != nil {
+			return nil, err
+		}
+
+		copy(st.Origin[:], origin)
+	}
+
+	com, err := root.GetCompound("structure")
+	if err != nil {
+		return nil, err
+	}
+
+	// Blocks
+
+	indices, err := com.GetList("block_indices")
+	if err != nil {
+		return nil, err
+	}
+
+	for layer, entry := range indices {
+		if layer >= LayerCount {
+			break
+		}
+
+		list, ok := entry.(*nbt.List)
+		if !ok {
+			return nil, fmt.Errorf("couldn't convert to *List")
+		}
+
+		if len(list.Value) != len(st.Layers[layer]) {
+			return nil, fmt.Errorf("level.structure: the number of block indices isn't matched the size")
+		}
+
+		for i, tag := range list.Value {
+			st.Layers[layer][i], err = tag.ToInt()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Palette
+
+	palettes, err := com.GetCompound("palette")
+	if err != nil {
+		return nil, err
+	}
+
+	palette, err := palettes.GetCompound("default")
+	if err != nil {
+		return nil, err
+	}
+
+	states, err := util.ReadCompounds(palette, "block_palette")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, state := range states {
+		name, err := state.GetString("name")
+		if err != nil {
+			return nil, err
+		}
+
+		var bs *leveldb.RawBlockState
+		if state.Has("states") {
+			s, err := state.GetCompound("states")
+			if err != nil {
+				return nil, err
+			}
+
+			var version int32
+			if state.Has("version") {
+				version, err = state.GetInt("version")
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			bs = leveldb.NewRawBlockStateWithStates(name, s, int(version))
+		} else {
+			val, err := state.GetShort("val")
+			if err != nil {
+				return nil, err
+			}
+
+			bs = leveldb.NewRawBlockState(name, int(val))
+		}
+
+		st.Palette = append(st.Palette, bs)
+	}
+
+	// Block entities
+
+	if palette.Has("block_position_data") {
+		data, err := palette.GetCompound("block_position_data")
+		if err != nil {
+			return nil, err
+		}
+
+		for key, tag := range data.Value {
+			index, err := strconv.Atoi(key)
+			if err != nil {
+				return nil, err
+			}
+
+			pos, ok := tag.(*nbt.Compound)
+			if !ok || !pos.Has("block_entity_data") {
+				continue
+			}
+
+			be, err := pos.GetCompound("block_entity_data")
+			if err != nil {
+				return nil, err
+			}
+
+			x, y, z := st.Pos(index)
+
+			st.BlockEntities = append(st.BlockEntities, util.WithPos(be, x, y, z))
+		}
+	}
+
+	st.Entities, err = util.ReadCompounds(com, "entities")
+	if err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+// writeBedrock returns a root compound of mcstructure
+// Block states are converted to block states for mcbe, and waterlogged blocks have water in layer 1
+func (st *Structure) writeBedrock() *nbt.Compound {
+	// Palette
+
+	states := make([]*nbt.Compound, len(st.Palette))
+	waterlogged := make([]bool, len(st.Palette))
+	for i, bs := range st.Palette {
+		rbs, logged, _, err := leveldb.SplitRawBlockState(bs)
+		if err != nil {
+			rbs = leveldb.NewRawBlockState(bs.Name(), 0)
+		}
+
+		states[i] = writeBedrockState(rbs)
+		waterlogged[i] = logged
+	}
+
+	// Blocks
+
+	water := NoBlock
+
+	indices := make([]nbt.Tag, LayerCount)
+	for layer, indexes := range st.Layers {
+		list := make([]nbt.Tag, len(indexes))
+		for i, index := range indexes {
+			if layer == level.LayerLiquid && index == NoBlock {
+				base := st.Layers[level.LayerBlock][i]
+				if base >= 0 && base < len(waterlogged) && waterlogged[base] {
+					if water == NoBlock {
+						water = len(states)
+						states = append(states, writeBedrockState(leveldb.NewWaterBlockState(leveldb.BlockStateVersionV114)))
+					}
+
+					index = water
+				}
+			}
+
+			list[i] = nbt.NewIntTag("", int32(index))
+		}
+
+		indices[layer] = nbt.NewListTag("", list, nbt.IDTagInt)
+	}
+
+	// Block entities
+
+	data := nbt.NewCompoundTag("block_position_data", make(map[string]nbt.Tag))
+	for _, be := range st.BlockEntities {
+		pos, ok := blockentity.PosOf(be)
+		if !ok || !st.Vaild(pos.X, pos.Y, pos.Z) {
+			continue
+		}
+
+		index := strconv.Itoa(st.At(pos.X, pos.Y, pos.Z))
+
+		data.Set(nbt.NewCompoundTag(index, map[string]nbt.Tag{
+			"block_entity_data": util.WithPos(be, st.Origin[0]+pos.X, st.Origin[1]+pos.Y, st.Origin[2]+pos.Z),
+		}))
+	}
+
+	palette := nbt.NewCompoundTag("default", map[string]nbt.Tag{
+		"block_palette":       util.WriteCompounds("block_palette", states),
+		"block_position_data": data,
+	})
+
+	structure := nbt.NewCompoundTag("structure", map[string]nbt.Tag{
+		"block_indices": nbt.NewListTag("block_indices", indices, nbt.IDTagList),
+		"entities":      util.WriteCompounds("entities", st.Entities),
+		"palette": nbt.NewCompoundTag("palette", map[string]nbt.Tag{
+			"default": palette,
+		}),
+	})
+
+	return nbt.NewCompoundTag("", map[string]nbt.Tag{
+		"format_version":         nbt.NewIntTag("format_version", BedrockFormatVersion),
+		"size":                   writeInts("size", st.Size[0], st.Size[1], st.Size[2]),
+		"structure_world_origin": writeInts("structure_world_origin", st.Origin[0], st.Origin[1], st.Origin[2]),
+		"structure":              structure,
+	})
+}
+
+// writeBedrockState returns a compound of the block state for a palette
+func writeBedrockState(rbs *leveldb.RawBlockState) *nbt.Compound {
+	com := nbt.NewCompoundTag("", make(map[string]nbt.Tag))
+	com.Set(nbt.NewStringTag("name", rbs.Name()))
+
+	if rbs.HasStates() {
+		version := rbs.Version()
+		if version == 0 {
+			version = leveldb.BlockStateVersionV114
+		}
+
+		com.Set(cloneCompound("states", rbs.States()))
+		com.Set(nbt.NewIntTag("version", int32(version)))
+	} else {
+		com.Set(nbt.NewShortTag("val", int16(rbs.Value())))
+	}
+
+	return com
+}
+
+// cloneCompound returns a shallow copy of a compound with name
+func cloneCompound(name string, com *nbt.Compound) *nbt.Compound {
+	result := nbt.NewCompoundTag(name, make(map[string]nbt.Tag, len(com.Value)))
+	for key, tag := range com.Value {
+		result.Value[key] = tag
+	}
+
+	return result
+}
@@ -0,0 +1,135 @@
+package structure
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/anvil"
+	"github.com/beito123/level/asset"
+	"github.com/beito123/level/blockentity"
+	"github.com/beito123/level/entity"
+	"github.com/beito123/level/leveldb"
+	"github.com/beito123/nbt"
+)
+
+func TestBedrockReadWrite(t *testing.T) {
+	st := New(3, 2, 4)
+	st.Origin = [3]int{10, 64, -5}
+
+	granite := leveldb.NewRawBlockStateWithStates("minecraft:stone", nbt.NewCompoundTag("states", map[string]nbt.Tag{
+		"stone_type": nbt.NewStringTag("stone_type", "granite"),
+	}), leveldb.BlockStateVersionV114)
+
+	stairs := anvil.NewBlockState("minecraft:oak_stairs", map[string]string{
+		"facing":      "east",
+		"half":        "bottom",
+		"shape":       "straight",
+		"waterlogged": "true",
+	})
+
+	st.SetBlock(0, 0, 0, level.LayerBlock, granite)
+	st.SetBlock(2, 1, 3, level.LayerBlock, granite)
+	st.SetBlock(1, 1, 2, level.LayerBlock, stairs)
+
+	chest := blockentity.NewChest(asset.BedrockEdition, 1, 1, 2)
+	chest.SetCustomName("test")
+
+	st.BlockEntities = []*nbt.Compound{chest.Compound}
+	st.Entities = []*nbt.Compound{entity.New(asset.BedrockEdition, "minecraft:pig", 1.5, 1, 2.5).Compound}
+
+	buf := new(bytes.Buffer)
+
+	err := st.Write(buf, FormatBedrock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	read, format, err := Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if format != FormatBedrock {
+		t.Errorf("detected as %s", format.Name())
+	}
+
+	if read.Size != st.Size || read.Origin != st.Origin {
+		t.Fatalf("expected size %v and origin %v, but got %v and %v", st.Size, st.Origin, read.Size, read.Origin)
+	}
+
+	for _, pos := range [][3]int{{0, 0, 0}, {2, 1, 3}} {
+		bs := read.Block(pos[0], pos[1], pos[2], level.LayerBlock)
+		if bs == nil || stateKey(bs) != stateKey(granite) {
+			t.Errorf("%v: expected granite", pos)
+		}
+	}
+
+	if read.Block(1, 0, 0, level.LayerBlock) != nil {
+		t.Errorf("expected no block at (1, 0, 0)")
+	}
+
+	bs := read.Block(1, 1, 2, level.LayerBlock)
+	if bs == nil || bs.Name() != "minecraft:oak_stairs" {
+		t.Errorf("expected oak stairs at (1, 1, 2)")
+	}
+
+	water := read.Block(1, 1, 2, level.LayerLiquid)
+	if water == nil || water.Name() != "minecraft:water" {
+		t.Errorf("expected water in the liquid layer of waterlogged stairs")
+	}
+
+	if len(read.BlockEntities) != 1 {
+		t.Fatalf("expected a block entity, but got %d", len(read.BlockEntities))
+	}
+
+	pos, _ := blockentity.PosOf(read.BlockEntities[0])
+	if pos != (blockentity.Pos{X: 1, Y: 1, Z: 2}) {
+		t.Errorf("expected the block entity at (1, 1, 2), but got %v", pos)
+	}
+
+	if len(read.Entities) != 1 || entity.Wrap(read.Entities[0]).Identifier() != "minecraft:pig" {
+		t.Errorf("entities aren't kept")
+	}
+}
+
+func TestBedrockBlockEntityPos(t *testing.T) {
+	st := New(2, 2, 2)
+	st.Origin = [3]int{100, 10, 200}
+	st.SetBlock(1, 0, 1, level.LayerBlock, leveldb.NewRawBlockState("minecraft:chest", 0))
+
+	chest := blockentity.NewChest(asset.BedrockEdition, 1, 0, 1)
+	st.BlockEntities = []*nbt.Compound{chest.Compound}
+
+	root := st.writeBedrock()
+
+	structure, _ := root.GetCompound("structure")
+	palettes, _ := structure.GetCompound("palette")
+	palette, _ := palettes.GetCompound("default")
+	data, _ := palette.GetCompound("block_position_data")
+
+	entry, err := data.GetCompound("5") // index of (1, 0, 1) is (x*sy+y)*sz+z
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	be, err := entry.GetCompound("block_entity_data")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// block entities in mcstructure have world coordinates
+	pos, _ := blockentity.PosOf(be)
+	if pos != (blockentity.Pos{X: 101, Y: 10, Z: 201}) {
+		t.Errorf("expected (101, 10, 201), but got %v", pos)
+	}
+}
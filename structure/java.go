@@ -0,0 +1,278 @@
+package structure
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+
+	"github.com/beito123/level"
+	"github.com/beito123/level/anvil"
+	"github.com/beito123/level/block"
+	"github.com/beito123/level/blockentity"
+	"github.com/beito123/level/util"
+	"github.com/beito123/nbt"
+)
+
+// readJava reads structure nbt for mcje
+// If it has several palettes (palettes), the first palette is used
+func readJava(root *nbt.Compound) (*Structure, error) {
+	size, err := readInts(root, "size", 3)
+	if err != nil {
+		return nil, err
+	}
+
+	st := New(size[0], size[1], size[2])
+	st.DataVersion = anvil.DefaultDataVersion
+
+	if root.Has("DataVersion") {
+		ver, err := root.GetInt("DataVersion")
+		if err != nil {
+			return nil, err
+		}
+
+		st.DataVersion = int(ver)
+	}
+
+	// Palette
+
+	var states []*nbt.Compound
+	if root.Has("palette") {
+		states, err = util.ReadCompounds(root, "palette")
+		if err != nil {
+			return nil, err
+		}
+	} else if root.Has("palettes") {
+		palettes, err := root.GetList("palettes")
+		if err != nil {
+			return nil, err
+		}
+
+		if len(palettes) > 0 {
+			list, ok := palettes[0].(*nbt.List)
+			if !ok {
+				return nil, fmt.Errorf("couldn't convert to *List")
+			}
+
+			for _, entry := range list.Value {
+				c, ok := entry.(*nbt.Compound)
+				if !ok {
+					return nil, fmt.Errorf("couldn't convert to *Compound")
+				}
+
+				states = append(states, c)
+			}
+		}
+	}
+
+	for _, state := range states {
+		name, err := state.GetString("Name")
+		if err != nil {
+			return nil, err
+		}
+
+		properties := make(map[string]string)
+		if state.Has("Properties") {
+			pro, err := state.GetCompound("Properties")
+			if err != nil {
+				return nil, err
+			}
+
+			for key, tag := range pro.Value {
+				properties[key], err = tag.ToString()
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		st.Palette = append(st.Palette, anvil.NewBlockState(name, properties))
+	}
+
+	// Blocks
+
+	blocks, err := util.ReadCompounds(root, "blocks")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, bl := range blocks {
+		state, err := bl.GetInt("state")
+		if err != nil {
+			return nil, err
+		}
+
+		pos, err := readInts(bl, "pos", 3)
+		if err != nil {
+			return nil, err
+		}
+
+		x, y, z := pos[0], pos[1], pos[2]
+		if !st.Vaild(x, y, z) {
+			return nil, fmt.Errorf("level.structure: the block is out of the structure")
+		}
+
+		st.Layers[0][st.At(x, y, z)] = int(state)
+
+		if bl.Has("nbt") {
+			be, err := bl.GetCompound("nbt")
+			if err != nil {
+				return nil, err
+			}
+
+			st.BlockEntities = append(st.BlockEntities, util.WithPos(be, x, y, z))
+		}
+	}
+
+	st.Entities, err = util.ReadCompounds(root, "entities")
+	if err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+// writeJava returns a root compound of structure nbt for mcje
+// Water in layer 1 is written as waterlogged property, or water if there is no block
+func (st *Structure) writeJava() *nbt.Compound {
+	// Palette
+
+	var states []*nbt.Compound
+	keys := make(map[string]int)
+
+	paletteIndex := func(bs level.BlockState) int {
+		key := stateKey(bs)
+		if index, ok := keys[key]; ok {
+			return index
+		}
+
+		keys[key] = len(states)
+		states = append(states, writeJavaState(bs))
+
+		return len(states) - 1
+	}
+
+	// Block entities
+
+	entities := make(map[int]*nbt.Compound)
+	for _, be := range st.BlockEntities {
+		pos, ok := blockentity.PosOf(be)
+		if !ok || !st.Vaild(pos.X, pos.Y, pos.Z) {
+			continue
+		}
+
+		com := nbt.NewCompoundTag("nbt", make(map[string]nbt.Tag))
+		for key, tag := range be.Value {
+			switch key {
+			case "x", "y", "z":
+				continue
+			}
+
+			com.Value[key] = tag
+		}
+
+		entities[st.At(pos.X, pos.Y, pos.Z)] = com
+	}
+
+	// Blocks
+
+	var blocks []*nbt.Compound
+	for x := 0; x < st.Size[0]; x++ {
+		for y := 0; y < st.Size[1]; y++ {
+			for z := 0; z < st.Size[2]; z++ {
+				index := st.At(x, y, z)
+
+				bs := st.javaBlock(x, y, z)
+				if bs == nil {
+					continue
+				}
+
+				com := nbt.NewCompoundTag("", map[string]nbt.Tag{
+					"state": nbt.NewIntTag("state", int32(paletteIndex(bs))),
+					"pos":   writeInts("pos", x, y, z),
+				})
+
+				if be, ok := entities[index]; ok {
+					com.Set(be)
+				}
+
+				blocks = append(blocks, com)
+			}
+		}
+	}
+
+	dataVersion := st.DataVersion
+	if dataVersion == 0 {
+		dataVersion = anvil.DefaultDataVersion
+	}
+
+	return nbt.NewCompoundTag("", map[string]nbt.Tag{
+		"DataVersion": nbt.NewIntTag("DataVersion", int32(dataVersion)),
+		"size":        writeInts("size", st.Size[0], st.Size[1], st.Size[2]),
+		"palette":     util.WriteCompounds("palette", states),
+		"blocks":      util.WriteCompounds("blocks", blocks),
+		"entities":    util.WriteCompounds("entities", st.Entities),
+	})
+}
+
+// javaBlock returns a block state for mcje at the coordinate
+// Water in layer 1 is merged to the block in layer 0
+func (st *Structure) javaBlock(x, y, z int) level.BlockState {
+	bs := st.Block(x, y, z, level.LayerBlock)
+
+	liquid := st.Block(x, y, z, level.LayerLiquid)
+	if liquid == nil || !block.IsWater(liquid.Name()) {
+		return bs
+	}
+
+	if bs == nil || block.IsAir(bs.Name()) {
+		return anvil.NewWaterBlockState()
+	}
+
+	name, properties, ok := bs.ToBlockNameProperties()
+	if !ok {
+		return bs
+	}
+
+	if _, has := properties[block.Waterlogged]; !has && !block.CanWaterlog(name) {
+		return bs
+	}
+
+	result := make(map[string]string, len(properties)+1)
+	for key, val := range properties {
+		result[key] = val
+	}
+
+	result[block.Waterlogged] = "true"
+
+	return anvil.NewBlockState(name, result)
+}
+
+// writeJavaState returns a compound of the block state for a palette
+func writeJavaState(bs level.BlockState) *nbt.Compound {
+	name, properties, ok := bs.ToBlockNameProperties()
+	if !ok {
+		name = bs.Name()
+	}
+
+	com := nbt.NewCompoundTag("", map[string]nbt.Tag{
+		"Name": nbt.NewStringTag("Name", name),
+	})
+
+	if len(properties) > 0 {
+		pro := nbt.NewCompoundTag("Properties", make(map[string]nbt.Tag))
+		for key, val := range properties {
+			pro.Set(nbt.NewStringTag(key, val))
+		}
+
+		com.Set(pro)
+	}
+
+	return com
+}
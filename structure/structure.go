@@ -0,0 +1,480 @@
+package structure
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+
+	"github.com/beito123/binary"
+	"github.com/beito123/level"
+	"github.com/beito123/level/anvil"
+	"github.com/beito123/level/asset"
+	"github.com/beito123/level/block"
+	"github.com/beito123/level/blockentity"
+	"github.com/beito123/level/edit"
+	"github.com/beito123/level/leveldb"
+	"github.com/beito123/level/util"
+	"github.com/beito123/nbt"
+)
+
+// Format is a file format of structures
+type Format int
+
+const (
+	// FormatBedrock is .mcstructure for mcbe (little endian nbt)
+	FormatBedrock Format = iota
+
+	// FormatJava is structure block nbt for mcje (gzip, big endian nbt)
+	FormatJava
+)
+
+// Name returns the name of the format
+func (format Format) Name() string {
+	switch format {
+	case FormatBedrock:
+		return "mcstructure"
+	case FormatJava:
+		return "java"
+	}
+
+	return "unknown"
+}
+
+const (
+	// LayerCount is the number of block layers
+	LayerCount = 2
+
+	// NoBlock is a palette index for positions without a block (structure void)
+	NoBlock = -1
+)
+
+// New returns new Structure without blocks
+func New(x, y, z int) *Structure {
+	st := &Structure{
+		Size: [3]int{x, y, z},
+	}
+
+	for i := range st.Layers {
+		st.Layers[i] = make([]int, x*y*z)
+		for j := range st.Layers[i] {
+			st.Layers[i][j] = NoBlock
+		}
+	}
+
+	return st
+}
+
+// Structure is a block structure saved by structure blocks
+// Layer 1 is used for a second block in mcbe, such as water of waterlogged blocks
+type Structure struct {
+	Size   [3]int
+	Origin [3]int // world origin of the structure (mcbe)
+
+	DataVersion int // (mcje)
+
+	Palette []level.BlockState
+
+	// Layers is palette indexes for blocks, or NoBlock
+	Layers [LayerCount][]int
+
+	// BlockEntities is block entities which have coordinates (x, y, z) relative to the structure
+	BlockEntities []*nbt.Compound
+
+	// Entities is entities in the file
+	// They are kept when the structure is read and written, but not placed
+	Entities []*nbt.Compound
+}
+
+// At returns a index for layers
+func (st *Structure) At(x, y, z int) int {
+	return (x*st.Size[1]+y)*st.Size[2] + z
+}
+
+// Pos returns a coordinate from a index for layers
+func (st *Structure) Pos(index int) (x, y, z int) {
+	return index / (st.Size[1] * st.Size[2]), (index / st.Size[2]) % st.Size[1], index % st.Size[2]
+}
+
+// Vaild returns whether the coordinate is in the structure
+func (st *Structure) Vaild(x, y, z int) bool {
+	return x >= 0 && x < st.Size[0] && y >= 0 && y < st.Size[1] && z >= 0 && z < st.Size[2]
+}
+
+// Block returns a block at the coordinate and the layer
+// If there is no block, returns nil
+func (st *Structure) Block(x, y, z, layer int) level.BlockState {
+	if !st.Vaild(x, y, z) || layer < 0 || layer >= LayerCount {
+		return nil
+	}
+
+	index := st.Layers[layer][st.At(x, y, z)]
+	if index < 0 || index >= len(st.Palette) {
+		return nil
+	}
+
+	return st.Palette[index]
+}
+
+// SetBlock sets a block at the coordinate and the layer
+// If state is nil, the block is removed
+func (st *Structure) SetBlock(x, y, z, layer int, state level.BlockState) {
+	if !st.Vaild(x, y, z) || layer < 0 || layer >= LayerCount {
+		return
+	}
+
+	index := NoBlock
+	if state != nil {
+		index = st.paletteIndex(state)
+	}
+
+	st.Layers[layer][st.At(x, y, z)] = index
+}
+
+// paletteIndex returns a index of the state in the palette, the state is added if it's not found
+func (st *Structure) paletteIndex(state level.BlockState) int {
+	key := stateKey(state)
+	for i, bs := range st.Palette {
+		if stateKey(bs) == key {
+			return i
+		}
+	}
+
+	st.Palette = append(st.Palette, state)
+
+	return len(st.Palette) - 1
+}
+
+// stateKey returns a string to compare block states
+func stateKey(state level.BlockState) string {
+	if name, meta, ok := state.ToBlockNameMeta(); ok {
+		return name + ":" + strconv.Itoa(meta)
+	}
+
+	name, properties, ok := state.ToBlockNameProperties()
+	if !ok {
+		return state.Name()
+	}
+
+	return block.FormatState(name, properties)
+}
+
+// Extract copies blocks and block entities in the box as a Structure
+// Blocks in chunks which don't exist are saved as no block, and air in the liquid layer too
+func Extract(e *edit.Editor, box edit.Box) (*Structure, error) {
+	var clips [LayerCount]*edit.Clipboard
+	for layer := range clips {
+		var err error
+		clips[layer], err = e.CopyAtLayer(box, layer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	entities, err := e.BlockEntities(box)
+	if err != nil {
+		return nil, err
+	}
+
+	st := New(box.Size())
+	st.Origin = [3]int{box.MinX, box.MinY, box.MinZ}
+
+	keys := make(map[string]int)
+	for layer, clip := range clips {
+		for y := 0; y < clip.Height; y++ {
+			for z := 0; z < clip.Length; z++ {
+				for x := 0; x < clip.Width; x++ {
+					bs := clip.Get(x, y, z)
+					if bs == nil || (layer == level.LayerLiquid && block.IsAir(bs.Name())) {
+						continue
+					}
+
+					key := stateKey(bs)
+
+					index, ok := keys[key]
+					if !ok {
+						index = len(st.Palette)
+						keys[key] = index
+
+						st.Palette = append(st.Palette, bs)
+					}
+
+					st.Layers[layer][st.At(x, y, z)] = index
+				}
+			}
+		}
+	}
+
+	for _, com := range entities {
+		pos, _ := blockentity.PosOf(com)
+
+		st.BlockEntities = append(st.BlockEntities, util.WithPos(com, pos.X-box.MinX, pos.Y-box.MinY, pos.Z-box.MinZ))
+	}
+
+	return st, nil
+}
+
+// Place places blocks and block entities at world coordinate x, y, z as the minimum corner
+// Positions without a block are skipped, returns the number of set blocks in the block layer
+// Blocks are converted for the edition of the world, and if a block can't be converted, returns an error before placing
+func (st *Structure) Place(e *edit.Editor, x, y, z int) (int, error) {
+	palette, err := st.convertPalette(editionOf(e.Format))
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	for layer := 0; layer < LayerCount; layer++ {
+		clip := edit.NewClipboard(st.Size[0], st.Size[1], st.Size[2])
+		for bx := 0; bx < st.Size[0]; bx++ {
+			for by := 0; by < st.Size[1]; by++ {
+				for bz := 0; bz < st.Size[2]; bz++ {
+					index := st.Layers[layer][st.At(bx, by, bz)]
+					if index >= 0 && index < len(palette) {
+						clip.Set(bx, by, bz, palette[index])
+					}
+				}
+			}
+		}
+
+		n, err := e.PasteAtLayer(clip, x, y, z, layer)
+		if layer == level.LayerBlock {
+			count = n
+		}
+
+		if err != nil {
+			return count, err
+		}
+	}
+
+	for _, com := range st.BlockEntities {
+		pos, ok := blockentity.PosOf(com)
+		if !ok {
+			continue
+		}
+
+		err := e.SetBlockEntity(util.WithPos(com, x+pos.X, y+pos.Y, z+pos.Z))
+		if err != nil {
+			return count, err
+		}
+	}
+
+	return count, nil
+}
+
+// convertPalette returns the palette converted for the edition
+// Blocks which aren't used are skipped, block states for mcbe are checked but kept for the liquid layer
+func (st *Structure) convertPalette(edition asset.Edition) ([]level.BlockState, error) {
+	used := make([]bool, len(st.Palette))
+	for _, indexes := range st.Layers {
+		for _, index := range indexes {
+			if index >= 0 && index < len(used) {
+				used[index] = true
+			}
+		}
+	}
+
+	palette := make([]level.BlockState, len(st.Palette))
+	for i, bs := range st.Palette {
+		if !used[i] || bs == nil {
+			continue
+		}
+
+		if edition == asset.BedrockEdition {
+			_, _, _, err := leveldb.SplitRawBlockState(bs)
+			if err != nil {
+				return nil, err
+			}
+
+			palette[i] = bs
+
+			continue
+		}
+
+		rbs, ok := bs.(*leveldb.RawBlockState)
+		if !ok {
+			palette[i] = bs
+
+			continue
+		}
+
+		name, properties, err := leveldb.JavaBlockState(rbs)
+		if err != nil {
+			return nil, err
+		}
+
+		palette[i] = anvil.NewBlockState(name, properties)
+	}
+
+	return palette, nil
+}
+
+// editioner is a format which has a edition
+type editioner interface {
+	Edition() asset.Edition
+}
+
+// editionOf returns the edition of the format
+func editionOf(format level.Format) asset.Edition {
+	if f, ok := format.(editioner); ok {
+		return f.Edition()
+	}
+
+	return asset.JavaEdition
+}
+
+// Read reads a structure, the format is detected
+// Structures for mcje need to be compressed with gzip as structure blocks save
+func Read(reader io.Reader) (*Structure, Format, error) {
+	b, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// structure files for mcje are compressed with gzip, mcstructure isn't compressed
+	var order binary.Order = nbt.BigEndian
+	if len(b) < 2 || b[0] != 0x1f || b[1] != 0x8b {
+		order = nbt.LittleEndian
+	}
+
+	root, err := readRoot(b, order)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if root.Has("structure") {
+		st, err := readBedrock(root)
+
+		return st, FormatBedrock, err
+	}
+
+	if !root.Has("blocks") {
+		return nil, 0, fmt.Errorf("level.structure: unknown structure format")
+	}
+
+	st, err := readJava(root)
+
+	return st, FormatJava, err
+}
+
+// ReadFile reads a structure file
+func ReadFile(path string) (*Structure, Format, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer file.Close()
+
+	return Read(file)
+}
+
+func readRoot(b []byte, order binary.Order) (*nbt.Compound, error) {
+	stream, err := nbt.FromBytes(b, order)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := stream.ReadTag()
+	if err != nil {
+		return nil, err
+	}
+
+	root, ok := tag.(*nbt.Compound)
+	if !ok {
+		return nil, errors.New("unexpected " + nbt.GetTagName(tag.ID()) + "Tag, expected CompoundTag")
+	}
+
+	return root, nil
+}
+
+// Write writes the structure with the format
+func (st *Structure) Write(writer io.Writer, format Format) error {
+	var b []byte
+
+	switch format {
+	case FormatBedrock:
+		stream := nbt.NewStream(nbt.LittleEndian)
+
+		err := stream.WriteTag(util.FixArrays(st.writeBedrock()))
+		if err != nil {
+			return err
+		}
+
+		b = stream.Bytes()
+	case FormatJava:
+		stream := nbt.NewStream(nbt.BigEndian)
+
+		err := stream.WriteTag(util.FixArrays(st.writeJava()))
+		if err != nil {
+			return err
+		}
+
+		b, err = nbt.Compress(stream, nbt.CompressGZip, nbt.DefaultCompressionLevel)
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("level.structure: unknown structure format")
+	}
+
+	_, err := io.Copy(writer, bytes.NewReader(b))
+
+	return err
+}
+
+// WriteFile writes the structure to a file with the format
+func (st *Structure) WriteFile(path string, format Format) error {
+	buf := new(bytes.Buffer)
+
+	err := st.Write(buf, format)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, buf.Bytes(), os.ModePerm)
+}
+
+// readInts reads a list of ints
+func readInts(com *nbt.Compound, name string, count int) ([]int, error) {
+	list, err := com.GetList(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) < count {
+		return nil, fmt.Errorf("level.structure: %s needs %d values", name, count)
+	}
+
+	result := make([]int, len(list))
+	for i, tag := range list {
+		result[i], err = tag.ToInt()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// writeInts returns a list of ints
+func writeInts(name string, values ...int) *nbt.List {
+	list := make([]nbt.Tag, len(values))
+	for i, v := range values {
+		list[i] = nbt.NewIntTag("", int32(v))
+	}
+
+	return nbt.NewListTag(name, list, nbt.IDTagInt)
+}
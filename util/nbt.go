@@ -0,0 +1,132 @@
+package util
+
+/*
+	level
+
+	Copyright (c) 2019 beito
+
+	This software is released under the MIT License.
+	http://opensource.org/licenses/mit-license.php
+*/
+
+import (
+	"fmt"
+
+	"github.com/beito123/nbt"
+)
+
+// ByteArray is a ByteArrayTag fixed the length prefix for writing
+// nbt.ByteArray doesn't write the length
+type ByteArray struct {
+	*nbt.ByteArray
+}
+
+// Write writes tag for Stream
+func (t *ByteArray) Write(n *nbt.Stream) error {
+	err := n.Stream.PutInt(int32(len(t.Value)))
+	if err != nil {
+		return err
+	}
+
+	return n.Stream.Put(t.Value)
+}
+
+// LongArray is a LongArrayTag fixed the length prefix for writing
+// nbt.LongArray writes the length as a long instead of an int
+type LongArray struct {
+	*nbt.LongArray
+}
+
+// Write writes tag for Stream
+func (t *LongArray) Write(n *nbt.Stream) error {
+	err := n.Stream.PutInt(int32(len(t.Value)))
+	if err != nil {
+		return err
+	}
+
+	for _, value := range t.Value {
+		err = n.Stream.PutLong(value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FixArrays returns a copy of the tag which ByteArrayTags and LongArrayTags are replaced
+// with tags which are written correctly, the tag isn't modified
+// You need to use it before writing tags which may contain them
+func FixArrays(tag nbt.Tag) nbt.Tag {
+	switch t := tag.(type) {
+	case *nbt.ByteArray:
+		return &ByteArray{ByteArray: t}
+	case *nbt.LongArray:
+		return &LongArray{LongArray: t}
+	case *nbt.Compound:
+		value := make(map[string]nbt.Tag, len(t.Value))
+		for name, child := range t.Value {
+			value[name] = FixArrays(child)
+		}
+
+		return nbt.NewCompoundTag(t.Name(), value)
+	case *nbt.List:
+		value := make([]nbt.Tag, len(t.Value))
+		for i, child := range t.Value {
+			value[i] = FixArrays(child)
+		}
+
+		return nbt.NewListTag(t.Name(), value, t.ListType)
+	}
+
+	return tag
+}
+
+// WithPos returns a copy of a compound with x, y and z such as a block entity
+func WithPos(com *nbt.Compound, x, y, z int) *nbt.Compound {
+	result := nbt.NewCompoundTag(com.Name(), make(map[string]nbt.Tag, len(com.Value)+3))
+	for name, tag := range com.Value {
+		result.Value[name] = tag
+	}
+
+	result.Set(nbt.NewIntTag("x", int32(x)))
+	result.Set(nbt.NewIntTag("y", int32(y)))
+	result.Set(nbt.NewIntTag("z", int32(z)))
+
+	return result
+}
+
+// ReadCompounds reads a list of compounds
+// If the compound doesn't have the list, returns nil
+func ReadCompounds(com *nbt.Compound, name string) ([]*nbt.Compound, error) {
+	if !com.Has(name) {
+		return nil, nil
+	}
+
+	list, err := com.GetList(name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*nbt.Compound, 0, len(list))
+	for _, entry := range list {
+		c, ok := entry.(*nbt.Compound)
+		if !ok {
+			return nil, fmt.Errorf("level.util: couldn't convert %s to *Compound", name)
+		}
+
+		result = append(result, c)
+	}
+
+	return result, nil
+}
+
+// WriteCompounds returns a list of compounds
+func WriteCompounds(name string, compounds []*nbt.Compound) *nbt.List {
+	list := make([]nbt.Tag, len(compounds))
+	for i, c := range compounds {
+		list[i] = c
+	}
+
+	return nbt.NewListTag(name, list, nbt.IDTagCompound)
+}